@@ -3,9 +3,13 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/wandb/wandb/core/internal/clioutput"
 	"github.com/wandb/wandb/core/pkg/gowandb"
 	"github.com/wandb/wandb/core/pkg/gowandb/opts/sessionopts"
 	"github.com/wandb/wandb/core/pkg/gowandb/settings"
@@ -53,14 +57,32 @@ func (b *Bench) RunWorkers() {
 		runtime.GOMAXPROCS(*b.opts.numCPUs)
 	}
 	var wg sync.WaitGroup
+	var finished atomic.Int64
+	total := int64(*b.opts.numWorkers)
+	start := time.Now()
 	for i := 0; i < *b.opts.numWorkers; i++ {
 		wg.Add(1)
 		go func() {
 			b.Worker()
+			clioutput.Default().Progress("workers", finished.Add(1), total)
 			wg.Done()
 		}()
 	}
 	wg.Wait()
+
+	elapsed := time.Since(start)
+	records := *b.opts.numWorkers * *b.opts.numHistory
+	clioutput.Default().Result("benchmark",
+		fmt.Sprintf("%d workers logged %d history records in %v (%.1f records/s)",
+			*b.opts.numWorkers, records, elapsed, float64(records)/elapsed.Seconds()),
+		map[string]interface{}{
+			"workers":            *b.opts.numWorkers,
+			"history":            *b.opts.numHistory,
+			"history_elements":   *b.opts.numHistoryElements,
+			"records":            records,
+			"duration_seconds":   elapsed.Seconds(),
+			"records_per_second": float64(records) / elapsed.Seconds(),
+		})
 }
 
 func (b *Bench) Worker() {
@@ -97,7 +119,12 @@ func main() {
 		numCPUs:            flag.Int("numCPUs", 0, "number of cpus"),
 		numWorkers:         flag.Int("numWorkers", 1, "number of parallel workers"),
 	}
+	output := flag.String("output", "", "output format, text or json; "+clioutput.EnvFormat+" if empty")
 	flag.Parse()
+	if err := clioutput.Init("benchmark", *output); err != nil {
+		clioutput.Default().Error(err.Error())
+		os.Exit(2)
+	}
 
 	b := NewBench(benchOpts)
 	b.Setup()
@@ -11,6 +11,7 @@ import (
 	"runtime/trace"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/wandb/wandb/core/internal/clioutput"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 )
@@ -33,8 +34,13 @@ func main() {
 	noAnalytics := flag.Bool("no-observability", false, "turn off observability")
 	// todo: remove these flags, they are here for backward compatibility
 	serveSock := flag.Bool("serve-sock", false, "use sockets")
+	output := flag.String("output", "", "output format, text or json; "+clioutput.EnvFormat+" if empty")
 
 	flag.Parse()
+	if err := clioutput.Init("wandb-core", *output); err != nil {
+		clioutput.Default().Error(err.Error())
+		os.Exit(2)
+	}
 
 	var writers []io.Writer
 
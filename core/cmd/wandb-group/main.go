@@ -0,0 +1,134 @@
+// Command wandb-group aggregates the offline runs of a group, such as runs of
+// the same experiment with different seeds, without syncing them.
+//
+// The history of the runs sharing a run group under a directory is aligned
+// by step, and the mean, standard deviation, min and max of each metric are
+// written as a CSV file and as a synthetic offline run of the group, which
+// can be synced later with `wandb sync`. Aggregating a group again replaces
+// its CSV file and its synthetic run. With -output json, the results,
+// warnings and progress are printed as JSON lines.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/clioutput"
+	"github.com/wandb/wandb/core/internal/groupagg"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+const usage = `usage: wandb-group [flags] <dir>
+
+Aggregates the offline runs of each group under dir, or of the group given
+with -group.
+
+flags:
+`
+
+// csvName returns the name of the CSV file of a group
+func csvName(group string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, group) + ".csv"
+}
+
+func run() error {
+	group := flag.String("group", "", "run group to aggregate, all groups if empty")
+	out := flag.String("out", "", "directory to write the aggregates to, dir if empty")
+	output := flag.String("output", "", "output format, text or json; "+clioutput.EnvFormat+" if empty")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := clioutput.Init("wandb-group", *output); err != nil {
+		return err
+	}
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := flag.Arg(0)
+	if *out == "" {
+		*out = dir
+	}
+
+	logger := observability.NewNoOpLogger()
+	paths, err := groupagg.FindRuns(dir)
+	if err != nil {
+		return err
+	}
+	var runs []*groupagg.Run
+	for i, path := range paths {
+		run, err := groupagg.ReadRun(logger, path)
+		clioutput.Default().Progress("reading runs", int64(i+1), int64(len(paths)))
+		if err != nil {
+			clioutput.Default().Warn(fmt.Sprintf("skipping %s: %v", path, err))
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	names, byGroup := groupagg.GroupRuns(runs)
+	if *group != "" {
+		names = []string{*group}
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no runs with a group under %s", dir)
+	}
+	for _, name := range names {
+		if len(byGroup[name]) == 0 {
+			return fmt.Errorf("no runs of group %q under %s", name, dir)
+		}
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		aggregate := groupagg.Aggregate(name, byGroup[name])
+
+		csvPath := filepath.Join(*out, csvName(name))
+		f, err := os.Create(csvPath)
+		if err != nil {
+			return err
+		}
+		err = aggregate.WriteCSV(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+
+		runLog, err := aggregate.WriteRunLog(logger, *out)
+		if err != nil {
+			return err
+		}
+		clioutput.Default().Result("aggregate",
+			fmt.Sprintf("%s: %d runs, %d steps, wrote %s and %s",
+				name, len(aggregate.Runs), len(aggregate.Steps), csvPath, runLog.Dir()),
+			map[string]interface{}{
+				"group":   name,
+				"runs":    len(aggregate.Runs),
+				"steps":   len(aggregate.Steps),
+				"csv":     csvPath,
+				"run_dir": runLog.Dir(),
+			})
+	}
+	return nil
+}
+
+func main() {
+	if err := run(); err != nil {
+		clioutput.Default().Error(err.Error())
+		os.Exit(1)
+	}
+}
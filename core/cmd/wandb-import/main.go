@@ -0,0 +1,208 @@
+// Command wandb-import imports runs logged by other tools as W&B runs.
+//
+// Each imported run is written as an offline run, with a transaction log that
+// can be synced later with `wandb sync`, or right away with -sync. With
+// -output json, the results and errors are printed as JSON lines.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/clioutput"
+	"github.com/wandb/wandb/core/internal/digestcache"
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+const usage = `usage: wandb-import <command> [flags] <path>
+
+commands:
+  table   import a CSV, TSV or JSON lines file of metrics
+  mlflow  import the runs of an MLflow file store (an mlruns directory)
+  gotest  import the output of go test, or go test -json, with benchmarks;
+          reads stdin if path is - or missing
+`
+
+// commonFlags are the flags of all the import commands
+type commonFlags struct {
+	dir     *string
+	sync    *bool
+	baseURL *string
+	entity  *string
+	project *string
+	output  *string
+}
+
+func addCommonFlags(flags *flag.FlagSet) *commonFlags {
+	baseURL := os.Getenv("WANDB_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.wandb.ai"
+	}
+	return &commonFlags{
+		dir:     flags.String("dir", "wandb", "directory to write the offline runs to"),
+		sync:    flags.Bool("sync", false, "sync the imported runs"),
+		baseURL: flags.String("base-url", baseURL, "W&B server to sync to"),
+		entity:  flags.String("entity", "", "entity of the imported runs"),
+		project: flags.String("project", "", "project of the imported runs"),
+		output:  flags.String("output", "", "output format, text or json; "+clioutput.EnvFormat+" if empty"),
+	}
+}
+
+// parse parses the flags of a command and sets up its output
+func (c *commonFlags) parse(flags *flag.FlagSet, args []string) error {
+	_ = flags.Parse(args)
+	return clioutput.Init("wandb-import", *c.output)
+}
+
+// finish reports an imported run and syncs it if asked to
+func (c *commonFlags) finish(runLog *importer.RunLog) error {
+	runID := runLog.Run().GetRunId()
+	clioutput.Default().Result("import", fmt.Sprintf("wrote %s", runLog.Dir()), map[string]interface{}{
+		"run_id": runID,
+		"dir":    runLog.Dir(),
+	})
+	if !*c.sync {
+		return nil
+	}
+	url, err := importer.Sync(context.Background(), runLog, *c.baseURL, os.Getenv("WANDB_API_KEY"))
+	if err != nil {
+		return err
+	}
+	clioutput.Default().Result("sync", fmt.Sprintf("synced %s", url), map[string]interface{}{
+		"run_id": runID,
+		"url":    url,
+	})
+	return nil
+}
+
+func importTable(args []string) error {
+	flags := flag.NewFlagSet("table", flag.ExitOnError)
+	common := addCommonFlags(flags)
+	mappingPath := flags.String("mapping", "", "mapping file of the columns (required)")
+	if err := common.parse(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 || *mappingPath == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	mapping, err := importer.LoadMapping(*mappingPath)
+	if err != nil {
+		return err
+	}
+	if *common.entity != "" {
+		mapping.Run.Entity = *common.entity
+	}
+	if *common.project != "" {
+		mapping.Run.Project = *common.project
+	}
+	runLog, err := importer.ImportTable(observability.NewNoOpLogger(), flags.Arg(0), mapping, *common.dir)
+	if err != nil {
+		return err
+	}
+	return common.finish(runLog)
+}
+
+func importMLflow(args []string) error {
+	flags := flag.NewFlagSet("mlflow", flag.ExitOnError)
+	common := addCommonFlags(flags)
+	experiments := flags.String("experiments", "", "comma separated ids of the experiments to import, all if empty")
+	if err := common.parse(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	opts := importer.MLflowOptions{
+		Entity:  *common.entity,
+		Project: *common.project,
+	}
+	if *experiments != "" {
+		opts.Experiments = strings.Split(*experiments, ",")
+	}
+	if path, err := digestcache.DefaultPath(); err == nil {
+		opts.DigestCache = digestcache.New(path)
+		defer func() { _ = opts.DigestCache.Save() }()
+	}
+
+	runLogs, err := importer.ImportMLflow(observability.NewNoOpLogger(), flags.Arg(0), *common.dir, opts)
+	if err != nil {
+		return err
+	}
+	for _, runLog := range runLogs {
+		if err := common.finish(runLog); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func importGoTest(args []string) error {
+	flags := flag.NewFlagSet("gotest", flag.ExitOnError)
+	common := addCommonFlags(flags)
+	name := flags.String("name", "", "name of the run, the tested package if empty")
+	gitDir := flags.String("git-dir", ".", "git working tree the tests ran in, none if empty")
+	if err := common.parse(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var input io.Reader = os.Stdin
+	if path := flags.Arg(0); path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+
+	opts := importer.GoTestOptions{
+		Run: importer.RunInfo{
+			Name:    *name,
+			Entity:  *common.entity,
+			Project: *common.project,
+		},
+		GitDir: *gitDir,
+	}
+	runLog, err := importer.ImportGoTest(observability.NewNoOpLogger(), input, *common.dir, opts)
+	if err != nil {
+		return err
+	}
+	return common.finish(runLog)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "table":
+		err = importTable(args)
+	case "mlflow":
+		err = importMLflow(args)
+	case "gotest":
+		err = importGoTest(args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		clioutput.Default().Error(err.Error())
+		os.Exit(1)
+	}
+}
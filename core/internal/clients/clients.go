@@ -55,6 +55,13 @@ func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return t.wrapped.RoundTrip(req)
 }
 
+// CloseIdleConnections closes the idle connections of the wrapped transport
+func (t *authedTransport) CloseIdleConnections() {
+	if closer, ok := t.wrapped.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
 func NewRetryClient(opts ...RetryClientOption) *retryablehttp.Client {
 	retryClient := retryablehttp.NewClient()
 
@@ -0,0 +1,287 @@
+// Package clioutput prints the user-visible messages of the commands and of
+// the core, such as the run header and footer, as text or as JSON lines that
+// wrapper tools can read.
+//
+// In the JSON output mode, every message is one JSON object on its own line
+// of stdout, with the fields of Message. The schema is versioned: within a
+// version, fields are only ever added.
+package clioutput
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// EnvFormat is the environment variable that sets the output format of a
+// process, for processes that are not started with an -output flag, such as
+// the core started by a client library.
+const EnvFormat = "WANDB_CORE_OUTPUT"
+
+// SchemaVersion is the version of the schema of the JSON messages.
+const SchemaVersion = 1
+
+// Format is the output format of a printer.
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses an output format, the one of the EnvFormat environment
+// variable if empty, or text if it is not set either.
+func ParseFormat(value string) (Format, error) {
+	if value == "" {
+		value = os.Getenv(EnvFormat)
+	}
+	switch Format(value) {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	default:
+		return FormatText, fmt.Errorf("clioutput: unknown output format %q", value)
+	}
+}
+
+// Type is the type of a message.
+type Type string
+
+const (
+	TypeRunHeader Type = "run_header"
+	TypeRunFooter Type = "run_footer"
+	TypeInfo      Type = "info"
+	TypeWarning   Type = "warning"
+	TypeError     Type = "error"
+	TypeProgress  Type = "progress"
+	TypeResult    Type = "result"
+)
+
+// Message is a message in the JSON output mode.
+type Message struct {
+	Version int    `json:"version"`
+	Type    Type   `json:"type"`
+	Time    string `json:"time"`
+	// Source is the command that printed the message
+	Source string `json:"source"`
+	// Message is the text of the message, as printed in the text mode. It is
+	// set for all types but the run header and footer and progress.
+	Message string `json:"message,omitempty"`
+	// Run is set for the run header and footer
+	Run *Run `json:"run,omitempty"`
+	// LogDir is the directory of the logs of the run, set for the footer
+	LogDir string `json:"log_dir,omitempty"`
+	// Progress is set for progress updates
+	Progress *Progress `json:"progress,omitempty"`
+	// Result is set for results
+	Result *Result `json:"result,omitempty"`
+}
+
+// Run identifies a run in the run header and footer.
+type Run struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Entity  string `json:"entity"`
+	Project string `json:"project"`
+	URL     string `json:"url"`
+}
+
+// Progress is the progress of a task, such as reading files.
+type Progress struct {
+	Task  string `json:"task"`
+	Done  int64  `json:"done"`
+	Total int64  `json:"total"`
+}
+
+// Result is the result of a command. The fields of Data depend on Name.
+type Result struct {
+	Name string                 `json:"name"`
+	Data map[string]interface{} `json:"data"`
+}
+
+type PrinterOption func(*Printer)
+
+// WithClock sets the clock of the times of the messages, used in tests.
+func WithClock(now func() time.Time) PrinterOption {
+	return func(p *Printer) {
+		p.now = now
+	}
+}
+
+// WithSource sets the name of the command printing the messages, the name
+// of the executable by default.
+func WithSource(source string) PrinterOption {
+	return func(p *Printer) {
+		p.source = source
+	}
+}
+
+// Printer prints messages in its format. It is safe for concurrent use.
+//
+// In the text mode, warnings, errors and progress updates are printed to
+// stderr, and the other messages to stdout. In the JSON mode, all messages
+// are printed to stdout.
+type Printer struct {
+	mu     sync.Mutex
+	stdout io.Writer
+	stderr io.Writer
+	format Format
+	source string
+	now    func() time.Time
+}
+
+func NewPrinter(stdout io.Writer, stderr io.Writer, format Format, opts ...PrinterOption) *Printer {
+	p := &Printer{
+		stdout: stdout,
+		stderr: stderr,
+		format: format,
+		source: filepath.Base(os.Args[0]),
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+var (
+	defaultMu      sync.Mutex
+	defaultPrinter *Printer
+)
+
+// Default returns the printer of the process, which prints to stdout and
+// stderr in the format of the EnvFormat environment variable unless set by
+// Init.
+func Default() *Printer {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultPrinter == nil {
+		format, _ := ParseFormat("")
+		defaultPrinter = NewPrinter(os.Stdout, os.Stderr, format)
+	}
+	return defaultPrinter
+}
+
+// SetDefault replaces the printer of the process, and returns the previous
+// one.
+func SetDefault(p *Printer) *Printer {
+	previous := Default()
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultPrinter = p
+	return previous
+}
+
+// Init sets the printer of a command, in the format of its -output flag.
+func Init(source string, format string) error {
+	parsed, err := ParseFormat(format)
+	SetDefault(NewPrinter(os.Stdout, os.Stderr, parsed, WithSource(source)))
+	return err
+}
+
+// Format returns the format of the printer.
+func (p *Printer) Format() Format {
+	return p.format
+}
+
+// RunHeader prints the header of a run, with the URL of its page.
+func (p *Printer) RunHeader(run *Run) {
+	p.print(
+		&Message{Type: TypeRunHeader, Run: run},
+		p.stdout,
+		runLine(run),
+	)
+}
+
+// RunFooter prints the footer of a run, with the URL of its page and the
+// directory of its logs.
+func (p *Printer) RunFooter(run *Run, logDir string) {
+	text := runLine(run)
+	if logDir != "" {
+		text += fmt.Sprintf("%vwandb%v: Find logs at: %v%v%v\n",
+			colorBrightBlue, colorReset, colorBrightMagenta, logDir, colorReset)
+	}
+	p.print(
+		&Message{Type: TypeRunFooter, Run: run, LogDir: logDir},
+		p.stdout,
+		text,
+	)
+}
+
+// Info prints an informational message.
+func (p *Printer) Info(text string) {
+	p.print(&Message{Type: TypeInfo, Message: text}, p.stdout, text+"\n")
+}
+
+// Warn prints a warning.
+func (p *Printer) Warn(text string) {
+	p.print(
+		&Message{Type: TypeWarning, Message: text},
+		p.stderr,
+		fmt.Sprintf("%s: warning: %s\n", p.source, text),
+	)
+}
+
+// Error prints an error.
+func (p *Printer) Error(text string) {
+	p.print(
+		&Message{Type: TypeError, Message: text},
+		p.stderr,
+		fmt.Sprintf("%s: %s\n", p.source, text),
+	)
+}
+
+// Progress prints the progress of a task.
+func (p *Printer) Progress(task string, done int64, total int64) {
+	p.print(
+		&Message{Type: TypeProgress, Progress: &Progress{Task: task, Done: done, Total: total}},
+		p.stderr,
+		fmt.Sprintf("%s: %d/%d\n", task, done, total),
+	)
+}
+
+// Result prints the result of a command, as text in the text mode, and with
+// data in the JSON mode.
+func (p *Printer) Result(name string, text string, data map[string]interface{}) {
+	p.print(
+		&Message{Type: TypeResult, Message: text, Result: &Result{Name: name, Data: data}},
+		p.stdout,
+		text+"\n",
+	)
+}
+
+func (p *Printer) print(message *Message, w io.Writer, text string) {
+	if p.format == FormatJSON {
+		message.Version = SchemaVersion
+		message.Time = p.now().UTC().Format(time.RFC3339Nano)
+		message.Source = p.source
+		data, err := json.Marshal(message)
+		if err != nil {
+			return
+		}
+		text = string(data) + "\n"
+		w = p.stdout
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, _ = io.WriteString(w, text)
+}
+
+const (
+	colorReset         = "\033[0m"
+	colorBrightBlue    = "\033[1;34m"
+	colorBrightMagenta = "\033[1;35m"
+	colorBlue          = "\033[34m"
+	colorYellow        = "\033[33m"
+)
+
+func runLine(run *Run) string {
+	return fmt.Sprintf("%vwandb%v: 🚀 View run %v%v%v at: %v%v%v\n",
+		colorBrightBlue, colorReset, colorYellow, run.Name, colorReset, colorBlue, run.URL, colorReset)
+}
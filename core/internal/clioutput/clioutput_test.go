@@ -0,0 +1,120 @@
+package clioutput_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/clioutput"
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var testRun = &clioutput.Run{
+	ID:      "abc123",
+	Name:    "bright-sun-1",
+	Entity:  "team",
+	Project: "proj",
+	URL:     "https://wandb.ai/team/proj/runs/abc123",
+}
+
+// messageCases print one message of each type
+var messageCases = map[string]func(p *clioutput.Printer){
+	"run_header": func(p *clioutput.Printer) { p.RunHeader(testRun) },
+	"run_footer": func(p *clioutput.Printer) { p.RunFooter(testRun, "wandb/run-abc123/logs") },
+	"info":       func(p *clioutput.Printer) { p.Info("syncing 2 runs") },
+	"warning":    func(p *clioutput.Printer) { p.Warn("skipping run-1: no run record") },
+	"error":      func(p *clioutput.Printer) { p.Error("no runs under dir") },
+	"progress":   func(p *clioutput.Printer) { p.Progress("reading runs", 2, 5) },
+	"result": func(p *clioutput.Printer) {
+		p.Result("import", "imported abc123 to wandb/run-abc123",
+			map[string]interface{}{"run_id": "abc123", "dir": "wandb/run-abc123"})
+	},
+}
+
+func newPrinter(stdout, stderr *bytes.Buffer, format clioutput.Format) *clioutput.Printer {
+	return clioutput.NewPrinter(stdout, stderr, format,
+		clioutput.WithSource("wandb-test"),
+		clioutput.WithClock(func() time.Time {
+			return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		}),
+	)
+}
+
+// assertGolden compares got with the golden file, or writes it with -update
+func assertGolden(t *testing.T, name string, got string) {
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.MkdirAll("testdata", 0755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), got)
+}
+
+func TestMessagesJSON(t *testing.T) {
+	for name, print := range messageCases {
+		t.Run(name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			print(newPrinter(&stdout, &stderr, clioutput.FormatJSON))
+
+			assert.Empty(t, stderr.String())
+			assertGolden(t, name+".json.golden", stdout.String())
+		})
+	}
+}
+
+func TestMessagesText(t *testing.T) {
+	for name, print := range messageCases {
+		t.Run(name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			print(newPrinter(&stdout, &stderr, clioutput.FormatText))
+
+			assertGolden(t, name+".txt.golden",
+				"stdout:\n"+stdout.String()+"stderr:\n"+stderr.String())
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Setenv(clioutput.EnvFormat, "")
+	format, err := clioutput.ParseFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, clioutput.FormatText, format)
+
+	t.Setenv(clioutput.EnvFormat, "json")
+	format, err = clioutput.ParseFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, clioutput.FormatJSON, format)
+
+	format, err = clioutput.ParseFormat("text")
+	assert.NoError(t, err)
+	assert.Equal(t, clioutput.FormatText, format)
+
+	_, err = clioutput.ParseFormat("yaml")
+	assert.Error(t, err)
+}
+
+func TestPrintHeadFoot(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	previous := clioutput.SetDefault(newPrinter(&stdout, &stderr, clioutput.FormatJSON))
+	defer clioutput.SetDefault(previous)
+
+	shared.PrintHeadFoot(
+		&service.RunRecord{RunId: "abc123", DisplayName: proto.String("bright-sun-1"), Entity: "team", Project: "proj"},
+		&service.Settings{BaseUrl: wrapperspb.String("https://api.wandb.ai")},
+		false,
+	)
+	assertGolden(t, "headfoot.json.golden", stdout.String())
+}
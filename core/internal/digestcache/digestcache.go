@@ -0,0 +1,362 @@
+// Package digestcache remembers the MD5 digests of files so that files that
+// did not change since they were last hashed are not read again.
+//
+// Like the git index, an entry is keyed by the absolute path of a file and is
+// valid as long as the size, modification time and inode of the file are the
+// ones it was hashed with. A file that is modified, touched or replaced by
+// another one fails this check and is hashed again.
+//
+// The cache is used by the artifact saver and downloader, which hash every
+// file they upload or check, and check the digests the client gave the files
+// it added to an artifact. Run files are uploaded without a digest, so they
+// do not go through the cache.
+package digestcache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+const (
+	// version is the version of the on-disk format
+	version = 1
+
+	// racyWindow is how long after a file was modified its digest is not
+	// remembered. A file modified again within the resolution of its
+	// modification time would keep the same stat, so a digest computed this
+	// soon could go stale without the entry noticing.
+	racyWindow = 2 * time.Second
+
+	// FileName is the name of the cache file in the cache directory.
+	FileName = "digests.json"
+
+	// maxAge is how long an entry is kept after its file was last hashed.
+	maxAge = 30 * 24 * time.Hour
+
+	// lockTimeout is how long Save waits for another process to finish
+	// saving, and staleLock how old a lock file is when its process is
+	// assumed to have died while holding it.
+	lockTimeout = 5 * time.Second
+	staleLock   = 30 * time.Second
+)
+
+// entry is the digest of a file along with the stat it was computed with.
+type entry struct {
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"mtime"`
+	Inode    uint64 `json:"inode"`
+	Digest   string `json:"digest"`
+	HashedAt int64  `json:"hashed_at"`
+}
+
+// matches returns whether the entry was computed with the given stat
+func (e entry) matches(info os.FileInfo) bool {
+	return e.Size == info.Size() &&
+		e.ModTime == info.ModTime().UnixNano() &&
+		e.Inode == inode(info)
+}
+
+// cacheFile is the on-disk format of the cache.
+type cacheFile struct {
+	Version int              `json:"version"`
+	Entries map[string]entry `json:"entries"`
+}
+
+type Option func(*Cache)
+
+// WithHashFunc sets the function that computes the digest of a file, used in
+// tests.
+func WithHashFunc(hash func(path string) (string, error)) Option {
+	return func(c *Cache) {
+		c.hash = hash
+	}
+}
+
+// WithClock sets the clock of the cache, used in tests.
+func WithClock(now func() time.Time) Option {
+	return func(c *Cache) {
+		c.now = now
+	}
+}
+
+// Cache is a persistent cache of the base64 encoded MD5 digests of files.
+//
+// The cache is read from its file on first use and written back by Save.
+// Several processes may share the same file: Save holds a lock file while it
+// merges the entries on disk with its own, and replaces the file atomically,
+// so that readers never see a partial file and no entry saved by another
+// process is lost. Entries of files that no longer exist or that were hashed
+// too long ago are dropped on save. A nil Cache computes every digest.
+type Cache struct {
+	mu   sync.Mutex
+	path string
+	hash func(path string) (string, error)
+	now  func() time.Time
+
+	loaded  bool
+	dirty   bool
+	entries map[string]entry
+}
+
+// New returns a cache stored in the file at the given path.
+func New(path string, opts ...Option) *Cache {
+	c := &Cache{
+		path:    path,
+		hash:    utils.ComputeFileB64MD5,
+		now:     time.Now,
+		entries: make(map[string]entry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// DefaultPath returns the path of the cache shared by all runs of the user,
+// in $WANDB_CACHE_DIR, or else in the wandb directory of the user cache
+// directory, as the Python SDK does.
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("WANDB_CACHE_DIR"); dir != "" {
+		return filepath.Join(dir, FileName), nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("digestcache: no cache directory: %v", err)
+	}
+	return filepath.Join(dir, "wandb", FileName), nil
+}
+
+// B64MD5 returns the base64 encoded MD5 digest of the file at the given path,
+// computing it only if the file changed since it was last hashed.
+func (c *Cache) B64MD5(path string) (string, error) {
+	if c == nil {
+		return utils.ComputeFileB64MD5(path)
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.load()
+	cached, ok := c.entries[path]
+	c.mu.Unlock()
+	if ok && cached.matches(info) {
+		return cached.Digest, nil
+	}
+
+	hashedAt := c.now()
+	digest, err := c.hash(path)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hashedAt.Sub(info.ModTime()) < racyWindow {
+		delete(c.entries, path)
+	} else {
+		c.entries[path] = entry{
+			Size:     info.Size(),
+			ModTime:  info.ModTime().UnixNano(),
+			Inode:    inode(info),
+			Digest:   digest,
+			HashedAt: hashedAt.UnixNano(),
+		}
+	}
+	c.dirty = true
+	return digest, nil
+}
+
+// Lookup returns the digest of the file at the given path if it did not
+// change since it was last hashed, without reading the file otherwise.
+func (c *Cache) Lookup(path string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	cached, ok := c.entries[path]
+	if !ok || !cached.matches(info) {
+		return "", false
+	}
+	return cached.Digest, true
+}
+
+// Remember records the digest of the file at the given path computed by
+// someone else, such as the client that staged the file.
+func (c *Cache) Remember(path string, digest string) {
+	if c == nil {
+		return
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	hashedAt := c.now()
+	if hashedAt.Sub(info.ModTime()) < racyWindow {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load()
+	c.entries[path] = entry{
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UnixNano(),
+		Inode:    inode(info),
+		Digest:   digest,
+		HashedAt: hashedAt.UnixNano(),
+	}
+	c.dirty = true
+}
+
+// Save writes the cache to its file, keeping the newer of its entries and
+// those saved by others since it was read.
+func (c *Cache) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	unlock, err := lockFile(c.path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if saved, err := readCacheFile(c.path); err == nil {
+		for path, other := range saved.Entries {
+			if own, ok := c.entries[path]; !ok || own.HashedAt < other.HashedAt {
+				c.entries[path] = other
+			}
+		}
+	}
+	c.prune()
+
+	data, err := json.Marshal(cacheFile{Version: version, Entries: c.entries})
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), FileName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
+
+// prune drops the entries of files that no longer exist or that were hashed
+// more than maxAge ago
+func (c *Cache) prune() {
+	oldest := c.now().Add(-maxAge).UnixNano()
+	for path, e := range c.entries {
+		if e.HashedAt < oldest {
+			delete(c.entries, path)
+			continue
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			delete(c.entries, path)
+		}
+	}
+}
+
+// lockFile creates the lock file at the given path, waiting for the process
+// holding it to remove it, and returns the function that removes it. A lock
+// file older than staleLock is removed.
+func lockFile(path string) (func(), error) {
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > staleLock {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("digestcache: timed out waiting for %s", path)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// load reads the cache file the first time it is called. A missing, corrupt
+// or outdated file leaves the cache empty.
+func (c *Cache) load() {
+	if c.loaded {
+		return
+	}
+	c.loaded = true
+	saved, err := readCacheFile(c.path)
+	if err != nil {
+		return
+	}
+	for path, e := range saved.Entries {
+		if _, ok := c.entries[path]; !ok {
+			c.entries[path] = e
+		}
+	}
+}
+
+func readCacheFile(path string) (*cacheFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var saved cacheFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, err
+	}
+	if saved.Version != version {
+		return nil, fmt.Errorf("digestcache: unsupported version %d", saved.Version)
+	}
+	return &saved, nil
+}
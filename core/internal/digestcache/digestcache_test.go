@@ -0,0 +1,334 @@
+package digestcache_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/digestcache"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// countingHash computes digests and counts how many files were read
+type countingHash struct {
+	calls int
+}
+
+func (h *countingHash) hash(path string) (string, error) {
+	h.calls++
+	return utils.ComputeFileB64MD5(path)
+}
+
+// writeOldFile writes a file modified an hour ago, outside the racy window
+func writeOldFile(t *testing.T, path string, data string) time.Time {
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+	return mtime
+}
+
+func TestCachedDigest(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello")
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+
+	want, _ := utils.ComputeFileB64MD5(path)
+	for i := 0; i < 3; i++ {
+		digest, err := cache.B64MD5(path)
+		require.NoError(t, err)
+		assert.Equal(t, want, digest)
+	}
+	assert.Equal(t, 1, h.calls)
+}
+
+func TestModifiedFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	mtime := writeOldFile(t, path, "hello")
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+	_, err := cache.B64MD5(path)
+	require.NoError(t, err)
+
+	// same size, later modification time
+	require.NoError(t, os.WriteFile(path, []byte("world"), 0644))
+	mtime = mtime.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	want, _ := utils.ComputeB64MD5([]byte("world"))
+	assert.Equal(t, want, digest)
+	assert.Equal(t, 2, h.calls)
+}
+
+func TestTouchedFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	mtime := writeOldFile(t, path, "hello")
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+	first, err := cache.B64MD5(path)
+	require.NoError(t, err)
+
+	mtime = mtime.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	assert.Equal(t, first, digest)
+	assert.Equal(t, 2, h.calls)
+
+	// the new modification time is remembered
+	_, err = cache.B64MD5(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, h.calls)
+}
+
+func TestReplacedFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	mtime := writeOldFile(t, path, "hello")
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+	_, err := cache.B64MD5(path)
+	require.NoError(t, err)
+
+	// a file with the same size and modification time moved over the old one
+	other := filepath.Join(dir, "other.txt")
+	require.NoError(t, os.WriteFile(other, []byte("world"), 0644))
+	require.NoError(t, os.Chtimes(other, mtime, mtime))
+	require.NoError(t, os.Rename(other, path))
+
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	want, _ := utils.ComputeB64MD5([]byte("world"))
+	assert.Equal(t, want, digest)
+	assert.Equal(t, 2, h.calls)
+}
+
+func TestRecentlyModifiedFileIsNotCached(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0644))
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.B64MD5(path)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, h.calls)
+}
+
+func TestSaveAndReload(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "cache", "digests.json")
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello")
+
+	cache := digestcache.New(cachePath)
+	_, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	require.NoError(t, cache.Save())
+
+	h := &countingHash{}
+	reloaded := digestcache.New(cachePath, digestcache.WithHashFunc(h.hash))
+	_, err = reloaded.B64MD5(path)
+	require.NoError(t, err)
+	assert.Equal(t, 0, h.calls)
+}
+
+func TestSaveMergesEntries(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "digests.json")
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	writeOldFile(t, a, "a")
+	writeOldFile(t, b, "b")
+
+	// two processes sharing the cache file
+	first := digestcache.New(cachePath)
+	second := digestcache.New(cachePath)
+	_, err := first.B64MD5(a)
+	require.NoError(t, err)
+	_, err = second.B64MD5(b)
+	require.NoError(t, err)
+	require.NoError(t, first.Save())
+	require.NoError(t, second.Save())
+
+	h := &countingHash{}
+	reloaded := digestcache.New(cachePath, digestcache.WithHashFunc(h.hash))
+	for _, path := range []string{a, b} {
+		_, err := reloaded.B64MD5(path)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 0, h.calls)
+}
+
+func TestConcurrentSavesKeepAllEntries(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "digests.json")
+
+	var paths []string
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file%d.txt", i))
+		writeOldFile(t, path, path)
+		paths = append(paths, path)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache := digestcache.New(cachePath)
+			_, err := cache.B64MD5(path)
+			assert.NoError(t, err)
+			assert.NoError(t, cache.Save())
+		}()
+	}
+	wg.Wait()
+
+	h := &countingHash{}
+	reloaded := digestcache.New(cachePath, digestcache.WithHashFunc(h.hash))
+	for _, path := range paths {
+		_, err := reloaded.B64MD5(path)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 0, h.calls)
+	assert.NoFileExists(t, cachePath+".lock")
+}
+
+func TestSaveRemovesStaleLock(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "digests.json")
+	lockPath := cachePath + ".lock"
+	require.NoError(t, os.WriteFile(lockPath, nil, 0644))
+	mtime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(lockPath, mtime, mtime))
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello")
+
+	cache := digestcache.New(cachePath)
+	_, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	require.NoError(t, cache.Save())
+	assert.FileExists(t, cachePath)
+	assert.NoFileExists(t, lockPath)
+}
+
+func TestSavePrunesEntries(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "digests.json")
+	kept := filepath.Join(dir, "kept.txt")
+	removed := filepath.Join(dir, "removed.txt")
+	old := filepath.Join(dir, "old.txt")
+	for _, path := range []string{kept, removed} {
+		writeOldFile(t, path, path)
+	}
+	require.NoError(t, os.WriteFile(old, []byte("old"), 0644))
+	mtime := time.Now().Add(-2 * 365 * 24 * time.Hour)
+	require.NoError(t, os.Chtimes(old, mtime, mtime))
+
+	// an entry saved a year ago
+	yearAgo := func() time.Time { return time.Now().Add(-365 * 24 * time.Hour) }
+	cache := digestcache.New(cachePath, digestcache.WithClock(yearAgo))
+	_, err := cache.B64MD5(old)
+	require.NoError(t, err)
+	require.NoError(t, cache.Save())
+
+	cache = digestcache.New(cachePath)
+	for _, path := range []string{kept, removed} {
+		_, err := cache.B64MD5(path)
+		require.NoError(t, err)
+	}
+	require.NoError(t, os.Remove(removed))
+	require.NoError(t, cache.Save())
+
+	data, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+	var file struct {
+		Entries map[string]interface{} `json:"entries"`
+	}
+	require.NoError(t, json.Unmarshal(data, &file))
+	assert.Contains(t, file.Entries, kept)
+	assert.NotContains(t, file.Entries, removed)
+	assert.NotContains(t, file.Entries, old)
+}
+
+func TestLookupAndRemember(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+	mtime := writeOldFile(t, path, "hello")
+	h := &countingHash{}
+	cache := digestcache.New(filepath.Join(dir, "cache.json"), digestcache.WithHashFunc(h.hash))
+
+	_, ok := cache.Lookup(path)
+	assert.False(t, ok)
+
+	cache.Remember(path, "digest-of-the-client")
+	digest, ok := cache.Lookup(path)
+	assert.True(t, ok)
+	assert.Equal(t, "digest-of-the-client", digest)
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	assert.Equal(t, "digest-of-the-client", digest)
+	assert.Equal(t, 0, h.calls)
+
+	// a changed file is not looked up, and is never read
+	mtime = mtime.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+	_, ok = cache.Lookup(path)
+	assert.False(t, ok)
+	assert.Equal(t, 0, h.calls)
+
+	// a recently modified file is not remembered
+	require.NoError(t, os.WriteFile(path, []byte("world"), 0644))
+	cache.Remember(path, "digest-of-the-client")
+	_, ok = cache.Lookup(path)
+	assert.False(t, ok)
+}
+
+func TestCorruptCacheFile(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "digests.json")
+	require.NoError(t, os.WriteFile(cachePath, []byte("{not json"), 0644))
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello")
+
+	cache := digestcache.New(cachePath)
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	want, _ := utils.ComputeB64MD5([]byte("hello"))
+	assert.Equal(t, want, digest)
+	assert.NoError(t, cache.Save())
+}
+
+func TestNilCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0644))
+
+	var cache *digestcache.Cache
+	digest, err := cache.B64MD5(path)
+	require.NoError(t, err)
+	want, _ := utils.ComputeB64MD5([]byte("hello"))
+	assert.Equal(t, want, digest)
+	assert.NoError(t, cache.Save())
+}
+
+func TestDefaultPath(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("WANDB_CACHE_DIR", dir)
+
+	path, err := digestcache.DefaultPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, digestcache.FileName), path)
+}
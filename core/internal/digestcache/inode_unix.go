@@ -0,0 +1,16 @@
+//go:build !windows
+
+package digestcache
+
+import (
+	"os"
+	"syscall"
+)
+
+// inode returns the inode number of a file, or 0 if it is unknown
+func inode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
@@ -0,0 +1,188 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SymlinkPolicy is what is uploaded for a symlink whose target is outside
+// the root of a FilePolicy.
+type SymlinkPolicy string
+
+const (
+	// SymlinkFollow uploads the target of the symlink
+	SymlinkFollow SymlinkPolicy = "follow"
+
+	// SymlinkCopy uploads a copy of the target made before the upload, so
+	// that the target can change or go away during the upload
+	SymlinkCopy SymlinkPolicy = "copy"
+
+	// SymlinkSkip does not upload the symlink
+	SymlinkSkip SymlinkPolicy = "skip"
+)
+
+// ParseSymlinkPolicy parses a symlink policy, follow if empty. An unknown
+// policy is an error, with the follow policy.
+func ParseSymlinkPolicy(value string) (SymlinkPolicy, error) {
+	switch policy := SymlinkPolicy(value); policy {
+	case "":
+		return SymlinkFollow, nil
+	case SymlinkFollow, SymlinkCopy, SymlinkSkip:
+		return policy, nil
+	default:
+		return SymlinkFollow, fmt.Errorf("filetransfer: unknown symlink policy %q", value)
+	}
+}
+
+// The reasons of a SkipError.
+const (
+	// SkipSymlink is for symlinks under the skip policy
+	SkipSymlink = "symlink"
+
+	// SkipSpecial is for files that are not regular files, such as FIFOs,
+	// devices, sockets and directories
+	SkipSpecial = "special"
+
+	// SkipVanished is for files deleted before they could be read
+	SkipVanished = "vanished"
+)
+
+// SkipError is the error of a file that is not uploaded on purpose, which
+// is not a failure of the upload.
+type SkipError struct {
+	Path   string
+	Reason string
+}
+
+func (e *SkipError) Error() string {
+	return fmt.Sprintf("filetransfer: skipped %s: %s", e.Path, e.Reason)
+}
+
+// AsSkipError returns the SkipError of err, or nil if it is another error.
+func AsSkipError(err error) *SkipError {
+	var skipErr *SkipError
+	if errors.As(err, &skipErr) {
+		return skipErr
+	}
+	return nil
+}
+
+// FilePolicy decides what happens to the files to upload that are not
+// regular files. A nil FilePolicy follows all symlinks.
+//
+// Symlinks whose target is inside Root are always followed, the others
+// according to Symlinks. Special files are refused without being opened,
+// since reading a FIFO would block the upload forever. Files that do not
+// exist anymore, including dangling symlinks, are skipped.
+type FilePolicy struct {
+	Symlinks SymlinkPolicy
+
+	// Root is the directory of the run files, empty if there is none
+	Root string
+
+	// CopyDir is where the copies of the copy policy are made, the default
+	// directory for temporary files if empty
+	CopyDir string
+}
+
+// Resolve checks the file at path before it is read.
+//
+// It returns the path to read, which is path itself or, under the copy
+// policy, a copy of the target of a symlink, and a function that removes
+// the copy. It returns a *SkipError if the file must not be uploaded.
+func (p *FilePolicy) Resolve(path string) (string, func(), error) {
+	noCleanup := func() {}
+
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipVanished}
+	}
+	if err != nil {
+		return "", noCleanup, err
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		if !info.Mode().IsRegular() {
+			return "", noCleanup, &SkipError{Path: path, Reason: SkipSpecial}
+		}
+		return path, noCleanup, nil
+	}
+
+	target, err := filepath.EvalSymlinks(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipVanished}
+	}
+	if err != nil {
+		return "", noCleanup, err
+	}
+	policy := p.symlinkPolicy(target)
+	if policy == SymlinkSkip {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipSymlink}
+	}
+	info, err = os.Stat(target)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipVanished}
+	}
+	if err != nil {
+		return "", noCleanup, err
+	}
+	if !info.Mode().IsRegular() {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipSpecial}
+	}
+	if policy == SymlinkFollow {
+		return path, noCleanup, nil
+	}
+
+	copyPath, err := p.copy(target)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", noCleanup, &SkipError{Path: path, Reason: SkipVanished}
+	}
+	if err != nil {
+		return "", noCleanup, err
+	}
+	return copyPath, func() { _ = os.Remove(copyPath) }, nil
+}
+
+// symlinkPolicy returns the policy of a symlink to target
+func (p *FilePolicy) symlinkPolicy(target string) SymlinkPolicy {
+	if p == nil || p.Symlinks == "" {
+		return SymlinkFollow
+	}
+	if p.Root != "" {
+		root, err := filepath.EvalSymlinks(p.Root)
+		if err == nil {
+			rel, err := filepath.Rel(root, target)
+			if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return SymlinkFollow
+			}
+		}
+	}
+	return p.Symlinks
+}
+
+// copy copies the file at path to a new file in CopyDir
+func (p *FilePolicy) copy(path string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(p.CopyDir, "upload-*"+filepath.Ext(path))
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(dst, src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
@@ -0,0 +1,107 @@
+package filetransfer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+// resolve resolves path, and returns the skip reason or the content read
+func resolve(t *testing.T, policy *filetransfer.FilePolicy, path string) (string, string) {
+	readPath, cleanup, err := policy.Resolve(path)
+	defer cleanup()
+	if skipErr := filetransfer.AsSkipError(err); skipErr != nil {
+		return skipErr.Reason, ""
+	}
+	require.NoError(t, err)
+	content, err := os.ReadFile(readPath)
+	require.NoError(t, err)
+	return "", string(content)
+}
+
+// makeFiles makes a files dir with a regular file and symlinks to a file
+// inside and outside of it
+func makeFiles(t *testing.T) (string, string) {
+	root := t.TempDir()
+	outside := filepath.Join(t.TempDir(), "outside.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("outside"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "inside.txt"), []byte("inside"), 0644))
+	require.NoError(t, os.Symlink(filepath.Join(root, "inside.txt"), filepath.Join(root, "link-inside")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "link-outside")))
+	require.NoError(t, os.Symlink(filepath.Join(root, "missing.txt"), filepath.Join(root, "dangling")))
+	require.NoError(t, os.Mkdir(filepath.Join(root, "dir"), 0755))
+	return root, outside
+}
+
+func TestParseSymlinkPolicy(t *testing.T) {
+	policy, err := filetransfer.ParseSymlinkPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, filetransfer.SymlinkFollow, policy)
+
+	policy, err = filetransfer.ParseSymlinkPolicy("copy")
+	assert.NoError(t, err)
+	assert.Equal(t, filetransfer.SymlinkCopy, policy)
+
+	policy, err = filetransfer.ParseSymlinkPolicy("hardlink")
+	assert.Error(t, err)
+	assert.Equal(t, filetransfer.SymlinkFollow, policy)
+}
+
+func TestFilePolicy_Resolve(t *testing.T) {
+	root, _ := makeFiles(t)
+	tests := []struct {
+		name    string
+		policy  filetransfer.SymlinkPolicy
+		file    string
+		skipped string
+		content string
+	}{
+		{"regular file", filetransfer.SymlinkSkip, "inside.txt", "", "inside"},
+		{"symlink inside, skip", filetransfer.SymlinkSkip, "link-inside", "", "inside"},
+		{"symlink outside, follow", filetransfer.SymlinkFollow, "link-outside", "", "outside"},
+		{"symlink outside, copy", filetransfer.SymlinkCopy, "link-outside", "", "outside"},
+		{"symlink outside, skip", filetransfer.SymlinkSkip, "link-outside", filetransfer.SkipSymlink, ""},
+		{"dangling symlink", filetransfer.SymlinkFollow, "dangling", filetransfer.SkipVanished, ""},
+		{"missing file", filetransfer.SymlinkFollow, "missing.txt", filetransfer.SkipVanished, ""},
+		{"directory", filetransfer.SymlinkFollow, "dir", filetransfer.SkipSpecial, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &filetransfer.FilePolicy{Symlinks: tt.policy, Root: root}
+			skipped, content := resolve(t, policy, filepath.Join(root, tt.file))
+			assert.Equal(t, tt.skipped, skipped)
+			assert.Equal(t, tt.content, content)
+		})
+	}
+}
+
+func TestFilePolicy_Copy(t *testing.T) {
+	root, outside := makeFiles(t)
+	copyDir := t.TempDir()
+	policy := &filetransfer.FilePolicy{Symlinks: filetransfer.SymlinkCopy, Root: root, CopyDir: copyDir}
+
+	readPath, cleanup, err := policy.Resolve(filepath.Join(root, "link-outside"))
+	require.NoError(t, err)
+	assert.Equal(t, copyDir, filepath.Dir(readPath))
+
+	// the copy does not change with the target
+	require.NoError(t, os.WriteFile(outside, []byte("changed"), 0644))
+	content, err := os.ReadFile(readPath)
+	require.NoError(t, err)
+	assert.Equal(t, "outside", string(content))
+
+	cleanup()
+	assert.NoFileExists(t, readPath)
+}
+
+func TestFilePolicy_Nil(t *testing.T) {
+	root, _ := makeFiles(t)
+	var policy *filetransfer.FilePolicy
+	_, content := resolve(t, policy, filepath.Join(root, "link-outside"))
+	assert.Equal(t, "outside", content)
+}
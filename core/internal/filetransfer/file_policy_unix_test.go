@@ -0,0 +1,92 @@
+//go:build !windows
+
+package filetransfer_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestFilePolicy_SpecialFiles(t *testing.T) {
+	root := t.TempDir()
+	fifo := filepath.Join(root, "fifo")
+	require.NoError(t, syscall.Mkfifo(fifo, 0644))
+	// unix socket paths are limited to about 100 bytes
+	socketDir, err := os.MkdirTemp("", "sock")
+	require.NoError(t, err)
+	defer os.RemoveAll(socketDir)
+	listener, err := net.Listen("unix", filepath.Join(socketDir, "s"))
+	require.NoError(t, err)
+	defer listener.Close()
+	require.NoError(t, os.Symlink(fifo, filepath.Join(root, "link-fifo")))
+
+	policy := &filetransfer.FilePolicy{Symlinks: filetransfer.SymlinkCopy, Root: root}
+	for _, path := range []string{
+		fifo,
+		filepath.Join(socketDir, "s"),
+		"/dev/null",
+		filepath.Join(root, "link-fifo"),
+	} {
+		skipped, _ := resolve(t, policy, path)
+		assert.Equal(t, filetransfer.SkipSpecial, skipped, path)
+	}
+}
+
+func TestUpload_SkippedFiles(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, syscall.Mkfifo(filepath.Join(root, "fifo"), 0644))
+	outside := filepath.Join(t.TempDir(), "outside.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("outside"), 0644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "link")))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "data.txt"), []byte("data"), 0644))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := retryablehttp.NewClient()
+	client.Logger = nil
+	logger := observability.NewNoOpLogger()
+	policy := &filetransfer.FilePolicy{Symlinks: filetransfer.SymlinkSkip, Root: root}
+	manager := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(logger),
+		filetransfer.WithFileTransfer(
+			filetransfer.NewDefaultFileTransfer(logger, client, filetransfer.WithFilePolicy(policy)),
+		),
+	)
+	manager.Start()
+
+	var tasks []*filetransfer.Task
+	for _, name := range []string{"fifo", "link", "data.txt", "vanished.txt"} {
+		task := &filetransfer.Task{Type: filetransfer.UploadTask, Path: filepath.Join(root, name), Url: server.URL}
+		tasks = append(tasks, task)
+		manager.AddTask(task)
+	}
+	manager.Close()
+
+	assert.Equal(t, filetransfer.SkipSpecial, filetransfer.AsSkipError(tasks[0].Err).Reason)
+	assert.Equal(t, filetransfer.SkipSymlink, filetransfer.AsSkipError(tasks[1].Err).Reason)
+	assert.NoError(t, tasks[2].Err)
+	assert.Equal(t, filetransfer.SkipVanished, filetransfer.AsSkipError(tasks[3].Err).Reason)
+
+	progress := manager.Progress().Progress()
+	assert.Empty(t, progress.Files)
+	assert.Equal(t, int32(1), progress.DoneFiles)
+	assert.Equal(t, map[string]int32{
+		filetransfer.SkipSpecial:  1,
+		filetransfer.SkipSymlink:  1,
+		filetransfer.SkipVanished: 1,
+	}, progress.SkippedFiles)
+}
@@ -1,9 +1,9 @@
 package filetransfer
 
 import (
-	"fmt"
+	"errors"
 	"io"
-	"math"
+	"io/fs"
 	"net/http"
 	"os"
 	"path"
@@ -20,14 +20,35 @@ type DefaultFileTransfer struct {
 
 	// logger is the logger for the file transfer
 	logger *observability.CoreLogger
+
+	// filePolicy decides what happens to the files to upload that are not
+	// regular files
+	filePolicy *FilePolicy
+}
+
+type DefaultFileTransferOption func(ft *DefaultFileTransfer)
+
+// WithFilePolicy sets the policy of the symlinks and special files to
+// upload, which follows all symlinks by default.
+func WithFilePolicy(policy *FilePolicy) DefaultFileTransferOption {
+	return func(ft *DefaultFileTransfer) {
+		ft.filePolicy = policy
+	}
 }
 
 // NewDefaultFileTransfer creates a new fileTransfer
-func NewDefaultFileTransfer(logger *observability.CoreLogger, client *retryablehttp.Client) *DefaultFileTransfer {
+func NewDefaultFileTransfer(
+	logger *observability.CoreLogger,
+	client *retryablehttp.Client,
+	opts ...DefaultFileTransferOption,
+) *DefaultFileTransfer {
 	fileTransfer := &DefaultFileTransfer{
 		logger: logger,
 		client: client,
 	}
+	for _, opt := range opts {
+		opt(fileTransfer)
+	}
 	return fileTransfer
 }
 
@@ -35,8 +56,18 @@ func NewDefaultFileTransfer(logger *observability.CoreLogger, client *retryableh
 func (ft *DefaultFileTransfer) Upload(task *Task) error {
 	ft.logger.Debug("default file transfer: uploading file", "path", task.Path, "url", task.Url)
 
+	// check the kind of the file before opening it, which blocks for FIFOs
+	readPath, cleanup, err := ft.filePolicy.Resolve(task.Path)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
 	// open the file for reading and defer closing it
-	file, err := os.Open(task.Path)
+	file, err := os.Open(readPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &SkipError{Path: task.Path, Reason: SkipVanished}
+	}
 	if err != nil {
 		return err
 	}
@@ -54,22 +85,32 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 	}
 	task.Size = stat.Size()
 
-	progressReader, err := NewProgressReader(file, task.Size, task.ProgressCallback)
-	if err != nil {
-		return err
-	}
+	progressReader := NewProgressReader(file, task.Size, task.ProgressCallback)
+	progressReader.retryCallback = task.RetryCallback
 	req, err := retryablehttp.NewRequest(http.MethodPut, task.Url, progressReader)
 	if err != nil {
 		return err
 	}
+	// set here rather than from a Len method, which would overflow for
+	// files larger than 2 GiB on 32-bit platforms
+	req.ContentLength = task.Size
 	for _, header := range task.Headers {
 		parts := strings.Split(header, ":")
 		req.Header.Set(parts[0], parts[1])
 	}
 
-	if _, err = ft.client.Do(req); err != nil {
+	resp, err := ft.client.Do(req)
+	if err != nil {
 		return err
 	}
+	// read the response to the end, so that the connection is kept alive for
+	// the next upload rather than left open for good
+	defer func(Body io.ReadCloser) {
+		if err := Body.Close(); err != nil {
+			ft.logger.CaptureError("file transfer: upload: error closing response body", err, "path", task.Path)
+		}
+	}(resp.Body)
+	_, _ = io.Copy(io.Discard, resp.Body)
 
 	return nil
 }
@@ -113,29 +154,33 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 			ft.logger.CaptureError("file transfer: download: error closing response reader", err, "path", task.Path)
 		}
 	}(resp.Body)
-	_, err = io.Copy(file, resp.Body)
+	n, err := io.Copy(file, resp.Body)
 	if err != nil {
 		return err
 	}
+	task.Size = n
 	return nil
 }
 
+// ProgressReader reads a file to upload and reports the bytes read so far.
+//
+// The HTTP client seeks it to the start before each attempt of a request,
+// which resets the progress.
 type ProgressReader struct {
 	*os.File
-	len      int
-	read     int
-	callback func(processed, total int)
+	len           int64
+	read          int64
+	attempts      int
+	callback      func(processed, total int64)
+	retryCallback func()
 }
 
-func NewProgressReader(file *os.File, size int64, callback func(processed, total int)) (*ProgressReader, error) {
-	if size > math.MaxInt {
-		return &ProgressReader{}, fmt.Errorf("file larger than %v", math.MaxInt)
-	}
+func NewProgressReader(file *os.File, size int64, callback func(processed, total int64)) *ProgressReader {
 	return &ProgressReader{
 		File:     file,
-		len:      int(size),
+		len:      size,
 		callback: callback,
-	}, nil
+	}
 }
 
 func (pr *ProgressReader) Read(p []byte) (int, error) {
@@ -144,13 +189,25 @@ func (pr *ProgressReader) Read(p []byte) (int, error) {
 		return n, err // Return early if there's an error
 	}
 
-	pr.read += n
+	pr.read += int64(n)
 	if pr.callback != nil {
 		pr.callback(pr.read, pr.len)
 	}
 	return n, err
 }
 
-func (pr *ProgressReader) Len() int {
-	return pr.len
+// Seek seeks the file. Seeking to the start begins a new attempt.
+func (pr *ProgressReader) Seek(offset int64, whence int) (int64, error) {
+	position, err := pr.File.Seek(offset, whence)
+	if err != nil {
+		return position, err
+	}
+	if offset == 0 && whence == io.SeekStart {
+		pr.read = 0
+		pr.attempts++
+		if pr.attempts > 1 && pr.retryCallback != nil {
+			pr.retryCallback()
+		}
+	}
+	return position, nil
 }
@@ -42,6 +42,9 @@ type FileTransferManager struct {
 	// logger is the logger for the file transfer
 	logger *observability.CoreLogger
 
+	// progress tracks the progress of each task
+	progress *ProgressTracker
+
 	// wg is the wait group
 	wg *sync.WaitGroup
 }
@@ -72,6 +75,14 @@ func WithFSCChan(fsChan chan protoreflect.ProtoMessage) FileTransferManagerOptio
 	}
 }
 
+// WithProgressTracker sets the tracker of the progress of the tasks, so
+// that it can be shared with the handler.
+func WithProgressTracker(progress *ProgressTracker) FileTransferManagerOption {
+	return func(fm *FileTransferManager) {
+		fm.progress = progress
+	}
+}
+
 func NewFileTransferManager(opts ...FileTransferManagerOption) *FileTransferManager {
 
 	fm := FileTransferManager{
@@ -83,6 +94,9 @@ func NewFileTransferManager(opts ...FileTransferManagerOption) *FileTransferMana
 	for _, opt := range opts {
 		opt(&fm)
 	}
+	if fm.progress == nil {
+		fm.progress = NewProgressTracker()
+	}
 
 	return &fm
 }
@@ -99,10 +113,17 @@ func (fm *FileTransferManager) Start() {
 			go func(task *Task) {
 				// Acquire the semaphore
 				fm.semaphore <- struct{}{}
+				fm.progress.Start(task)
 				task.Err = fm.transfer(task)
 				// Release the semaphore
 				<-fm.semaphore
-				if task.Err != nil {
+				fm.progress.Finish(task, task.Err)
+				if skipErr := AsSkipError(task.Err); skipErr != nil {
+					fm.logger.Info(
+						"filetransfer: skipped file",
+						"path", task.Path, "reason", skipErr.Reason,
+					)
+				} else if task.Err != nil {
 					fm.logger.CaptureError(
 						"filetransfer: uploader: error uploading",
 						task.Err,
@@ -124,9 +145,38 @@ func (fm *FileTransferManager) Start() {
 // AddTask adds a task to the fileTransfer
 func (fm *FileTransferManager) AddTask(task *Task) {
 	fm.logger.Debug("fileTransfer: adding upload task", "path", task.Path, "url", task.Url)
+	fm.trackProgress(task)
 	fm.inChan <- task
 }
 
+// trackProgress adds a task to the progress tracker, and chains the
+// tracker to the progress and retry callbacks of the task
+func (fm *FileTransferManager) trackProgress(task *Task) {
+	fm.progress.Add(task)
+	progressCallback := task.ProgressCallback
+	task.ProgressCallback = func(processed, total int64) {
+		fm.progress.Update(task, processed, total)
+		if progressCallback != nil {
+			progressCallback(processed, total)
+		}
+	}
+	retryCallback := task.RetryCallback
+	task.RetryCallback = func() {
+		fm.progress.Retry(task)
+		if retryCallback != nil {
+			retryCallback()
+		}
+	}
+}
+
+// Progress returns the tracker of the progress of the tasks.
+func (fm *FileTransferManager) Progress() *ProgressTracker {
+	if fm == nil {
+		return nil
+	}
+	return fm.progress
+}
+
 // FileStreamCallback returns a callback for filestream updates
 func (fm *FileTransferManager) FileStreamCallback() func(task *Task) {
 	return func(task *Task) {
@@ -0,0 +1,274 @@
+package filetransfer
+
+import (
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// rateInterval is the shortest interval over which a rate is sampled
+	rateInterval = time.Second
+
+	// rateSmoothing is the weight of the last sample in a smoothed rate
+	rateSmoothing = 0.3
+)
+
+// rateMeter is an exponentially smoothed transfer rate.
+type rateMeter struct {
+	// rate is the smoothed rate, in bytes per second
+	rate    float64
+	sampled bool
+	// pending are the bytes transferred since the last sample
+	pending int64
+	last    time.Time
+}
+
+// add records bytes transferred at now, and samples the rate if the last
+// sample is at least rateInterval old. Adding 0 bytes lowers the rate of a
+// stalled transfer.
+func (m *rateMeter) add(bytes int64, now time.Time) {
+	if m.last.IsZero() {
+		m.last = now
+	}
+	m.pending += bytes
+	elapsed := now.Sub(m.last)
+	if elapsed < rateInterval {
+		return
+	}
+	sample := float64(m.pending) / elapsed.Seconds()
+	if m.sampled {
+		m.rate = rateSmoothing*sample + (1-rateSmoothing)*m.rate
+	} else {
+		m.rate = sample
+		m.sampled = true
+	}
+	m.pending = 0
+	m.last = now
+}
+
+// eta returns the seconds left to transfer the remaining bytes, or -1 if
+// the rate is not known yet.
+func (m *rateMeter) eta(remaining int64) float64 {
+	switch {
+	case remaining <= 0:
+		return 0
+	case m.rate <= 0:
+		return -1
+	default:
+		return float64(remaining) / m.rate
+	}
+}
+
+// fileProgress is the progress of one transfer.
+type fileProgress struct {
+	status *service.FileTransferStatus
+	meter  rateMeter
+}
+
+type ProgressTrackerOption func(*ProgressTracker)
+
+// WithProgressClock sets the clock of the tracker, used in tests.
+func WithProgressClock(now func() time.Time) ProgressTrackerOption {
+	return func(t *ProgressTracker) {
+		t.now = now
+	}
+}
+
+// ProgressTracker keeps the state, bytes, rate and retries of each file
+// transfer, and estimates the time left from their smoothed rates.
+//
+// It is safe for concurrent use. A nil tracker ignores all updates.
+type ProgressTracker struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	files map[*Task]*fileProgress
+	// active are the transfers not done yet, in the order they were added
+	active []*fileProgress
+
+	doneFiles int32
+	doneBytes int64
+	meter     rateMeter
+
+	// skipped are the numbers of skipped files, by reason
+	skipped map[string]int32
+}
+
+func NewProgressTracker(opts ...ProgressTrackerOption) *ProgressTracker {
+	t := &ProgressTracker{
+		now:     time.Now,
+		files:   make(map[*Task]*fileProgress),
+		skipped: make(map[string]int32),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Add tracks a queued task. The size of an upload is read from its file if
+// the task does not have it.
+func (t *ProgressTracker) Add(task *Task) {
+	if t == nil {
+		return
+	}
+	size := task.Size
+	if size == 0 && task.Type == UploadTask {
+		if info, err := os.Stat(task.Path); err == nil {
+			size = info.Size()
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	file := &fileProgress{
+		status: &service.FileTransferStatus{
+			Path:       task.Path,
+			Name:       task.Name,
+			State:      service.FileTransferStatus_QUEUED,
+			Size:       size,
+			EtaSeconds: -1,
+		},
+	}
+	t.files[task] = file
+	t.active = append(t.active, file)
+}
+
+// Start marks a task as transferring.
+func (t *ProgressTracker) Start(task *Task) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if file, ok := t.files[task]; ok {
+		file.status.State = service.FileTransferStatus_TRANSFERRING
+		file.meter.add(0, t.now())
+	}
+}
+
+// Update records the bytes of a task transferred so far, out of total.
+func (t *ProgressTracker) Update(task *Task, transferred int64, total int64) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	file, ok := t.files[task]
+	if !ok {
+		return
+	}
+	now := t.now()
+	if delta := transferred - file.status.TransferredBytes; delta > 0 {
+		file.meter.add(delta, now)
+		t.meter.add(delta, now)
+	}
+	file.status.TransferredBytes = transferred
+	if total > 0 {
+		file.status.Size = total
+	}
+}
+
+// Retry records that the transfer of a task restarted from the beginning.
+func (t *ProgressTracker) Retry(task *Task) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if file, ok := t.files[task]; ok {
+		file.status.Retries++
+		file.status.TransferredBytes = 0
+	}
+}
+
+// Finish marks a task as done, or as failed if err is not nil. Done and
+// skipped tasks are only counted from then on.
+func (t *ProgressTracker) Finish(task *Task, err error) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	file, ok := t.files[task]
+	if !ok {
+		return
+	}
+	if skipErr := AsSkipError(err); skipErr != nil {
+		t.skipped[skipErr.Reason]++
+		delete(t.files, task)
+		t.active = slices.DeleteFunc(t.active, func(f *fileProgress) bool { return f == file })
+		return
+	}
+	if err != nil {
+		file.status.State = service.FileTransferStatus_FAILED
+		file.status.Error = err.Error()
+		file.status.BytesPerSecond = 0
+		file.status.EtaSeconds = -1
+		return
+	}
+	size := max(task.Size, file.status.Size, file.status.TransferredBytes)
+	if delta := size - file.status.TransferredBytes; delta > 0 {
+		t.meter.add(delta, t.now())
+	}
+	t.doneFiles++
+	t.doneBytes += size
+	delete(t.files, task)
+	t.active = slices.DeleteFunc(t.active, func(f *fileProgress) bool { return f == file })
+}
+
+// Skip counts a file that was skipped before it was queued.
+func (t *ProgressTracker) Skip(err *SkipError) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.skipped[err.Reason]++
+}
+
+// Progress returns the progress of the transfers not done yet, and the
+// totals of all the transfers.
+func (t *ProgressTracker) Progress() *service.FileTransferProgressResponse {
+	response := &service.FileTransferProgressResponse{EtaSeconds: -1}
+	if t == nil {
+		return response
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+
+	response.DoneFiles = t.doneFiles
+	if len(t.skipped) > 0 {
+		response.SkippedFiles = maps.Clone(t.skipped)
+	}
+	response.TransferredBytes = t.doneBytes
+	response.TotalBytes = t.doneBytes
+	for _, file := range t.active {
+		status := file.status
+		if status.State == service.FileTransferStatus_TRANSFERRING {
+			file.meter.add(0, now)
+			status.BytesPerSecond = file.meter.rate
+			status.EtaSeconds = file.meter.eta(status.Size - status.TransferredBytes)
+		}
+		response.Files = append(response.Files, proto.Clone(status).(*service.FileTransferStatus))
+
+		response.TransferredBytes += status.TransferredBytes
+		if status.State == service.FileTransferStatus_FAILED {
+			response.TotalBytes += status.TransferredBytes
+		} else {
+			response.TotalBytes += max(status.Size, status.TransferredBytes)
+		}
+	}
+	t.meter.add(0, now)
+	response.BytesPerSecond = t.meter.rate
+	response.EtaSeconds = t.meter.eta(response.TotalBytes - response.TransferredBytes)
+	return response
+}
@@ -0,0 +1,163 @@
+package filetransfer_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestProgressTracker(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	tracker := filetransfer.NewProgressTracker(filetransfer.WithProgressClock(clock.Now))
+	big := &filetransfer.Task{Type: filetransfer.UploadTask, Path: "big.bin", Size: 3 << 30}
+	small := &filetransfer.Task{Type: filetransfer.UploadTask, Path: "small.bin", Size: 1000}
+	tracker.Add(big)
+	tracker.Add(small)
+
+	progress := tracker.Progress()
+	require.Len(t, progress.Files, 2)
+	assert.Equal(t, service.FileTransferStatus_QUEUED, progress.Files[0].State)
+	assert.Equal(t, int64(3<<30)+1000, progress.TotalBytes)
+	assert.Equal(t, -1.0, progress.EtaSeconds)
+
+	tracker.Start(big)
+	clock.Advance(time.Second)
+	tracker.Update(big, 1<<30, 3<<30)
+	clock.Advance(time.Second)
+	tracker.Update(big, 1<<30+1<<29, 3<<30)
+
+	progress = tracker.Progress()
+	file := progress.Files[0]
+	assert.Equal(t, service.FileTransferStatus_TRANSFERRING, file.State)
+	assert.Equal(t, int64(1<<30+1<<29), file.TransferredBytes)
+	// the rate is smoothed: 0.3 * 0.5 GiB/s + 0.7 * 1 GiB/s
+	assert.InDelta(t, 0.85*(1<<30), file.BytesPerSecond, 1)
+	assert.InDelta(t, float64(1<<30+1<<29)/(0.85*(1<<30)), file.EtaSeconds, 1e-6)
+	assert.InDelta(t, 0.85*(1<<30), progress.BytesPerSecond, 1)
+
+	tracker.Retry(big)
+	progress = tracker.Progress()
+	assert.Equal(t, int32(1), progress.Files[0].Retries)
+	assert.Zero(t, progress.Files[0].TransferredBytes)
+
+	tracker.Finish(big, nil)
+	tracker.Start(small)
+	tracker.Finish(small, errors.New("forbidden"))
+
+	progress = tracker.Progress()
+	assert.Equal(t, int32(1), progress.DoneFiles)
+	require.Len(t, progress.Files, 1)
+	assert.Equal(t, service.FileTransferStatus_FAILED, progress.Files[0].State)
+	assert.Equal(t, "forbidden", progress.Files[0].Error)
+	assert.Equal(t, int64(3<<30), progress.TransferredBytes)
+	assert.Equal(t, int64(3<<30), progress.TotalBytes)
+	assert.Zero(t, progress.EtaSeconds)
+}
+
+func TestProgressTracker_Nil(t *testing.T) {
+	var tracker *filetransfer.ProgressTracker
+	task := &filetransfer.Task{}
+	tracker.Add(task)
+	tracker.Update(task, 1, 2)
+	tracker.Finish(task, nil)
+	assert.Equal(t, -1.0, tracker.Progress().EtaSeconds)
+}
+
+func TestProgressReader_LargeFile(t *testing.T) {
+	file, err := os.Open(writeFile(t, "data"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	var processed, total int64
+	reader := filetransfer.NewProgressReader(file, 5<<30, func(p, t int64) {
+		processed, total = p, t
+	})
+	_, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), processed)
+	assert.Equal(t, int64(5<<30), total)
+}
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestFileTransferManager_Progress(t *testing.T) {
+	var attempts atomic.Int32
+	var contentLength atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		contentLength.Store(r.ContentLength)
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := retryablehttp.NewClient()
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+	client.Logger = nil
+	logger := observability.NewNoOpLogger()
+	tracker := filetransfer.NewProgressTracker()
+	manager := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(logger),
+		filetransfer.WithFileTransfer(filetransfer.NewDefaultFileTransfer(logger, client)),
+		filetransfer.WithProgressTracker(tracker),
+	)
+	manager.Start()
+
+	var processed atomic.Int64
+	task := &filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: writeFile(t, "checkpoint"),
+		Url:  server.URL,
+	}
+	task.SetProgressCallback(func(p, _ int64) { processed.Store(p) })
+	var retries atomic.Int32
+	task.RetryCallback = func() { retries.Add(1) }
+	manager.AddTask(task)
+	manager.Close()
+
+	require.NoError(t, task.Err)
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.Equal(t, int64(10), contentLength.Load())
+	assert.Equal(t, int64(10), processed.Load())
+	assert.Equal(t, int32(1), retries.Load())
+
+	progress := manager.Progress().Progress()
+	assert.Empty(t, progress.Files)
+	assert.Equal(t, int32(1), progress.DoneFiles)
+	assert.Equal(t, int64(10), progress.TransferredBytes)
+	assert.Equal(t, int64(10), progress.TotalBytes)
+}
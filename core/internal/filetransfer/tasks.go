@@ -45,11 +45,16 @@ type Task struct {
 	// Callback to execute after completion (success or failure).
 	CompletionCallback []func(*Task)
 
-	// ProgressCallback is a callback to execute on progress updates
-	ProgressCallback func(int, int)
+	// ProgressCallback is a callback to execute on progress updates, with
+	// the bytes processed and the size of the file
+	ProgressCallback func(int64, int64)
+
+	// RetryCallback is a callback to execute when the transfer restarts
+	// from the beginning after a failed attempt
+	RetryCallback func()
 }
 
-func (ut *Task) SetProgressCallback(callback func(int, int)) {
+func (ut *Task) SetProgressCallback(callback func(int64, int64)) {
 	ut.ProgressCallback = callback
 }
 
@@ -4,6 +4,7 @@ package gql
 
 import (
 	"context"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -517,6 +518,46 @@ func (v *NotifyScriptableRunAlertResponse) GetNotifyScriptableRunAlert() *Notify
 	return v.NotifyScriptableRunAlert
 }
 
+// RunMetadataProject includes the requested fields of the GraphQL type Project.
+type RunMetadataProject struct {
+	Run *RunMetadataProjectRun `json:"run"`
+}
+
+// GetRun returns RunMetadataProject.Run, and is useful for accessing the field via an interface.
+func (v *RunMetadataProject) GetRun() *RunMetadataProjectRun { return v.Run }
+
+// RunMetadataProjectRun includes the requested fields of the GraphQL type Run.
+type RunMetadataProjectRun struct {
+	DisplayName *string    `json:"displayName"`
+	Notes       *string    `json:"notes"`
+	Group       *string    `json:"group"`
+	Tags        []string   `json:"tags"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+// GetDisplayName returns RunMetadataProjectRun.DisplayName, and is useful for accessing the field via an interface.
+func (v *RunMetadataProjectRun) GetDisplayName() *string { return v.DisplayName }
+
+// GetNotes returns RunMetadataProjectRun.Notes, and is useful for accessing the field via an interface.
+func (v *RunMetadataProjectRun) GetNotes() *string { return v.Notes }
+
+// GetGroup returns RunMetadataProjectRun.Group, and is useful for accessing the field via an interface.
+func (v *RunMetadataProjectRun) GetGroup() *string { return v.Group }
+
+// GetTags returns RunMetadataProjectRun.Tags, and is useful for accessing the field via an interface.
+func (v *RunMetadataProjectRun) GetTags() []string { return v.Tags }
+
+// GetUpdatedAt returns RunMetadataProjectRun.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *RunMetadataProjectRun) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+
+// RunMetadataResponse is returned by RunMetadata on success.
+type RunMetadataResponse struct {
+	Project *RunMetadataProject `json:"project"`
+}
+
+// GetProject returns RunMetadataResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunMetadataResponse) GetProject() *RunMetadataProject { return v.Project }
+
 // RunResumeStatusModelProject includes the requested fields of the GraphQL type Project.
 type RunResumeStatusModelProject struct {
 	Id     string                                `json:"id"`
@@ -607,6 +648,30 @@ type RunResumeStatusResponse struct {
 // GetModel returns RunResumeStatusResponse.Model, and is useful for accessing the field via an interface.
 func (v *RunResumeStatusResponse) GetModel() *RunResumeStatusModelProject { return v.Model }
 
+// RunStoppedStatusProject includes the requested fields of the GraphQL type Project.
+type RunStoppedStatusProject struct {
+	Run *RunStoppedStatusProjectRun `json:"run"`
+}
+
+// GetRun returns RunStoppedStatusProject.Run, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusProject) GetRun() *RunStoppedStatusProjectRun { return v.Run }
+
+// RunStoppedStatusProjectRun includes the requested fields of the GraphQL type Run.
+type RunStoppedStatusProjectRun struct {
+	Stopped *bool `json:"stopped"`
+}
+
+// GetStopped returns RunStoppedStatusProjectRun.Stopped, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusProjectRun) GetStopped() *bool { return v.Stopped }
+
+// RunStoppedStatusResponse is returned by RunStoppedStatus on success.
+type RunStoppedStatusResponse struct {
+	Project *RunStoppedStatusProject `json:"project"`
+}
+
+// GetProject returns RunStoppedStatusResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusResponse) GetProject() *RunStoppedStatusProject { return v.Project }
+
 // ServerInfoResponse is returned by ServerInfo on success.
 type ServerInfoResponse struct {
 	ServerInfo *ServerInfoServerInfo `json:"serverInfo"`
@@ -1078,6 +1143,22 @@ func (v *__NotifyScriptableRunAlertInput) GetSeverity() *AlertSeverity { return
 // GetWaitDuration returns __NotifyScriptableRunAlertInput.WaitDuration, and is useful for accessing the field via an interface.
 func (v *__NotifyScriptableRunAlertInput) GetWaitDuration() *int64 { return v.WaitDuration }
 
+// __RunMetadataInput is used internally by genqlient
+type __RunMetadataInput struct {
+	ProjectName *string `json:"projectName"`
+	EntityName  *string `json:"entityName"`
+	RunId       string  `json:"runId"`
+}
+
+// GetProjectName returns __RunMetadataInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__RunMetadataInput) GetProjectName() *string { return v.ProjectName }
+
+// GetEntityName returns __RunMetadataInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__RunMetadataInput) GetEntityName() *string { return v.EntityName }
+
+// GetRunId returns __RunMetadataInput.RunId, and is useful for accessing the field via an interface.
+func (v *__RunMetadataInput) GetRunId() string { return v.RunId }
+
 // __RunResumeStatusInput is used internally by genqlient
 type __RunResumeStatusInput struct {
 	Project *string `json:"project"`
@@ -1094,6 +1175,22 @@ func (v *__RunResumeStatusInput) GetEntity() *string { return v.Entity }
 // GetName returns __RunResumeStatusInput.Name, and is useful for accessing the field via an interface.
 func (v *__RunResumeStatusInput) GetName() string { return v.Name }
 
+// __RunStoppedStatusInput is used internally by genqlient
+type __RunStoppedStatusInput struct {
+	ProjectName *string `json:"projectName"`
+	EntityName  *string `json:"entityName"`
+	RunId       string  `json:"runId"`
+}
+
+// GetProjectName returns __RunStoppedStatusInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetProjectName() *string { return v.ProjectName }
+
+// GetEntityName returns __RunStoppedStatusInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetEntityName() *string { return v.EntityName }
+
+// GetRunId returns __RunStoppedStatusInput.RunId, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetRunId() string { return v.RunId }
+
 // __UpsertBucketInput is used internally by genqlient
 type __UpsertBucketInput struct {
 	Id             *string  `json:"id"`
@@ -1668,6 +1765,51 @@ func NotifyScriptableRunAlert(
 	return &data, err
 }
 
+// The query or mutation executed by RunMetadata.
+const RunMetadata_Operation = `
+query RunMetadata ($projectName: String, $entityName: String, $runId: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		run(name: $runId) {
+			displayName
+			notes
+			group
+			tags
+			updatedAt
+		}
+	}
+}
+`
+
+func RunMetadata(
+	ctx context.Context,
+	client graphql.Client,
+	projectName *string,
+	entityName *string,
+	runId string,
+) (*RunMetadataResponse, error) {
+	req := &graphql.Request{
+		OpName: "RunMetadata",
+		Query:  RunMetadata_Operation,
+		Variables: &__RunMetadataInput{
+			ProjectName: projectName,
+			EntityName:  entityName,
+			RunId:       runId,
+		},
+	}
+	var err error
+
+	var data RunMetadataResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by RunResumeStatus.
 const RunResumeStatus_Operation = `
 query RunResumeStatus ($project: String, $entity: String, $name: String!) {
@@ -1725,6 +1867,47 @@ func RunResumeStatus(
 	return &data, err
 }
 
+// The query or mutation executed by RunStoppedStatus.
+const RunStoppedStatus_Operation = `
+query RunStoppedStatus ($projectName: String, $entityName: String, $runId: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		run(name: $runId) {
+			stopped
+		}
+	}
+}
+`
+
+func RunStoppedStatus(
+	ctx context.Context,
+	client graphql.Client,
+	projectName *string,
+	entityName *string,
+	runId string,
+) (*RunStoppedStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "RunStoppedStatus",
+		Query:  RunStoppedStatus_Operation,
+		Variables: &__RunStoppedStatusInput{
+			ProjectName: projectName,
+			EntityName:  entityName,
+			RunId:       runId,
+		},
+	}
+	var err error
+
+	var data RunStoppedStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by ServerInfo.
 const ServerInfo_Operation = `
 query ServerInfo {
@@ -0,0 +1,149 @@
+// Package gqlcache caches the responses of read-only GraphQL queries, such
+// as the server info, across the streams of a process, and keeps statistics
+// of the latency and errors of each GraphQL operation.
+package gqlcache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// maxEntries bounds the number of cached responses
+	maxEntries = 1000
+)
+
+// DefaultTTLs are how long the responses of the cached queries are kept, by
+// operation name. Other operations are not cached.
+var DefaultTTLs = map[string]time.Duration{
+	"ServerInfo": 10 * time.Minute,
+	"Viewer":     10 * time.Minute,
+	// the server IDs of client IDs never change once mapped
+	"ClientIDMapping": time.Hour,
+	// the manifest has a signed URL, which outlives this
+	"ArtifactManifest": 5 * time.Minute,
+}
+
+// DefaultInvalidations are the cached queries whose responses are dropped
+// when a mutation succeeds, by mutation name.
+var DefaultInvalidations = map[string][]string{
+	"CreateArtifactManifest": {"ArtifactManifest"},
+	"CommitArtifact":         {"ArtifactManifest"},
+}
+
+// ParseTTLs returns the default TTLs with the overrides of the
+// _graphql_cache_ttls setting, which are durations such as "30s" by
+// operation name. A TTL of 0 turns off the caching of an operation.
+func ParseTTLs(overrides map[string]string) (map[string]time.Duration, error) {
+	ttls := make(map[string]time.Duration, len(DefaultTTLs)+len(overrides))
+	for op, ttl := range DefaultTTLs {
+		ttls[op] = ttl
+	}
+	for op, value := range overrides {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return DefaultTTLs, fmt.Errorf("gqlcache: invalid TTL %q for %s", value, op)
+		}
+		ttls[op] = ttl
+	}
+	return ttls, nil
+}
+
+type entry struct {
+	op      string
+	data    []byte
+	expires time.Time
+}
+
+type CacheOption func(*Cache)
+
+// WithClock sets the clock of the cache, used in tests.
+func WithClock(now func() time.Time) CacheOption {
+	return func(c *Cache) {
+		c.now = now
+	}
+}
+
+// Cache holds the encoded responses of queries until they expire. It is safe
+// for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+// Default is the cache shared by the streams of the process.
+var Default = NewCache()
+
+func NewCache(opts ...CacheOption) *Cache {
+	c := &Cache{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// get returns the response cached under key, if it has not expired
+func (c *Cache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.data, true
+}
+
+// put caches the response of op under key for ttl. Nothing is cached while
+// the cache is full of responses that have not expired.
+func (c *Cache) put(key string, op string, data []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if len(c.entries) >= maxEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxEntries {
+			return
+		}
+	}
+	c.entries[key] = entry{op: op, data: data, expires: now.Add(ttl)}
+}
+
+// Invalidate drops the cached responses of the given operations, or all of
+// them if none is given.
+func (c *Cache) Invalidate(ops ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(ops) == 0 {
+		c.entries = make(map[string]entry)
+		return
+	}
+	for key, e := range c.entries {
+		for _, op := range ops {
+			if e.op == op {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// Len returns the number of cached responses, including expired ones not
+// dropped yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
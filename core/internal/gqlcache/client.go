@@ -0,0 +1,143 @@
+package gqlcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/segmentio/encoding/json"
+)
+
+type ClientOption func(*Client)
+
+// WithCache sets the cache of the responses; the client caches nothing
+// without one.
+func WithCache(cache *Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithNamespace separates the cached responses of the client from those of
+// clients of other servers or users, such as with the URL of the server and
+// the API key, which is not kept.
+func WithNamespace(url string, apiKey string) ClientOption {
+	return func(c *Client) {
+		digest := sha256.Sum256([]byte(apiKey))
+		c.namespace = url + "\x00" + hex.EncodeToString(digest[:8])
+	}
+}
+
+// WithTTLs sets how long the responses of each query are cached.
+func WithTTLs(ttls map[string]time.Duration) ClientOption {
+	return func(c *Client) {
+		c.ttls = ttls
+	}
+}
+
+// WithInvalidations sets the cached queries that each mutation invalidates.
+func WithInvalidations(invalidations map[string][]string) ClientOption {
+	return func(c *Client) {
+		c.invalidations = invalidations
+	}
+}
+
+// WithStats sets the statistics the requests of the client are recorded in.
+func WithStats(stats *Stats) ClientOption {
+	return func(c *Client) {
+		c.stats = stats
+	}
+}
+
+// Client is a GraphQL client that answers the queries it caches from the
+// cache, and records the latency and errors of each operation.
+//
+// Only successful responses are cached, and only if none of their fields is
+// null or empty: an object the server does not have yet, such as the
+// mapping of a client ID being uploaded, may exist on the next request. A
+// successful mutation drops the cached responses of the queries it
+// invalidates.
+type Client struct {
+	client        graphql.Client
+	cache         *Cache
+	namespace     string
+	ttls          map[string]time.Duration
+	invalidations map[string][]string
+	stats         *Stats
+}
+
+// NewClient wraps client with the default TTLs and invalidations.
+func NewClient(client graphql.Client, opts ...ClientOption) *Client {
+	c := &Client{
+		client:        client,
+		ttls:          DefaultTTLs,
+		invalidations: DefaultInvalidations,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) MakeRequest(
+	ctx context.Context,
+	req *graphql.Request,
+	resp *graphql.Response,
+) error {
+	key := c.key(req)
+	if key != "" {
+		if data, ok := c.cache.get(key); ok && json.Unmarshal(data, resp.Data) == nil {
+			c.stats.hit(req.OpName)
+			return nil
+		}
+	}
+
+	start := time.Now()
+	err := c.client.MakeRequest(ctx, req, resp)
+	failed := err != nil || len(resp.Errors) > 0
+	c.stats.observe(req.OpName, time.Since(start), failed)
+	if failed {
+		return err
+	}
+
+	if key != "" {
+		if data, err := json.Marshal(resp.Data); err == nil && complete(data) {
+			c.cache.put(key, req.OpName, data, c.ttls[req.OpName])
+		}
+	}
+	if ops := c.invalidations[req.OpName]; c.cache != nil && len(ops) > 0 {
+		c.cache.Invalidate(ops...)
+	}
+	return nil
+}
+
+// key returns the key of the response of a request, or "" if it is not
+// cached
+func (c *Client) key(req *graphql.Request) string {
+	if c.cache == nil || c.ttls[req.OpName] <= 0 {
+		return ""
+	}
+	variables, err := json.Marshal(req.Variables)
+	if err != nil {
+		return ""
+	}
+	return c.namespace + "\x00" + req.OpName + "\x00" + string(variables)
+}
+
+// complete reports whether the encoded data of a response has fields, none
+// of which is null or empty
+func complete(data []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || len(fields) == 0 {
+		return false
+	}
+	for _, value := range fields {
+		switch string(value) {
+		case "null", "{}", "[]", `""`:
+			return false
+		}
+	}
+	return true
+}
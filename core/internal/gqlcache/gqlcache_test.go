@@ -0,0 +1,195 @@
+package gqlcache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/coretest"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/gqlcache"
+	"github.com/wandb/wandb/core/internal/gqltest"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.t
+}
+
+func serverInfoResponse(version string) *graphql.Response {
+	return &graphql.Response{Data: &gql.ServerInfoResponse{
+		ServerInfo: &gql.ServerInfoServerInfo{CliVersionInfo: map[string]interface{}{"max_cli_version": version}},
+	}}
+}
+
+func clientIDResponse(serverID string) *graphql.Response {
+	return &graphql.Response{Data: &gql.ClientIDMappingResponse{
+		ClientIDMapping: &gql.ClientIDMappingClientIDMapping{ServerID: serverID},
+	}}
+}
+
+func TestCachesQueries(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	stats := gqlcache.NewStats()
+	client := gqlcache.NewClient(to.MockClient,
+		gqlcache.WithCache(gqlcache.NewCache(gqlcache.WithClock(clock.Now))),
+		gqlcache.WithNamespace("https://api.example.com", "key"),
+		gqlcache.WithStats(stats),
+	)
+
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(serverInfoResponse("1.0"), nil)).Times(1)
+	for i := 0; i < 3; i++ {
+		data, err := gql.ServerInfo(context.Background(), client)
+		require.NoError(t, err)
+		assert.Equal(t, "1.0", data.GetServerInfo().GetCliVersionInfo().(map[string]interface{})["max_cli_version"])
+	}
+
+	// the variables are part of the key
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(clientIDResponse("server-a"), nil)).Times(1)
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(clientIDResponse("server-b"), nil)).Times(1)
+	for _, id := range []string{"a", "b", "a", "b"} {
+		data, err := gql.ClientIDMapping(context.Background(), client, id)
+		require.NoError(t, err)
+		assert.Equal(t, "server-"+id, data.GetClientIDMapping().GetServerID())
+	}
+
+	// responses expire after their TTL
+	clock.t = clock.t.Add(10 * time.Minute)
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(serverInfoResponse("2.0"), nil)).Times(1)
+	data, err := gql.ServerInfo(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", data.GetServerInfo().GetCliVersionInfo().(map[string]interface{})["max_cli_version"])
+
+	ops := stats.Operations()
+	require.Len(t, ops, 2)
+	assert.Equal(t, "ClientIDMapping", ops[0].Op)
+	assert.Equal(t, 4, ops[0].Count)
+	assert.Equal(t, 2, ops[0].CacheHits)
+	assert.Equal(t, "ServerInfo", ops[1].Op)
+	assert.Equal(t, 4, ops[1].Count)
+	assert.Equal(t, 2, ops[1].CacheHits)
+}
+
+func TestDoesNotCacheFailures(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+	stats := gqlcache.NewStats()
+	client := gqlcache.NewClient(to.MockClient,
+		gqlcache.WithCache(gqlcache.NewCache()),
+		gqlcache.WithStats(stats),
+	)
+
+	gomock.InOrder(
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("server error")),
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).Do(coretest.InjectResponse(serverInfoResponse("1.0"), nil)),
+	)
+	_, err := gql.ServerInfo(context.Background(), client)
+	assert.Error(t, err)
+	_, err = gql.ServerInfo(context.Background(), client)
+	assert.NoError(t, err)
+	_, err = gql.ServerInfo(context.Background(), client)
+	assert.NoError(t, err)
+
+	ops := stats.Operations()
+	require.Len(t, ops, 1)
+	assert.Equal(t, 3, ops[0].Count)
+	assert.Equal(t, 1, ops[0].Errors)
+	assert.Equal(t, 1, ops[0].CacheHits)
+}
+
+func TestDoesNotCacheEmptyResults(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+	client := gqlcache.NewClient(to.MockClient, gqlcache.WithCache(gqlcache.NewCache()))
+
+	// the client ID is not mapped until the server has the upload
+	gomock.InOrder(
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).Do(coretest.InjectResponse(
+			&graphql.Response{Data: &gql.ClientIDMappingResponse{}}, nil)),
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).Do(coretest.InjectResponse(clientIDResponse("server-a"), nil)),
+	)
+	data, err := gql.ClientIDMapping(context.Background(), client, "a")
+	require.NoError(t, err)
+	assert.Nil(t, data.GetClientIDMapping())
+	for i := 0; i < 3; i++ {
+		data, err = gql.ClientIDMapping(context.Background(), client, "a")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "server-a", data.GetClientIDMapping().GetServerID())
+}
+
+func TestNamespacesAndInvalidation(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+	cache := gqlcache.NewCache()
+	client := gqlcache.NewClient(to.MockClient,
+		gqlcache.WithCache(cache),
+		gqlcache.WithNamespace("https://api.example.com", "key"),
+		gqlcache.WithInvalidations(map[string][]string{"UpsertBucket": {"ServerInfo"}}),
+	)
+	otherClient := gqlcache.NewClient(to.MockClient,
+		gqlcache.WithCache(cache),
+		gqlcache.WithNamespace("https://api.example.com", "other key"),
+	)
+
+	// clients of other users do not share responses
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(serverInfoResponse("1.0"), nil)).Times(2)
+	_, err := gql.ServerInfo(context.Background(), client)
+	require.NoError(t, err)
+	_, err = gql.ServerInfo(context.Background(), otherClient)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cache.Len())
+
+	// mutations are not cached, and drop the responses they invalidate
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(&graphql.Response{Data: &gql.UpsertBucketResponse{}}, nil)).Times(1)
+	_, err = gql.UpsertBucket(context.Background(), client,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCacheOff(t *testing.T) {
+	mockClient := gqltest.NewMockClient(gomock.NewController(t))
+	ttls, err := gqlcache.ParseTTLs(map[string]string{"ServerInfo": "0"})
+	require.NoError(t, err)
+	client := gqlcache.NewClient(mockClient, gqlcache.WithCache(gqlcache.NewCache()), gqlcache.WithTTLs(ttls))
+
+	mockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(serverInfoResponse("1.0"), nil)).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := gql.ServerInfo(context.Background(), client)
+		require.NoError(t, err)
+	}
+}
+
+func TestParseTTLs(t *testing.T) {
+	ttls, err := gqlcache.ParseTTLs(map[string]string{"Viewer": "30s", "RunMetadata": "5s"})
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, ttls["Viewer"])
+	assert.Equal(t, 5*time.Second, ttls["RunMetadata"])
+	assert.Equal(t, gqlcache.DefaultTTLs["ServerInfo"], ttls["ServerInfo"])
+
+	_, err = gqlcache.ParseTTLs(map[string]string{"Viewer": "soon"})
+	assert.ErrorContains(t, err, `invalid TTL "soon" for Viewer`)
+}
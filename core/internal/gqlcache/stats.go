@@ -0,0 +1,93 @@
+package gqlcache
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// OperationStats are the statistics of the requests of a GraphQL operation.
+type OperationStats struct {
+	Op string
+	// Count is the number of requests, including those answered from the cache
+	Count int
+	// Errors is the number of failed requests
+	Errors int
+	// CacheHits is the number of requests answered from the cache
+	CacheHits int
+	// TotalLatency and MaxLatency are those of the requests sent to the server
+	TotalLatency time.Duration
+	MaxLatency   time.Duration
+}
+
+// MeanLatency returns the mean latency of the requests sent to the server.
+func (s *OperationStats) MeanLatency() time.Duration {
+	sent := s.Count - s.CacheHits
+	if sent == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(sent)
+}
+
+// Stats keeps the statistics of GraphQL operations. It is safe for
+// concurrent use.
+type Stats struct {
+	mu  sync.Mutex
+	ops map[string]*OperationStats
+}
+
+func NewStats() *Stats {
+	return &Stats{ops: make(map[string]*OperationStats)}
+}
+
+func (s *Stats) get(op string) *OperationStats {
+	stats, ok := s.ops[op]
+	if !ok {
+		stats = &OperationStats{Op: op}
+		s.ops[op] = stats
+	}
+	return stats
+}
+
+// observe records a request sent to the server
+func (s *Stats) observe(op string, latency time.Duration, failed bool) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.get(op)
+	stats.Count++
+	if failed {
+		stats.Errors++
+	}
+	stats.TotalLatency += latency
+	stats.MaxLatency = max(stats.MaxLatency, latency)
+}
+
+// hit records a request answered from the cache
+func (s *Stats) hit(op string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.get(op)
+	stats.Count++
+	stats.CacheHits++
+}
+
+// Operations returns the statistics of each operation, by name.
+func (s *Stats) Operations() []OperationStats {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ops := make([]OperationStats, 0, len(s.ops))
+	for _, stats := range s.ops {
+		ops = append(ops, *stats)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Op < ops[j].Op })
+	return ops
+}
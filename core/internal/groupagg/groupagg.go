@@ -0,0 +1,384 @@
+// Package groupagg aggregates the history of the offline runs of a group,
+// such as runs of the same experiment with different seeds, so that they can
+// be compared without syncing them.
+package groupagg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// AggregateJobType is the job type of the runs written by WriteRunLog, which
+// are not aggregated again
+const AggregateJobType = "aggregate"
+
+// Run is the numeric history of a run read from its transaction log.
+type Run struct {
+	Path    string
+	RunID   string
+	Group   string
+	Entity  string
+	Project string
+	JobType string
+	// StartTime is the start time of the run, zero if not known
+	StartTime time.Time
+	// History holds the numeric values of each step, by key. Keys starting
+	// with an underscore are not kept, except for _runtime.
+	History map[int64]map[string]float64
+}
+
+// FindRuns returns the paths of the transaction logs under dir.
+func FindRuns(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".wandb") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// ReadRun reads the run record and the history of a transaction log.
+func ReadRun(logger *observability.CoreLogger, path string) (*Run, error) {
+	store := server.NewStore(context.Background(), path, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	run := &Run{Path: path, History: make(map[int64]map[string]float64)}
+	for {
+		record, err := store.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("groupagg: reading %s: %w", path, err)
+		}
+		switch x := record.RecordType.(type) {
+		case *service.Record_Run:
+			run.setRun(x.Run)
+		case *service.Record_History:
+			run.addHistory(x.History)
+		}
+	}
+	if run.RunID == "" {
+		return nil, fmt.Errorf("groupagg: %s has no run record", path)
+	}
+	return run, nil
+}
+
+func (r *Run) setRun(record *service.RunRecord) {
+	if id := record.GetRunId(); id != "" {
+		r.RunID = id
+	}
+	if group := record.GetRunGroup(); group != "" {
+		r.Group = group
+	}
+	if entity := record.GetEntity(); entity != "" {
+		r.Entity = entity
+	}
+	if project := record.GetProject(); project != "" {
+		r.Project = project
+	}
+	if jobType := record.GetJobType(); jobType != "" {
+		r.JobType = jobType
+	}
+	if record.GetStartTime() != nil && r.StartTime.IsZero() {
+		r.StartTime = record.GetStartTime().AsTime()
+	}
+}
+
+func (r *Run) addHistory(history *service.HistoryRecord) {
+	step := history.GetStep().GetNum()
+	row, ok := r.History[step]
+	if !ok {
+		row = make(map[string]float64)
+		r.History[step] = row
+	}
+	for _, item := range history.GetItem() {
+		key := item.GetKey()
+		if len(item.GetNestedKey()) > 0 {
+			key = strings.Join(item.GetNestedKey(), ".")
+		}
+		if strings.HasPrefix(key, "_") && key != "_runtime" {
+			continue
+		}
+		value, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		row[key] = value
+	}
+}
+
+// Stats are the statistics of a metric at a step, over the runs that logged
+// it at that step.
+type Stats struct {
+	Count int
+	Mean  float64
+	// Std is the population standard deviation
+	Std float64
+	Min float64
+	Max float64
+}
+
+func computeStats(values []float64) Stats {
+	stats := Stats{Count: len(values), Min: values[0], Max: values[0]}
+	for _, value := range values {
+		stats.Mean += value
+		stats.Min = min(stats.Min, value)
+		stats.Max = max(stats.Max, value)
+	}
+	stats.Mean /= float64(len(values))
+	for _, value := range values {
+		stats.Std += (value - stats.Mean) * (value - stats.Mean)
+	}
+	stats.Std = math.Sqrt(stats.Std / float64(len(values)))
+	return stats
+}
+
+// Group is the history of the runs of a group, aligned by step.
+type Group struct {
+	Name string
+	Runs []*Run
+	// Steps are the steps logged by any run, in order
+	Steps []int64
+	// Metrics are the keys logged by any run, in order, without _runtime
+	Metrics []string
+	// Stats are the statistics of each metric at each step it was logged
+	Stats map[int64]map[string]Stats
+}
+
+// GroupRuns returns the names of the groups of runs, in order, and the runs
+// of each group. Runs without a group and aggregate runs are left out.
+func GroupRuns(runs []*Run) ([]string, map[string][]*Run) {
+	byGroup := make(map[string][]*Run)
+	var names []string
+	for _, run := range runs {
+		if run.Group == "" || run.JobType == AggregateJobType {
+			continue
+		}
+		if _, ok := byGroup[run.Group]; !ok {
+			names = append(names, run.Group)
+		}
+		byGroup[run.Group] = append(byGroup[run.Group], run)
+	}
+	sort.Strings(names)
+	return names, byGroup
+}
+
+// Aggregate aligns the history of the runs of a group by step, and computes
+// the statistics of each metric at each step.
+func Aggregate(name string, runs []*Run) *Group {
+	sort.Slice(runs, func(i, j int) bool { return runs[i].RunID < runs[j].RunID })
+	group := &Group{Name: name, Runs: runs, Stats: make(map[int64]map[string]Stats)}
+
+	values := make(map[int64]map[string][]float64)
+	metrics := make(map[string]bool)
+	for _, run := range runs {
+		for step, row := range run.History {
+			stepValues, ok := values[step]
+			if !ok {
+				stepValues = make(map[string][]float64)
+				values[step] = stepValues
+			}
+			for key, value := range row {
+				stepValues[key] = append(stepValues[key], value)
+				if key != "_runtime" {
+					metrics[key] = true
+				}
+			}
+		}
+	}
+
+	for step, stepValues := range values {
+		group.Steps = append(group.Steps, step)
+		stats := make(map[string]Stats, len(stepValues))
+		for key, keyValues := range stepValues {
+			stats[key] = computeStats(keyValues)
+		}
+		group.Stats[step] = stats
+	}
+	sort.Slice(group.Steps, func(i, j int) bool { return group.Steps[i] < group.Steps[j] })
+	for key := range metrics {
+		group.Metrics = append(group.Metrics, key)
+	}
+	sort.Strings(group.Metrics)
+	return group
+}
+
+// statNames are the suffixes of the aggregated keys, in the order of the
+// CSV columns
+var statNames = []string{"mean", "std", "min", "max", "count"}
+
+func (s Stats) value(name string) float64 {
+	switch name {
+	case "mean":
+		return s.Mean
+	case "std":
+		return s.Std
+	case "min":
+		return s.Min
+	case "max":
+		return s.Max
+	default:
+		return float64(s.Count)
+	}
+}
+
+// aggregateRunID returns the ID of the aggregate run of a group
+func aggregateRunID(entity, project, group string) string {
+	sum := sha256.Sum256([]byte(entity + "/" + project + "/" + group))
+	return "agg" + hex.EncodeToString(sum[:])[:8]
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// WriteCSV writes the statistics of the group as CSV, with one row per step
+// and the columns step and <metric>/<stat> for each metric. Cells of metrics
+// not logged at a step are empty.
+func (g *Group) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"step"}
+	for _, metric := range g.Metrics {
+		for _, stat := range statNames {
+			header = append(header, metric+"/"+stat)
+		}
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, step := range g.Steps {
+		row := []string{strconv.FormatInt(step, 10)}
+		for _, metric := range g.Metrics {
+			stats, ok := g.Stats[step][metric]
+			for _, stat := range statNames {
+				if ok {
+					row = append(row, formatFloat(stats.value(stat)))
+				} else {
+					row = append(row, "")
+				}
+			}
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteRunLog writes the statistics of the group as the transaction log of
+// a synthetic offline run of the group under dir, with the history keys
+// <metric>/<stat>.
+//
+// The run starts when the first run of the group started, and each row is
+// logged at the mean runtime of the runs at its step. The ID of the run is
+// derived from the entity, project and name of the group, so that writing
+// the aggregate again replaces the one written before instead of adding
+// another run to the group.
+func (g *Group) WriteRunLog(logger *observability.CoreLogger, dir string) (*importer.RunLog, error) {
+	run := &service.RunRecord{
+		DisplayName: proto.String(g.Name + " (aggregate)"),
+		RunGroup:    g.Name,
+		JobType:     AggregateJobType,
+	}
+	var start time.Time
+	runIDs := make([]string, 0, len(g.Runs))
+	for _, r := range g.Runs {
+		runIDs = append(runIDs, strconv.Quote(r.RunID))
+		if run.Entity == "" {
+			run.Entity = r.Entity
+		}
+		if run.Project == "" {
+			run.Project = r.Project
+		}
+		if !r.StartTime.IsZero() && (start.IsZero() || r.StartTime.Before(start)) {
+			start = r.StartTime
+		}
+	}
+	if start.IsZero() {
+		start = time.Now()
+	}
+	run.StartTime = timestamppb.New(start)
+	run.RunId = aggregateRunID(run.Entity, run.Project, g.Name)
+
+	// the previous aggregate may have started at another time, so it is in
+	// another directory
+	previous, err := filepath.Glob(filepath.Join(dir, "offline-run-*-"+run.RunId))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range previous {
+		if err := os.RemoveAll(path); err != nil {
+			return nil, err
+		}
+	}
+
+	runLog, err := importer.NewRunLog(logger, dir, run)
+	if err != nil {
+		return nil, err
+	}
+	err = runLog.Config([]*service.ConfigItem{
+		{Key: "group_runs", ValueJson: "[" + strings.Join(runIDs, ",") + "]"},
+		{Key: "group_size", ValueJson: strconv.Itoa(len(g.Runs))},
+	})
+	if err != nil {
+		_ = runLog.Finish(1)
+		return nil, err
+	}
+
+	for _, step := range g.Steps {
+		stats := g.Stats[step]
+		var items []*service.HistoryItem
+		for _, metric := range g.Metrics {
+			metricStats, ok := stats[metric]
+			if !ok {
+				continue
+			}
+			for _, stat := range statNames {
+				items = append(items, &service.HistoryItem{
+					Key:       metric + "/" + stat,
+					ValueJson: formatFloat(metricStats.value(stat)),
+				})
+			}
+		}
+		timestamp := start
+		if runtime, ok := stats["_runtime"]; ok {
+			timestamp = start.Add(time.Duration(runtime.Mean * float64(time.Second)))
+		}
+		if err := runLog.History(step, timestamp, items); err != nil {
+			_ = runLog.Finish(1)
+			return nil, err
+		}
+	}
+	return runLog, runLog.Finish(0)
+}
@@ -0,0 +1,158 @@
+package groupagg_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/groupagg"
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+var startTime = time.Unix(1700000000, 0)
+
+// writeRun writes the transaction log of an offline run with the given loss
+// at each step, at 10 seconds per step
+func writeRun(t *testing.T, dir string, id string, group string, losses map[int64]string) {
+	runLog, err := importer.NewRunLog(observability.NewNoOpLogger(), dir, &service.RunRecord{
+		RunId:     id,
+		RunGroup:  group,
+		Project:   "seeds",
+		StartTime: timestamppb.New(startTime),
+	})
+	require.NoError(t, err)
+	for step := int64(0); step < 3; step++ {
+		loss, ok := losses[step]
+		if !ok {
+			continue
+		}
+		err := runLog.History(step, startTime.Add(time.Duration(step)*10*time.Second), []*service.HistoryItem{
+			{Key: "loss", ValueJson: loss},
+			{Key: "phase", ValueJson: `"train"`},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, runLog.Finish(0))
+}
+
+func readRuns(t *testing.T, dir string) []*groupagg.Run {
+	paths, err := groupagg.FindRuns(dir)
+	require.NoError(t, err)
+	var runs []*groupagg.Run
+	for _, path := range paths {
+		run, err := groupagg.ReadRun(observability.NewNoOpLogger(), path)
+		require.NoError(t, err)
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+func writeGroup(t *testing.T) string {
+	dir := t.TempDir()
+	writeRun(t, dir, "seed1", "exp", map[int64]string{0: "1.0", 1: "0.5", 2: "0.25"})
+	writeRun(t, dir, "seed2", "exp", map[int64]string{0: "3.0", 1: "1.5"})
+	writeRun(t, dir, "seed3", "exp", map[int64]string{0: "2.0", 1: "NaN", 2: "0.75"})
+	writeRun(t, dir, "other", "other", map[int64]string{0: "9"})
+	writeRun(t, dir, "single", "", map[int64]string{0: "9"})
+	return dir
+}
+
+func TestAggregate(t *testing.T) {
+	runs := readRuns(t, writeGroup(t))
+	require.Len(t, runs, 5)
+	names, byGroup := groupagg.GroupRuns(runs)
+	assert.Equal(t, []string{"exp", "other"}, names)
+
+	group := groupagg.Aggregate("exp", byGroup["exp"])
+	assert.Equal(t, []int64{0, 1, 2}, group.Steps)
+	assert.Equal(t, []string{"loss"}, group.Metrics)
+
+	stats := group.Stats[0]["loss"]
+	assert.Equal(t, 3, stats.Count)
+	assert.InDelta(t, 2.0, stats.Mean, 1e-9)
+	assert.InDelta(t, 0.816496580927726, stats.Std, 1e-9)
+	assert.Equal(t, 1.0, stats.Min)
+	assert.Equal(t, 3.0, stats.Max)
+
+	// NaN values and missing steps are left out
+	assert.Equal(t, 2, group.Stats[1]["loss"].Count)
+	assert.InDelta(t, 1.0, group.Stats[1]["loss"].Mean, 1e-9)
+	assert.Equal(t, 2, group.Stats[2]["loss"].Count)
+	assert.InDelta(t, 0.25, group.Stats[2]["loss"].Std, 1e-9)
+}
+
+func TestWriteCSV(t *testing.T) {
+	runs := readRuns(t, writeGroup(t))
+	_, byGroup := groupagg.GroupRuns(runs)
+	group := groupagg.Aggregate("exp", byGroup["exp"])
+
+	var buf bytes.Buffer
+	require.NoError(t, group.WriteCSV(&buf))
+	assert.Equal(t,
+		"step,loss/mean,loss/std,loss/min,loss/max,loss/count\n"+
+			"0,2,0.816496580927726,1,3,3\n"+
+			"1,1,0.5,0.5,1.5,2\n"+
+			"2,0.5,0.25,0.25,0.75,2\n",
+		buf.String())
+}
+
+func TestWriteRunLog(t *testing.T) {
+	dir := writeGroup(t)
+	_, byGroup := groupagg.GroupRuns(readRuns(t, dir))
+	group := groupagg.Aggregate("exp", byGroup["exp"])
+
+	runLog, err := group.WriteRunLog(observability.NewNoOpLogger(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, "exp", runLog.Run().GetRunGroup())
+	assert.Equal(t, groupagg.AggregateJobType, runLog.Run().GetJobType())
+
+	aggregate, err := groupagg.ReadRun(observability.NewNoOpLogger(), runLog.SyncFile())
+	require.NoError(t, err)
+	assert.Equal(t, startTime, aggregate.StartTime.Local())
+	assert.Len(t, aggregate.History, 3)
+	assert.Equal(t, 2.0, aggregate.History[0]["loss/mean"])
+	assert.Equal(t, 0.25, aggregate.History[2]["loss/std"])
+	assert.Equal(t, 20.0, aggregate.History[2]["_runtime"])
+
+	// the aggregate run is not aggregated again
+	_, byGroup = groupagg.GroupRuns(readRuns(t, dir))
+	assert.Len(t, byGroup["exp"], 3)
+}
+
+func TestWriteRunLogReplacesPreviousAggregate(t *testing.T) {
+	dir := writeGroup(t)
+	_, byGroup := groupagg.GroupRuns(readRuns(t, dir))
+	group := groupagg.Aggregate("exp", byGroup["exp"])
+	runs, err := groupagg.FindRuns(dir)
+	require.NoError(t, err)
+
+	first, err := group.WriteRunLog(observability.NewNoOpLogger(), dir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(first.Dir(), "files", "stale.txt"), nil, 0644))
+	// a previous aggregate that started at another time
+	moved := filepath.Join(dir, "offline-run-20000101_000000-"+first.Run().GetRunId())
+	require.NoError(t, os.Rename(first.Dir(), moved))
+
+	second, err := group.WriteRunLog(observability.NewNoOpLogger(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, first.Run().GetRunId(), second.Run().GetRunId())
+	assert.NoDirExists(t, moved)
+
+	paths, err := groupagg.FindRuns(dir)
+	require.NoError(t, err)
+	assert.Len(t, paths, len(runs)+1)
+
+	// another group gets another aggregate
+	other := groupagg.Aggregate("other", byGroup["exp"])
+	otherLog, err := other.WriteRunLog(observability.NewNoOpLogger(), dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Run().GetRunId(), otherLog.Run().GetRunId())
+}
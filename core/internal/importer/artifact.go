@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+
+	"github.com/wandb/wandb/core/internal/digestcache"
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ArtifactFromDir returns the record of an artifact holding the regular files
+// under dir. The digests of the files are computed through the cache, which
+// may be nil.
+func ArtifactFromDir(
+	cache *digestcache.Cache,
+	name string,
+	artifactType string,
+	dir string,
+) (*service.ArtifactRecord, error) {
+	return artifactFromDir(cache, name, artifactType, dir, nil)
+}
+
+// artifactFromDir is ArtifactFromDir leaving out the directories under dir
+// for which skip returns true
+func artifactFromDir(
+	cache *digestcache.Cache,
+	name string,
+	artifactType string,
+	dir string,
+	skip func(path string) bool,
+) (*service.ArtifactRecord, error) {
+	manifest := &service.ArtifactManifest{
+		Version:       1,
+		StoragePolicy: "wandb-storage-policy-v1",
+		StoragePolicyConfig: []*service.StoragePolicyConfigItem{
+			{Key: "storageLayout", ValueJson: `"V2"`},
+		},
+	}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != dir && skip != nil && skip(path) {
+			return fs.SkipDir
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		digest, err := cache.B64MD5(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		manifest.Contents = append(manifest.Contents, &service.ArtifactManifestEntry{
+			Path:      filepath.ToSlash(rel),
+			Digest:    digest,
+			Size:      info.Size(),
+			LocalPath: path,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.Contents) == 0 {
+		return nil, fmt.Errorf("importer: no files in %s", dir)
+	}
+
+	return &service.ArtifactRecord{
+		Type:             artifactType,
+		Name:             name,
+		Digest:           manifestDigest(manifest),
+		Aliases:          []string{"latest"},
+		Manifest:         manifest,
+		Finalize:         true,
+		ClientId:         shared.ShortID(32),
+		SequenceClientId: shared.ShortID(32),
+	}, nil
+}
+
+// manifestDigest returns the digest of an artifact, computed from its
+// manifest as the SDK does
+func manifestDigest(manifest *service.ArtifactManifest) string {
+	entries := append([]*service.ArtifactManifestEntry(nil), manifest.Contents...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	hasher := md5.New()
+	hasher.Write([]byte("wandb-artifact-manifest-v1\n"))
+	for _, entry := range entries {
+		fmt.Fprintf(hasher, "%s:%s\n", entry.Path, entry.Digest)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
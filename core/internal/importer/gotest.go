@@ -0,0 +1,401 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+var (
+	// benchmarkLine matches the result of a benchmark: its name, with the
+	// GOMAXPROCS suffix, its iterations and its "value unit" pairs
+	benchmarkLine = regexp.MustCompile(`^(Benchmark\S*?)(?:-(\d+))?\s+(\d+)\s+(.+)$`)
+	// testLine matches the result of a test in the text output
+	testLine = regexp.MustCompile(`^\s*--- (PASS|FAIL|SKIP): (\S+)`)
+	// packageLine matches the result of a package in the text output
+	packageLine = regexp.MustCompile(`^(ok|FAIL)\s+(\S+)\s+(?:([0-9.]+)s|\(cached\))`)
+)
+
+// GoTestOptions describes the run go test results are imported as.
+type GoTestOptions struct {
+	// Run is the run; its name is the tested package if empty, when there
+	// is only one
+	Run RunInfo
+	// GitDir is a directory of the git working tree the tests ran in, whose
+	// commit, remote, branch and state are recorded with the run
+	GitDir string
+}
+
+// goTestEvent is an event of `go test -json`, see `go doc test2json`.
+type goTestEvent struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// benchmarkResult is a result line of a benchmark.
+type benchmarkResult struct {
+	name string
+	// metrics are the values of the result by key, as in the history
+	metrics map[string]float64
+	keys    []string
+	time    time.Time
+}
+
+// goTestResults are the results gathered from the output of go test.
+type goTestResults struct {
+	// json is whether the output is that of `go test -json`, whose test
+	// results are events rather than lines of output
+	json bool
+
+	startTime time.Time
+	endTime   time.Time
+	// elapsed is the time the packages took, from the text output
+	elapsed time.Duration
+
+	// config holds the JSON values of the config, from the header of the
+	// benchmarks
+	config     map[string]string
+	packages   []string
+	benchmarks []*benchmarkResult
+
+	passed, failed, skipped int
+	failures                []string
+	packagesPassed          int
+	packagesFailed          int
+
+	// partial holds the output of each package that is not a whole line yet
+	partial map[string]string
+}
+
+// ImportGoTest writes the run log of the results of `go test`, read from r
+// either as the events of `go test -json` or as the text output, under dir.
+//
+// Each benchmark result is a history row holding its iterations and
+// metrics, such as ns/op, B/op, allocs/op and the metrics reported with
+// b.ReportMetric, under keys of the form "BenchmarkName/ns_per_op". The
+// summary holds the mean of each metric over the repeated results of a
+// benchmark, along with the counts of passed, failed and skipped tests and
+// packages. The text output only has the tests that passed with -v. The run
+// exits with code 1 if a test or a package failed.
+func ImportGoTest(
+	logger *observability.CoreLogger,
+	r io.Reader,
+	dir string,
+	opts GoTestOptions,
+) (*RunLog, error) {
+	results := &goTestResults{
+		config:  make(map[string]string),
+		partial: make(map[string]string),
+	}
+	if err := results.read(r); err != nil {
+		return nil, err
+	}
+
+	run := opts.Run.record()
+	if run.GetDisplayName() == "" && len(results.packages) == 1 {
+		run.DisplayName = &results.packages[0]
+	}
+	if !results.startTime.IsZero() {
+		run.StartTime = timestamppb.New(results.startTime)
+	}
+	run.Config = &service.ConfigRecord{Update: results.configItems()}
+	if opts.GitDir != "" {
+		git, state := readGit(opts.GitDir)
+		run.Git = git
+		if state != "" {
+			run.Config.Update = append(run.Config.Update, &service.ConfigItem{Key: "git", ValueJson: state})
+		}
+	}
+
+	runLog, err := NewRunLog(logger, dir, run)
+	if err != nil {
+		return nil, err
+	}
+	if err := results.write(runLog); err != nil {
+		_ = runLog.Finish(1)
+		return nil, err
+	}
+	var exitCode int32
+	if results.failed > 0 || results.packagesFailed > 0 {
+		exitCode = 1
+	}
+	if err := runLog.Finish(exitCode); err != nil {
+		return nil, err
+	}
+	return runLog, nil
+}
+
+// read reads the output of go test, a line at a time
+func (res *goTestResults) read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "{") {
+			var event goTestEvent
+			if err := json.Unmarshal([]byte(line), &event); err == nil {
+				res.json = true
+				res.event(&event)
+				continue
+			}
+		}
+		res.line(line, time.Time{})
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("importer: reading go test output: %w", err)
+	}
+	for _, line := range res.partial {
+		res.line(line, res.endTime)
+	}
+	return nil
+}
+
+// event handles an event of `go test -json`
+func (res *goTestResults) event(event *goTestEvent) {
+	if !event.Time.IsZero() {
+		if res.startTime.IsZero() {
+			res.startTime = event.Time
+		}
+		res.endTime = event.Time
+	}
+
+	switch event.Action {
+	case "output":
+		// a benchmark prints its name before running and its result after,
+		// which may be two events
+		output := res.partial[event.Package] + event.Output
+		for {
+			i := strings.IndexByte(output, '\n')
+			if i < 0 {
+				break
+			}
+			res.line(output[:i], event.Time)
+			output = output[i+1:]
+		}
+		res.partial[event.Package] = output
+	case "pass", "fail", "skip":
+		if event.Test == "" {
+			res.packageResult(event.Action == "pass", event.Package)
+		} else if !strings.HasPrefix(event.Test, "Benchmark") {
+			res.testResult(event.Action, event.Package, event.Test)
+		}
+	}
+}
+
+// line handles a line of output, at time t if known
+func (res *goTestResults) line(line string, t time.Time) {
+	line = strings.TrimRight(line, "\r")
+	if key, value, ok := strings.Cut(line, ": "); ok {
+		switch key {
+		case "goos", "goarch", "cpu":
+			res.config[key] = strconv.Quote(value)
+			return
+		case "pkg":
+			res.addPackage(value)
+			return
+		}
+	}
+
+	if match := benchmarkLine.FindStringSubmatch(line); match != nil {
+		res.benchmark(match, t)
+		return
+	}
+	// the results of tests and packages are events in the JSON output
+	if res.json {
+		return
+	}
+	if match := testLine.FindStringSubmatch(line); match != nil {
+		res.testResult(strings.ToLower(match[1]), "", match[2])
+	} else if match := packageLine.FindStringSubmatch(line); match != nil {
+		res.packageResult(match[1] == "ok", match[2])
+		if seconds, err := strconv.ParseFloat(match[3], 64); err == nil {
+			res.elapsed += time.Duration(seconds * float64(time.Second))
+		}
+	}
+}
+
+func (res *goTestResults) benchmark(match []string, t time.Time) {
+	iterations, err := strconv.ParseInt(match[3], 10, 64)
+	if err != nil {
+		return
+	}
+	fields := strings.Fields(match[4])
+	if len(fields)%2 != 0 {
+		return
+	}
+	if match[2] != "" {
+		res.config["gomaxprocs"] = match[2]
+	}
+
+	result := &benchmarkResult{
+		name:    match[1],
+		metrics: make(map[string]float64),
+		time:    t,
+	}
+	add := func(key string, value float64) {
+		result.keys = append(result.keys, key)
+		result.metrics[key] = value
+	}
+	add(result.name+"/iterations", float64(iterations))
+	for i := 0; i < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return
+		}
+		add(result.name+"/"+benchmarkUnitKey(fields[i+1]), value)
+	}
+	res.benchmarks = append(res.benchmarks, result)
+}
+
+// benchmarkUnitKey returns the key of the metrics of a unit, as "ns_per_op"
+// for "ns/op", so that the unit is not taken as a section of the key
+func benchmarkUnitKey(unit string) string {
+	return strings.ReplaceAll(unit, "/", "_per_")
+}
+
+func (res *goTestResults) testResult(action string, pkg string, test string) {
+	switch action {
+	case "pass":
+		res.passed++
+	case "fail":
+		res.failed++
+		if pkg != "" {
+			test = pkg + "." + test
+		}
+		res.failures = append(res.failures, test)
+	case "skip":
+		res.skipped++
+	}
+}
+
+func (res *goTestResults) packageResult(passed bool, pkg string) {
+	res.addPackage(pkg)
+	if passed {
+		res.packagesPassed++
+	} else {
+		res.packagesFailed++
+	}
+}
+
+func (res *goTestResults) addPackage(pkg string) {
+	for _, p := range res.packages {
+		if p == pkg {
+			return
+		}
+	}
+	res.packages = append(res.packages, pkg)
+}
+
+func (res *goTestResults) configItems() []*service.ConfigItem {
+	var items []*service.ConfigItem
+	keys := make([]string, 0, len(res.config))
+	for key := range res.config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		items = append(items, &service.ConfigItem{Key: key, ValueJson: res.config[key]})
+	}
+	if len(res.packages) > 0 {
+		packages, _ := json.Marshal(res.packages)
+		items = append(items, &service.ConfigItem{Key: "packages", ValueJson: string(packages)})
+	}
+	return items
+}
+
+// write writes the benchmarks as history rows and the summary
+func (res *goTestResults) write(runLog *RunLog) error {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for step, result := range res.benchmarks {
+		t := result.time
+		if t.IsZero() {
+			t = runLog.startTime
+		}
+		items := make([]*service.HistoryItem, 0, len(result.keys))
+		for _, key := range result.keys {
+			value := result.metrics[key]
+			items = append(items, &service.HistoryItem{Key: key, ValueJson: formatFloat(value)})
+			sums[key] += value
+			counts[key]++
+		}
+		if err := runLog.History(int64(step), t, items); err != nil {
+			return err
+		}
+	}
+
+	var summary []*service.SummaryItem
+	for key, sum := range sums {
+		summary = append(summary, &service.SummaryItem{
+			Key:       key,
+			ValueJson: formatFloat(sum / float64(counts[key])),
+		})
+	}
+	failures, _ := json.Marshal(append([]string{}, res.failures...))
+	summary = append(summary,
+		&service.SummaryItem{Key: "tests/passed", ValueJson: strconv.Itoa(res.passed)},
+		&service.SummaryItem{Key: "tests/failed", ValueJson: strconv.Itoa(res.failed)},
+		&service.SummaryItem{Key: "tests/skipped", ValueJson: strconv.Itoa(res.skipped)},
+		&service.SummaryItem{Key: "tests/failures", ValueJson: string(failures)},
+		&service.SummaryItem{Key: "packages/passed", ValueJson: strconv.Itoa(res.packagesPassed)},
+		&service.SummaryItem{Key: "packages/failed", ValueJson: strconv.Itoa(res.packagesFailed)},
+	)
+	runLog.Summary(summary)
+
+	if !res.json {
+		runLog.SetEndTime(runLog.startTime.Add(res.elapsed))
+	} else if !res.endTime.IsZero() {
+		runLog.SetEndTime(res.endTime)
+	}
+	return nil
+}
+
+// readGit returns the commit and remote of the git working tree at dir, and
+// the JSON of its commit, branch and whether it has uncommitted changes
+func readGit(dir string) (*service.GitRepoRecord, string) {
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		output, err := cmd.Output()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(output))
+	}
+
+	commit := git("rev-parse", "HEAD")
+	if commit == "" {
+		return nil, ""
+	}
+	record := &service.GitRepoRecord{
+		Commit:    commit,
+		RemoteUrl: git("remote", "get-url", "origin"),
+	}
+	state, _ := json.Marshal(map[string]interface{}{
+		"commit": commit,
+		"remote": record.RemoteUrl,
+		"branch": git("rev-parse", "--abbrev-ref", "HEAD"),
+		"dirty":  git("status", "--porcelain", "--untracked-files=no") != "",
+	})
+	return record, string(state)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,132 @@
+package importer_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func importGoTest(t *testing.T, name string, opts importer.GoTestOptions) (*importer.RunLog, []*service.Record) {
+	f, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+	runLog, err := importer.ImportGoTest(observability.NewNoOpLogger(), f, t.TempDir(), opts)
+	require.NoError(t, err)
+	return runLog, readRunLog(t, runLog.SyncFile())
+}
+
+func configValues(run *service.RunRecord) map[string]string {
+	values := make(map[string]string)
+	for _, item := range run.GetConfig().GetUpdate() {
+		values[item.GetKey()] = item.GetValueJson()
+	}
+	return values
+}
+
+func TestImportGoTestJSON(t *testing.T) {
+	_, records := importGoTest(t, "gotest.jsonl", importer.GoTestOptions{})
+
+	run := records[0].GetRun()
+	assert.Equal(t, "example.com/sorting", run.GetDisplayName())
+	start, _ := time.Parse(time.RFC3339Nano, "2026-10-17T06:39:51.122863465Z")
+	assert.Equal(t, start, run.GetStartTime().AsTime())
+	config := configValues(run)
+	assert.Equal(t, `"linux"`, config["goos"])
+	assert.Equal(t, `"Intel(R) Xeon(R) Processor"`, config["cpu"])
+	assert.Equal(t, "2", config["gomaxprocs"])
+	assert.Equal(t, `["example.com/sorting"]`, config["packages"])
+
+	// the second result is split across two events
+	rows := historyRows(records)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "53.22", rows[0]["BenchmarkSort/ns_per_op"])
+	assert.Equal(t, "100", rows[0]["BenchmarkSort/iterations"])
+	assert.Equal(t, "3", rows[0]["BenchmarkSort/items_per_op"])
+	assert.Equal(t, "53", rows[0]["BenchmarkSort/B_per_op"])
+	assert.Equal(t, "56.69", rows[1]["BenchmarkSort/ns_per_op"])
+	assert.Equal(t, "1", rows[1]["_step"])
+	assert.Equal(t, "1792219191.144108", rows[1]["_timestamp"])
+
+	summary := summaryValues(records)
+	nsPerOp, err := strconv.ParseFloat(summary["BenchmarkSort/ns_per_op"], 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 54.955, nsPerOp, 1e-9)
+	assert.Equal(t, "26.5", summary["BenchmarkSort/B_per_op"])
+	assert.Equal(t, "6", summary["tests/passed"])
+	assert.Equal(t, "0", summary["tests/failed"])
+	assert.Equal(t, "2", summary["tests/skipped"])
+	assert.Equal(t, "[]", summary["tests/failures"])
+	assert.Equal(t, "1", summary["packages/passed"])
+
+	exit := records[len(records)-1].GetExit()
+	assert.Equal(t, int32(0), exit.GetExitCode())
+}
+
+func TestImportGoTestText(t *testing.T) {
+	_, records := importGoTest(t, "gotest_bench.txt", importer.GoTestOptions{
+		Run: importer.RunInfo{ID: "bench", Name: "nightly"},
+	})
+
+	run := records[0].GetRun()
+	assert.Equal(t, "bench", run.GetRunId())
+	assert.Equal(t, "nightly", run.GetDisplayName())
+
+	rows := historyRows(records)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "44.04", rows[0]["BenchmarkSort/ns_per_op"])
+	assert.Equal(t, "35.71", rows[1]["BenchmarkSort/ns_per_op"])
+	assert.Equal(t, "0", rows[1]["BenchmarkSort/allocs_per_op"])
+}
+
+func TestImportGoTestFailures(t *testing.T) {
+	_, records := importGoTest(t, "gotest_fail.txt", importer.GoTestOptions{})
+
+	assert.Empty(t, historyRows(records))
+	summary := summaryValues(records)
+	assert.Equal(t, "1", summary["tests/passed"])
+	assert.Equal(t, "2", summary["tests/failed"])
+	assert.Equal(t, "1", summary["tests/skipped"])
+	assert.Equal(t, `["TestSort","TestSort/reversed"]`, summary["tests/failures"])
+	assert.Equal(t, "1", summary["packages/failed"])
+
+	exit := records[len(records)-1].GetExit()
+	assert.Equal(t, int32(1), exit.GetExitCode())
+}
+
+func TestImportGoTestGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+	git("init", "-q", "-b", "main")
+	git("remote", "add", "origin", "https://example.com/sorting.git")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/sorting\n"), 0644))
+	git("add", "go.mod")
+	git("-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/sorted\n"), 0644))
+
+	_, records := importGoTest(t, "gotest_bench.txt", importer.GoTestOptions{GitDir: dir})
+
+	run := records[0].GetRun()
+	assert.Len(t, run.GetGit().GetCommit(), 40)
+	assert.Equal(t, "https://example.com/sorting.git", run.GetGit().GetRemoteUrl())
+	state := configValues(run)["git"]
+	assert.Contains(t, state, `"branch":"main"`)
+	assert.Contains(t, state, `"dirty":true`)
+}
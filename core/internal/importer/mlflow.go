@@ -0,0 +1,461 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/wandb/wandb/core/internal/digestcache"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// MLflow statuses of the runs that did not succeed, as stored in meta.yaml
+const (
+	mlflowFailed = 4
+	mlflowKilled = 5
+)
+
+// MLflow tags that map to run fields
+const (
+	mlflowTagRunName   = "mlflow.runName"
+	mlflowTagNote      = "mlflow.note.content"
+	mlflowTagParentRun = "mlflow.parentRunId"
+)
+
+// MLflowOptions selects the MLflow runs to import and where they go.
+type MLflowOptions struct {
+	Entity string
+	// Project is the project of the runs, the name of their experiment if
+	// empty
+	Project string
+	// Experiments are the ids of the experiments to import, all if empty
+	Experiments []string
+	// DigestCache caches the digests of the artifact files, may be nil
+	DigestCache *digestcache.Cache
+}
+
+// MLflowExperiment is an experiment of an MLflow file store.
+type MLflowExperiment struct {
+	ID             string `yaml:"experiment_id"`
+	Name           string `yaml:"name"`
+	LifecycleStage string `yaml:"lifecycle_stage"`
+
+	dir string
+}
+
+// MLflowRun is a run of an MLflow file store.
+type MLflowRun struct {
+	ID             string      `yaml:"run_id"`
+	Name           string      `yaml:"run_name"`
+	ExperimentID   string      `yaml:"experiment_id"`
+	UserID         string      `yaml:"user_id"`
+	Status         interface{} `yaml:"status"`
+	StartTime      int64       `yaml:"start_time"`
+	EndTime        int64       `yaml:"end_time"`
+	LifecycleStage string      `yaml:"lifecycle_stage"`
+	ArtifactURI    string      `yaml:"artifact_uri"`
+
+	Params  map[string]string         `yaml:"-"`
+	Tags    map[string]string         `yaml:"-"`
+	Metrics map[string][]MLflowMetric `yaml:"-"`
+
+	dir string
+}
+
+// MLflowMetric is a value of a metric of an MLflow run.
+type MLflowMetric struct {
+	Timestamp int64
+	Value     string
+	Step      int64
+}
+
+// ReadMLflowExperiments returns the active experiments of the MLflow file
+// store at root, the mlruns directory.
+func ReadMLflowExperiments(root string) ([]*MLflowExperiment, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	var experiments []*MLflowExperiment
+	for _, entry := range entries {
+		// skips .trash and the model registry
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || entry.Name() == "models" {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		var experiment MLflowExperiment
+		if err := readYaml(filepath.Join(dir, "meta.yaml"), &experiment); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if experiment.LifecycleStage == "deleted" {
+			continue
+		}
+		experiment.dir = dir
+		experiments = append(experiments, &experiment)
+	}
+	sort.Slice(experiments, func(i, j int) bool { return experiments[i].ID < experiments[j].ID })
+	return experiments, nil
+}
+
+// Runs returns the active runs of the experiment, in the order they started.
+func (e *MLflowExperiment) Runs() ([]*MLflowRun, error) {
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	var runs []*MLflowRun
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == "tags" {
+			continue
+		}
+		run, err := readMLflowRun(filepath.Join(e.dir, entry.Name()))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if run.LifecycleStage != "deleted" {
+			runs = append(runs, run)
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].StartTime < runs[j].StartTime })
+	return runs, nil
+}
+
+func readMLflowRun(dir string) (*MLflowRun, error) {
+	run := &MLflowRun{dir: dir}
+	if err := readYaml(filepath.Join(dir, "meta.yaml"), run); err != nil {
+		return nil, err
+	}
+	var err error
+	if run.Params, err = readKeyFiles(filepath.Join(dir, "params")); err != nil {
+		return nil, err
+	}
+	if run.Tags, err = readKeyFiles(filepath.Join(dir, "tags")); err != nil {
+		return nil, err
+	}
+	if run.Metrics, err = readMetrics(filepath.Join(dir, "metrics")); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// ExitCode returns the exit code of the run, 1 if it failed or was killed
+func (r *MLflowRun) ExitCode() int32 {
+	switch r.Status {
+	case mlflowFailed, mlflowKilled, "FAILED", "KILLED":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ArtifactDir returns the directory of the artifacts of the run. It is given
+// by the artifact URI if it is a local directory, as the store may have been
+// moved since.
+func (r *MLflowRun) ArtifactDir() string {
+	if u, err := url.Parse(r.ArtifactURI); err == nil && (u.Scheme == "file" || u.Scheme == "") {
+		if info, err := os.Stat(u.Path); err == nil && info.IsDir() {
+			return u.Path
+		}
+	}
+	return filepath.Join(r.dir, "artifacts")
+}
+
+// readKeyFiles reads a directory with one file per key, holding its value.
+// Keys with slashes are nested directories.
+func readKeyFiles(dir string) (map[string]string, error) {
+	values := make(map[string]string)
+	err := walkKeys(dir, func(key string, path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		values[key] = string(data)
+		return nil
+	})
+	return values, err
+}
+
+// readMetrics reads the metric files of a run, with one
+// "<timestamp> <value> <step>" line per value
+func readMetrics(dir string) (map[string][]MLflowMetric, error) {
+	metrics := make(map[string][]MLflowMetric)
+	err := walkKeys(dir, func(key string, path string) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for line := 1; scanner.Scan(); line++ {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 {
+				continue
+			}
+			if len(fields) < 2 {
+				return fmt.Errorf("importer: %s:%d: invalid metric", path, line)
+			}
+			metric := MLflowMetric{Value: fields[1]}
+			if metric.Timestamp, err = strconv.ParseInt(fields[0], 10, 64); err != nil {
+				return fmt.Errorf("importer: %s:%d: invalid timestamp: %w", path, line, err)
+			}
+			// old stores have no steps
+			if len(fields) > 2 {
+				if metric.Step, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+					return fmt.Errorf("importer: %s:%d: invalid step: %w", path, line, err)
+				}
+			}
+			metrics[key] = append(metrics[key], metric)
+		}
+		return scanner.Err()
+	})
+	return metrics, err
+}
+
+// walkKeys calls f with the key and path of each file under dir
+func walkKeys(dir string, f func(key string, path string) error) error {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		return f(filepath.ToSlash(rel), path)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func readYaml(path string, out interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("importer: invalid %s: %w", path, err)
+	}
+	return nil
+}
+
+// ImportMLflow writes the run logs of the runs of the MLflow file store at
+// root under dir.
+//
+// Params become the config of the runs, along with an "mlflow" key holding
+// the experiment, the user and the tags of the run. The values of the metrics
+// are grouped by step into history rows. The artifacts of a run become W&B
+// artifacts: each logged model, a directory with an MLmodel file, is a
+// "model" artifact, and the other files form an "mlflow-artifacts" artifact.
+func ImportMLflow(
+	logger *observability.CoreLogger,
+	root string,
+	dir string,
+	opts MLflowOptions,
+) ([]*RunLog, error) {
+	experiments, err := ReadMLflowExperiments(root)
+	if err != nil {
+		return nil, err
+	}
+	selected := make(map[string]bool)
+	for _, id := range opts.Experiments {
+		selected[id] = true
+	}
+
+	var runLogs []*RunLog
+	for _, experiment := range experiments {
+		if len(selected) > 0 && !selected[experiment.ID] {
+			continue
+		}
+		runs, err := experiment.Runs()
+		if err != nil {
+			return runLogs, err
+		}
+		for _, run := range runs {
+			runLog, err := importMLflowRun(logger, experiment, run, dir, &opts)
+			if err != nil {
+				return runLogs, fmt.Errorf("importer: run %s: %w", run.ID, err)
+			}
+			runLogs = append(runLogs, runLog)
+		}
+	}
+	return runLogs, nil
+}
+
+func importMLflowRun(
+	logger *observability.CoreLogger,
+	experiment *MLflowExperiment,
+	run *MLflowRun,
+	dir string,
+	opts *MLflowOptions,
+) (*RunLog, error) {
+	record := &service.RunRecord{
+		RunId:       run.ID,
+		DisplayName: utils.NilIfZero(run.Name),
+		Entity:      opts.Entity,
+		Project:     opts.Project,
+		Notes:       utils.NilIfZero(run.Tags[mlflowTagNote]),
+		RunGroup:    run.Tags[mlflowTagParentRun],
+		StartTime:   timestamppb.New(time.UnixMilli(run.StartTime)),
+		Config:      &service.ConfigRecord{},
+	}
+	if name := run.Tags[mlflowTagRunName]; name != "" {
+		record.DisplayName = &name
+	}
+	if record.Project == "" {
+		record.Project = experiment.Name
+	}
+
+	keys := make([]string, 0, len(run.Params))
+	for key := range run.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		record.Config.Update = append(record.Config.Update,
+			&service.ConfigItem{Key: key, ValueJson: csvValueJson(run.Params[key])})
+	}
+	info, err := json.Marshal(map[string]interface{}{
+		"experiment_id":   experiment.ID,
+		"experiment_name": experiment.Name,
+		"run_id":          run.ID,
+		"user_id":         run.UserID,
+		"tags":            run.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	record.Config.Update = append(record.Config.Update,
+		&service.ConfigItem{Key: "mlflow", ValueJson: string(info)})
+
+	runLog, err := NewRunLog(logger, dir, record)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMLflowHistory(runLog, run.Metrics); err != nil {
+		_ = runLog.Finish(1)
+		return nil, err
+	}
+	if err := writeMLflowArtifacts(runLog, run, opts.DigestCache); err != nil {
+		_ = runLog.Finish(1)
+		return nil, err
+	}
+	if run.EndTime > 0 {
+		runLog.SetEndTime(time.UnixMilli(run.EndTime))
+	}
+	if err := runLog.Finish(run.ExitCode()); err != nil {
+		return nil, err
+	}
+	return runLog, nil
+}
+
+// writeMLflowHistory writes a history row per step, holding the latest value
+// of each metric at that step, at the time of the latest of them
+func writeMLflowHistory(runLog *RunLog, metrics map[string][]MLflowMetric) error {
+	type step struct {
+		timestamp int64
+		values    map[string]MLflowMetric
+	}
+	steps := make(map[int64]*step)
+	for key, values := range metrics {
+		for _, metric := range values {
+			s, ok := steps[metric.Step]
+			if !ok {
+				s = &step{values: make(map[string]MLflowMetric)}
+				steps[metric.Step] = s
+			}
+			s.timestamp = max(s.timestamp, metric.Timestamp)
+			if last, ok := s.values[key]; !ok || metric.Timestamp >= last.Timestamp {
+				s.values[key] = metric
+			}
+		}
+	}
+
+	nums := make([]int64, 0, len(steps))
+	for num := range steps {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+	for _, num := range nums {
+		s := steps[num]
+		keys := make([]string, 0, len(s.values))
+		for key := range s.values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]*service.HistoryItem, 0, len(keys))
+		for _, key := range keys {
+			items = append(items,
+				&service.HistoryItem{Key: key, ValueJson: csvValueJson(s.values[key].Value)})
+		}
+		if err := runLog.History(num, time.UnixMilli(s.timestamp), items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMLflowArtifacts logs the artifacts of a run
+func writeMLflowArtifacts(runLog *RunLog, run *MLflowRun, cache *digestcache.Cache) error {
+	root := run.ArtifactDir()
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	hasOtherFiles := false
+	for _, entry := range entries {
+		path := filepath.Join(root, entry.Name())
+		if !isMLflowModel(path) {
+			hasOtherFiles = true
+			continue
+		}
+		name := fmt.Sprintf("mlflow-%s-%s", run.ID, entry.Name())
+		artifact, err := ArtifactFromDir(cache, name, "model", path)
+		if err != nil {
+			return err
+		}
+		if err := runLog.Artifact(artifact); err != nil {
+			return err
+		}
+	}
+	if !hasOtherFiles {
+		return nil
+	}
+
+	// the models have their own artifacts
+	artifact, err := artifactFromDir(cache, "mlflow-"+run.ID, "mlflow-artifacts", root, isMLflowModel)
+	if err != nil {
+		return err
+	}
+	return runLog.Artifact(artifact)
+}
+
+// isMLflowModel returns whether a directory holds a logged model
+func isMLflowModel(path string) bool {
+	info, err := os.Stat(filepath.Join(path, "MLmodel"))
+	return err == nil && info.Mode().IsRegular()
+}
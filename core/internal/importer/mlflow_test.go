@@ -0,0 +1,142 @@
+package importer_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func artifacts(records []*service.Record) []*service.ArtifactRecord {
+	var artifacts []*service.ArtifactRecord
+	for _, record := range records {
+		if artifact := record.GetArtifact(); artifact != nil {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts
+}
+
+func manifestPaths(artifact *service.ArtifactRecord) []string {
+	var paths []string
+	for _, entry := range artifact.GetManifest().GetContents() {
+		paths = append(paths, entry.GetPath())
+	}
+	return paths
+}
+
+func TestReadMLflowExperiments(t *testing.T) {
+	experiments, err := importer.ReadMLflowExperiments(filepath.Join("testdata", "mlruns"))
+	require.NoError(t, err)
+
+	require.Len(t, experiments, 2)
+	assert.Equal(t, "Default", experiments[0].Name)
+	assert.Equal(t, "mnist", experiments[1].Name)
+
+	runs, err := experiments[1].Runs()
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "3f1c0e2a9b8d4c6e8f0a1b2c3d4e5f60", runs[0].ID)
+	assert.Equal(t, int32(0), runs[0].ExitCode())
+	assert.Equal(t, int32(1), runs[1].ExitCode())
+}
+
+func TestImportMLflow(t *testing.T) {
+	runLogs, err := importer.ImportMLflow(
+		observability.NewNoOpLogger(),
+		filepath.Join("testdata", "mlruns"),
+		t.TempDir(),
+		importer.MLflowOptions{Entity: "entity"},
+	)
+	require.NoError(t, err)
+	require.Len(t, runLogs, 2)
+
+	records := readRunLog(t, runLogs[0].SyncFile())
+	run := records[0].GetRun()
+	assert.Equal(t, "3f1c0e2a9b8d4c6e8f0a1b2c3d4e5f60", run.GetRunId())
+	assert.Equal(t, "bright-fox", run.GetDisplayName())
+	assert.Equal(t, "entity", run.GetEntity())
+	assert.Equal(t, "mnist", run.GetProject())
+	assert.Equal(t, "first try", run.GetNotes())
+
+	config := make(map[string]string)
+	for _, item := range run.GetConfig().GetUpdate() {
+		config[item.GetKey()] = item.GetValueJson()
+	}
+	assert.Equal(t, "0.01", config["lr"])
+	assert.Equal(t, `"adam"`, config["optimizer"])
+	assert.Equal(t, "3", config["model/layers"])
+	assert.Contains(t, config["mlflow"], `"experiment_name":"mnist"`)
+	assert.Contains(t, config["mlflow"], `"team":"vision"`)
+
+	// the two values of val/acc at step 2 keep the last one
+	rows := historyRows(records)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "0.9", rows[0]["loss"])
+	assert.Equal(t, "0.5", rows[0]["val/acc"])
+	assert.Equal(t, "1700000015", rows[0]["_timestamp"])
+	assert.Equal(t, "0.75", rows[2]["val/acc"])
+	assert.Equal(t, "1700000145", rows[2]["_timestamp"])
+
+	exit := records[len(records)-1].GetExit()
+	require.NotNil(t, exit)
+	assert.Equal(t, int32(0), exit.GetExitCode())
+	assert.Equal(t, int32(300), exit.GetRuntime())
+
+	artifacts := artifacts(records)
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "mlflow-3f1c0e2a9b8d4c6e8f0a1b2c3d4e5f60-model", artifacts[0].GetName())
+	assert.Equal(t, "model", artifacts[0].GetType())
+	assert.ElementsMatch(t, []string{"MLmodel", "model.pkl"}, manifestPaths(artifacts[0]))
+	assert.Equal(t, "mlflow-3f1c0e2a9b8d4c6e8f0a1b2c3d4e5f60", artifacts[1].GetName())
+	assert.ElementsMatch(t, []string{"notes.txt", "plots/loss.png"}, manifestPaths(artifacts[1]))
+
+	failed := readRunLog(t, runLogs[1].SyncFile())
+	assert.Equal(t, "NaN", historyRows(failed)[0]["loss"])
+	assert.Equal(t, int32(1), failed[len(failed)-1].GetExit().GetExitCode())
+}
+
+func TestImportMLflowExperiments(t *testing.T) {
+	runLogs, err := importer.ImportMLflow(
+		observability.NewNoOpLogger(),
+		filepath.Join("testdata", "mlruns"),
+		t.TempDir(),
+		importer.MLflowOptions{Experiments: []string{"0"}},
+	)
+	require.NoError(t, err)
+	assert.Empty(t, runLogs)
+}
+
+func TestSyncMLflow(t *testing.T) {
+	server := newFakeServer(t)
+	runLogs, err := importer.ImportMLflow(
+		observability.NewNoOpLogger(),
+		filepath.Join("testdata", "mlruns"),
+		t.TempDir(),
+		importer.MLflowOptions{},
+	)
+	require.NoError(t, err)
+
+	_, err = importer.Sync(context.Background(), runLogs[0], server.URL, "api-key")
+	require.NoError(t, err)
+
+	graphql := server.bodies("/graphql")
+	assert.Contains(t, graphql, "CommitArtifact")
+	assert.Contains(t, graphql, "mlflow-3f1c0e2a9b8d4c6e8f0a1b2c3d4e5f60-model")
+	assert.Equal(t,
+		[]string{
+			"/upload/MLmodel",
+			"/upload/manifest",
+			"/upload/model.pkl",
+			"/upload/notes.txt",
+			"/upload/plots/loss.png",
+		},
+		server.paths("/upload/"),
+	)
+}
@@ -0,0 +1,214 @@
+// Package importer turns runs logged by other tools into W&B transaction
+// logs, which are then synced like offline runs.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// RunLog writes the transaction log of a run that already happened.
+//
+// The log is written to an offline run directory named as the SDK names
+// them, so that it can be synced like any offline run. History rows keep the
+// timestamps they are given, and the summary holds the last value of each
+// history key unless it is set explicitly.
+type RunLog struct {
+	logger *observability.CoreLogger
+	store  *server.Store
+	run    *service.RunRecord
+
+	runDir   string
+	syncFile string
+
+	startTime time.Time
+	lastTime  time.Time
+
+	// summary is the consolidated summary of the run
+	summary map[string]string
+
+	// recordNum is the number of the last record written, as the writer
+	// numbers them
+	recordNum int64
+}
+
+// NewRunLog creates the offline run directory of the run under dir and opens
+// its transaction log, starting with the run record. The run must have an id;
+// its start time is the time of the import if not set.
+func NewRunLog(
+	logger *observability.CoreLogger,
+	dir string,
+	run *service.RunRecord,
+) (*RunLog, error) {
+	if run.GetRunId() == "" {
+		return nil, fmt.Errorf("importer: run has no id")
+	}
+	if run.StartTime == nil {
+		run.StartTime = timestamppb.Now()
+	}
+	startTime := run.StartTime.AsTime()
+
+	name := fmt.Sprintf("offline-run-%s-%s", startTime.Local().Format("20060102_150405"), run.RunId)
+	runDir := filepath.Join(dir, name)
+	for _, sub := range []string{"files", "logs", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(runDir, sub), 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	rl := &RunLog{
+		logger:    logger,
+		run:       run,
+		runDir:    runDir,
+		syncFile:  filepath.Join(runDir, fmt.Sprintf("run-%s.wandb", run.RunId)),
+		startTime: startTime,
+		lastTime:  startTime,
+		summary:   make(map[string]string),
+	}
+	rl.store = server.NewStore(context.Background(), rl.syncFile, logger)
+	if err := rl.store.Open(os.O_WRONLY); err != nil {
+		return nil, err
+	}
+	if err := rl.write(&service.Record{RecordType: &service.Record_Run{Run: run}}); err != nil {
+		_ = rl.store.Close()
+		return nil, err
+	}
+	return rl, nil
+}
+
+// Run returns the run record of the log.
+func (rl *RunLog) Run() *service.RunRecord {
+	return rl.run
+}
+
+// Dir returns the offline run directory.
+func (rl *RunLog) Dir() string {
+	return rl.runDir
+}
+
+// SyncFile returns the path of the transaction log.
+func (rl *RunLog) SyncFile() string {
+	return rl.syncFile
+}
+
+// FilesDir returns the directory of the run files.
+func (rl *RunLog) FilesDir() string {
+	return filepath.Join(rl.runDir, "files")
+}
+
+// Config updates the config of the run.
+func (rl *RunLog) Config(items []*service.ConfigItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return rl.write(&service.Record{
+		RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{Update: items},
+		},
+	})
+}
+
+// History logs a history row with the given step and time. The items hold
+// the JSON encoded values of the row.
+func (rl *RunLog) History(step int64, timestamp time.Time, items []*service.HistoryItem) error {
+	if timestamp.After(rl.lastTime) {
+		rl.lastTime = timestamp
+	}
+	row := make([]*service.HistoryItem, 0, len(items)+3)
+	row = append(row, items...)
+	row = append(row,
+		&service.HistoryItem{Key: "_timestamp", ValueJson: formatSeconds(timestamp)},
+		&service.HistoryItem{
+			Key:       "_runtime",
+			ValueJson: strconv.FormatFloat(timestamp.Sub(rl.startTime).Seconds(), 'f', -1, 64),
+		},
+		&service.HistoryItem{Key: "_step", ValueJson: strconv.FormatInt(step, 10)},
+	)
+	corelib.ConsolidateSummaryItems(rl.summary, items)
+	return rl.write(&service.Record{
+		RecordType: &service.Record_History{
+			History: &service.HistoryRecord{
+				Step: &service.HistoryStep{Num: step},
+				Item: row,
+			},
+		},
+	})
+}
+
+// Artifact logs an artifact of the run, which is saved when the log is synced.
+func (rl *RunLog) Artifact(artifact *service.ArtifactRecord) error {
+	artifact.RunId = rl.run.GetRunId()
+	artifact.Entity = rl.run.GetEntity()
+	artifact.Project = rl.run.GetProject()
+	return rl.write(&service.Record{
+		RecordType: &service.Record_Artifact{Artifact: artifact},
+	})
+}
+
+// SetEndTime sets the time the run ended, if later than its last history row.
+func (rl *RunLog) SetEndTime(endTime time.Time) {
+	if endTime.After(rl.lastTime) {
+		rl.lastTime = endTime
+	}
+}
+
+// Summary sets summary values, which replace the last values of the history.
+func (rl *RunLog) Summary(items []*service.SummaryItem) {
+	corelib.ConsolidateSummaryItems(rl.summary, items)
+}
+
+// Finish writes the summary and the exit record of the run, which ended at
+// the time of its last history row unless set otherwise, and closes the log.
+func (rl *RunLog) Finish(exitCode int32) error {
+	runtime := rl.lastTime.Sub(rl.startTime)
+	rl.summary["_wandb"] = fmt.Sprintf(`{"runtime": %d}`, int64(runtime.Seconds()))
+
+	keys := make([]string, 0, len(rl.summary))
+	for key := range rl.summary {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	summary := &service.SummaryRecord{}
+	for _, key := range keys {
+		summary.Update = append(summary.Update, &service.SummaryItem{Key: key, ValueJson: rl.summary[key]})
+	}
+
+	err := rl.write(&service.Record{RecordType: &service.Record_Summary{Summary: summary}})
+	if err == nil {
+		err = rl.write(&service.Record{
+			RecordType: &service.Record_Exit{
+				Exit: &service.RunExitRecord{
+					ExitCode: exitCode,
+					Runtime:  int32(runtime.Seconds()),
+				},
+			},
+		})
+	}
+	if closeErr := rl.store.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (rl *RunLog) write(record *service.Record) error {
+	rl.recordNum++
+	record.Num = rl.recordNum
+	return rl.store.Write(record)
+}
+
+// formatSeconds formats a time as fractional seconds since the epoch
+func formatSeconds(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixMicro())/1e6, 'f', -1, 64)
+}
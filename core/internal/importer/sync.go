@@ -0,0 +1,41 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Sync uploads a finished run log to the W&B server at baseURL and returns
+// the URL of the run. If the API key is empty, it comes from the credential
+// helper named by WANDB_CREDENTIAL_HELPER, or from the netrc file.
+func Sync(ctx context.Context, rl *RunLog, baseURL string, apiKey string) (string, error) {
+	settings := &service.Settings{
+		BaseUrl:     wrapperspb.String(baseURL),
+		ApiKey:      wrapperspb.String(apiKey),
+		RunId:       wrapperspb.String(rl.Run().GetRunId()),
+		SyncDir:     wrapperspb.String(rl.Dir()),
+		SyncFile:    wrapperspb.String(rl.SyncFile()),
+		FilesDir:    wrapperspb.String(rl.FilesDir()),
+		LogDir:      wrapperspb.String(filepath.Join(rl.Dir(), "logs")),
+		LogInternal: wrapperspb.String(filepath.Join(rl.Dir(), "logs", "debug-internal.log")),
+		TmpDir:      wrapperspb.String(filepath.Join(rl.Dir(), "tmp")),
+		XSync:       wrapperspb.Bool(true),
+	}
+	if err := server.ResolveAPIKey(ctx, settings); err != nil {
+		return "", fmt.Errorf("importer: no API key: %w", err)
+	}
+	response, err := server.SyncFile(ctx, settings)
+	if err != nil {
+		return "", err
+	}
+	if response.GetError() != nil {
+		return "", fmt.Errorf("importer: sync failed: %s", response.GetError().GetMessage())
+	}
+	return response.GetUrl(), nil
+}
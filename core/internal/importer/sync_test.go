@@ -0,0 +1,126 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/importer"
+)
+
+// fakeServer answers the GraphQL requests of a sync, with an upserted run and
+// the uploads of artifacts, and records the bodies of the requests by path
+type fakeServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests map[string][]string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	fs := &fakeServer{requests: make(map[string][]string)}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fs.mu.Lock()
+		fs.requests[r.URL.Path] = append(fs.requests[r.URL.Path], string(body))
+		fs.mu.Unlock()
+		if r.URL.Path == "/graphql" {
+			_, _ = w.Write([]byte(fs.graphql(body)))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+func (fs *fakeServer) graphql(body []byte) string {
+	var request struct {
+		OperationName string `json:"operationName"`
+		Variables     struct {
+			ArtifactFiles []struct {
+				Name string `json:"name"`
+			} `json:"artifactFiles"`
+		} `json:"variables"`
+	}
+	_ = json.Unmarshal(body, &request)
+	switch request.OperationName {
+	case "UpsertBucket":
+		return `{"data": {"upsertBucket": {"bucket": {` +
+			`"id": "id", "name": "run", "displayName": "run",` +
+			`"project": {"name": "imported", "entity": {"name": "entity"}}}}}}`
+	case "CreateArtifact":
+		return `{"data": {"createArtifact": {"artifact": {` +
+			`"id": "artifact", "state": "PENDING", "artifactSequence": {}}}}}`
+	case "CreateArtifactManifest":
+		return fmt.Sprintf(`{"data": {"createArtifactManifest": {"artifactManifest": {`+
+			`"id": "manifest", "file": {"id": "file", "uploadUrl": "%s/upload/manifest"}}}}}`, fs.URL)
+	case "CreateArtifactFiles":
+		var edges []string
+		for _, file := range request.Variables.ArtifactFiles {
+			edges = append(edges, fmt.Sprintf(
+				`{"node": {"uploadUrl": "%s/upload/%s", "artifact": {"id": "artifact"}}}`,
+				fs.URL, file.Name))
+		}
+		return `{"data": {"createArtifactFiles": {"files": {"edges": [` +
+			strings.Join(edges, ",") + `]}}}}`
+	case "CommitArtifact":
+		return `{"data": {"commitArtifact": {"artifact": {"id": "artifact"}}}}`
+	default:
+		return `{"data": {}}`
+	}
+}
+
+func (fs *fakeServer) bodies(path string) string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return strings.Join(fs.requests[path], "\n")
+}
+
+// paths returns the sorted paths of the requests with the given prefix
+func (fs *fakeServer) paths(prefix string) []string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	var paths []string
+	for path := range fs.requests {
+		if strings.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestSync(t *testing.T) {
+	server := newFakeServer(t)
+	runLog, _ := importFixture(t, "metrics.csv", "metrics_mapping.json")
+
+	url, err := importer.Sync(context.Background(), runLog, server.URL, "api-key")
+	require.NoError(t, err)
+
+	assert.Equal(t, server.URL+"/entity/imported/runs/csvrun", url)
+	assert.Contains(t, server.bodies("/graphql"), "UpsertBucket")
+	stream := server.bodies("/files/entity/imported/csvrun/file_stream")
+	assert.Contains(t, stream, `_timestamp\":1700000060.5`)
+	assert.Contains(t, stream, `final_acc\":0.71`)
+	assert.Contains(t, stream, `"complete":true`)
+}
+
+func TestSyncMissingLog(t *testing.T) {
+	server := newFakeServer(t)
+	runLog, _ := importFixture(t, "metrics.csv", "metrics_mapping.json")
+	require.NoError(t, os.Remove(runLog.SyncFile()))
+
+	_, err := importer.Sync(context.Background(), runLog, server.URL, "api-key")
+	assert.ErrorContains(t, err, "can't read")
+}
@@ -0,0 +1,515 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// Timestamp formats of the timestamp column, besides Go time layouts.
+const (
+	// TimestampUnix is fractional seconds since the epoch, the default
+	TimestampUnix = "unix"
+	// TimestampUnixMilli is milliseconds since the epoch
+	TimestampUnixMilli = "unix_ms"
+	// TimestampRFC3339 is an RFC 3339 date and time
+	TimestampRFC3339 = "rfc3339"
+)
+
+// RunInfo describes the run a table is imported as.
+type RunInfo struct {
+	// ID is the id of the run, random if empty
+	ID string `json:"id"`
+	// Name is the display name of the run, the name of the file if empty
+	Name    string   `json:"name"`
+	Entity  string   `json:"entity"`
+	Project string   `json:"project"`
+	Group   string   `json:"group"`
+	Notes   string   `json:"notes"`
+	Tags    []string `json:"tags"`
+}
+
+// record returns the run record of the run, with a random id if it has none
+func (info *RunInfo) record() *service.RunRecord {
+	run := &service.RunRecord{
+		RunId:       info.ID,
+		DisplayName: utils.NilIfZero(info.Name),
+		Entity:      info.Entity,
+		Project:     info.Project,
+		RunGroup:    info.Group,
+		Notes:       utils.NilIfZero(info.Notes),
+		Tags:        info.Tags,
+	}
+	if run.RunId == "" {
+		run.RunId = shared.ShortID(8)
+	}
+	return run
+}
+
+// Mapping maps the columns of a CSV file, or the fields of a JSON lines
+// file, to the config, history and summary of a run.
+//
+// Each row is a history row. Consecutive rows with the same step are merged
+// into one. The config takes the first value of its columns and the summary
+// the last one. Columns that are not mapped otherwise go to the history,
+// unless History names the history columns.
+type Mapping struct {
+	Run RunInfo `json:"run"`
+
+	// Step is the column of the step, if any; rows are numbered from 0
+	// otherwise
+	Step string `json:"step"`
+	// Timestamp is the column of the time of the rows, if any; rows are
+	// given the time of the import otherwise
+	Timestamp string `json:"timestamp"`
+	// TimestampFormat is "unix", "unix_ms", "rfc3339" or a Go time layout
+	TimestampFormat string `json:"timestamp_format"`
+
+	Config  []string `json:"config"`
+	Summary []string `json:"summary"`
+	History []string `json:"history"`
+	Ignore  []string `json:"ignore"`
+
+	// Rename gives the keys of the columns whose key is not their name
+	Rename map[string]string `json:"rename"`
+}
+
+// LoadMapping reads a mapping file.
+func LoadMapping(path string) (*Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var mapping Mapping
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("importer: invalid mapping %s: %w", path, err)
+	}
+	return &mapping, nil
+}
+
+// key returns the key a column is logged under
+func (m *Mapping) key(column string) string {
+	if key, ok := m.Rename[column]; ok {
+		return key
+	}
+	return column
+}
+
+// columnKind is where the values of a column go
+type columnKind int
+
+const (
+	columnHistory columnKind = iota
+	columnConfig
+	columnSummary
+	columnSkip
+)
+
+func (m *Mapping) kinds() func(column string) columnKind {
+	kinds := make(map[string]columnKind)
+	for _, column := range m.Ignore {
+		kinds[column] = columnSkip
+	}
+	for _, column := range m.Config {
+		kinds[column] = columnConfig
+	}
+	for _, column := range m.Summary {
+		kinds[column] = columnSummary
+	}
+	for _, column := range []string{m.Step, m.Timestamp} {
+		if column != "" {
+			kinds[column] = columnSkip
+		}
+	}
+	history := make(map[string]bool)
+	for _, column := range m.History {
+		history[column] = true
+	}
+	return func(column string) columnKind {
+		if kind, ok := kinds[column]; ok {
+			return kind
+		}
+		if len(history) > 0 && !history[column] {
+			return columnSkip
+		}
+		return columnHistory
+	}
+}
+
+// parseTimestamp parses a value of the timestamp column
+func (m *Mapping) parseTimestamp(value string) (time.Time, error) {
+	value = strings.Trim(value, `"`)
+	switch m.TimestampFormat {
+	case "", TimestampUnix:
+		seconds, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMicro(int64(seconds * 1e6)), nil
+	case TimestampUnixMilli:
+		millis, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMicro(int64(millis * 1e3)), nil
+	case TimestampRFC3339:
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return time.Parse(m.TimestampFormat, value)
+	}
+}
+
+// rowReader reads the rows of a table, as JSON encoded values by column
+type rowReader interface {
+	Next() (map[string]string, error)
+}
+
+// csvReader reads a CSV file with a header row; values that are not numbers,
+// booleans or JSON objects or arrays are strings, and empty values are missing
+type csvReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+func newCSVReader(r io.Reader, comma rune) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("importer: can't read header: %w", err)
+	}
+	return &csvReader{reader: reader, header: append([]string(nil), header...)}, nil
+}
+
+func (cr *csvReader) Next() (map[string]string, error) {
+	record, err := cr.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	row := make(map[string]string, len(record))
+	for i, value := range record {
+		if i >= len(cr.header) || value == "" {
+			continue
+		}
+		row[cr.header[i]] = csvValueJson(value)
+	}
+	return row, nil
+}
+
+// csvValueJson returns the JSON encoding of a CSV value. Numbers that JSON
+// can't hold are encoded as Python's json module does.
+func csvValueJson(value string) string {
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		switch {
+		case math.IsNaN(number):
+			return "NaN"
+		case math.IsInf(number, 1):
+			return "Infinity"
+		case math.IsInf(number, -1):
+			return "-Infinity"
+		case json.Valid([]byte(value)):
+			return value
+		}
+	}
+	if value == "true" || value == "false" {
+		return value
+	}
+	if (strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[")) && json.Valid([]byte(value)) {
+		return value
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
+
+// jsonlReader reads a file with one JSON object per line
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	return &jsonlReader{scanner: scanner}
+}
+
+func (jr *jsonlReader) Next() (map[string]string, error) {
+	for jr.scanner.Scan() {
+		jr.line++
+		line := strings.TrimSpace(jr.scanner.Text())
+		if line == "" {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			return nil, fmt.Errorf("line %d: %w", jr.line, err)
+		}
+		row := make(map[string]string, len(fields))
+		for key, value := range fields {
+			if string(value) == "null" {
+				continue
+			}
+			row[key] = string(value)
+		}
+		return row, nil
+	}
+	if err := jr.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// ImportTable writes the run log of the CSV, TSV or JSON lines file at path
+// under dir, as described by the mapping. The format is given by the file
+// extension.
+func ImportTable(
+	logger *observability.CoreLogger,
+	path string,
+	mapping *Mapping,
+	dir string,
+) (*RunLog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rows rowReader
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		rows, err = newCSVReader(f, ',')
+	case ".tsv":
+		rows, err = newCSVReader(f, '\t')
+	case ".jsonl", ".ndjson", ".json":
+		rows = newJSONLReader(f)
+	default:
+		err = fmt.Errorf("importer: unsupported file type %q", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	run := mapping.Run.record()
+	if run.GetDisplayName() == "" {
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		run.DisplayName = &name
+	}
+
+	ti := &tableImporter{
+		logger:    logger,
+		dir:       dir,
+		run:       run,
+		mapping:   mapping,
+		kind:      mapping.kinds(),
+		now:       time.Now(),
+		configSet: make(map[string]bool),
+		summary:   make(map[string]string),
+	}
+	if err := ti.read(rows); err != nil {
+		if ti.runLog != nil {
+			_ = ti.runLog.Finish(1)
+		}
+		return nil, fmt.Errorf("importer: %s: %w", path, err)
+	}
+	ti.runLog.Summary(ti.summaryItems())
+	if err := ti.runLog.Finish(0); err != nil {
+		return nil, err
+	}
+	return ti.runLog, nil
+}
+
+// tableRow is a history row of an imported table
+type tableRow struct {
+	step      int64
+	timestamp time.Time
+	items     []*service.HistoryItem
+}
+
+// tableImporter maps the rows of a table to a run log.
+//
+// The run log is created with the first history row, which gives the start
+// time of the run. A history row is written once the next row has a higher
+// step, so that the rows of the same step are merged.
+type tableImporter struct {
+	logger  *observability.CoreLogger
+	dir     string
+	run     *service.RunRecord
+	mapping *Mapping
+	kind    func(column string) columnKind
+	now     time.Time
+
+	runLog *RunLog
+
+	// config are the config items not written yet
+	config    []*service.ConfigItem
+	configSet map[string]bool
+	summary   map[string]string
+	pending   *tableRow
+}
+
+func (ti *tableImporter) read(rows rowReader) error {
+	for n := 0; ; n++ {
+		row, err := rows.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := ti.add(n, row); err != nil {
+			return fmt.Errorf("row %d: %w", n+1, err)
+		}
+	}
+
+	if err := ti.start(ti.now); err != nil {
+		return err
+	}
+	return ti.flush()
+}
+
+func (ti *tableImporter) add(n int, row map[string]string) error {
+	step := int64(n)
+	if column := ti.mapping.Step; column != "" {
+		value, ok := row[column]
+		if !ok {
+			return fmt.Errorf("no step in column %q", column)
+		}
+		parsed, err := strconv.ParseFloat(strings.Trim(value, `"`), 64)
+		if err != nil || parsed != float64(int64(parsed)) {
+			return fmt.Errorf("step %s is not an integer", value)
+		}
+		step = int64(parsed)
+	}
+	timestamp := ti.now
+	if column := ti.mapping.Timestamp; column != "" {
+		value, ok := row[column]
+		if !ok {
+			return fmt.Errorf("no timestamp in column %q", column)
+		}
+		parsed, err := ti.mapping.parseTimestamp(value)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %s: %w", value, err)
+		}
+		timestamp = parsed
+	}
+
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	var items []*service.HistoryItem
+	for _, column := range columns {
+		key, value := ti.mapping.key(column), row[column]
+		switch ti.kind(column) {
+		case columnConfig:
+			if !ti.configSet[key] {
+				ti.configSet[key] = true
+				ti.config = append(ti.config, &service.ConfigItem{Key: key, ValueJson: value})
+			}
+		case columnSummary:
+			ti.summary[key] = value
+		case columnHistory:
+			items = append(items, &service.HistoryItem{Key: key, ValueJson: value})
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	if ti.pending != nil {
+		switch {
+		case step < ti.pending.step:
+			return fmt.Errorf("step %d is lower than the previous step %d", step, ti.pending.step)
+		case step == ti.pending.step:
+			ti.pending.timestamp = timestamp
+			ti.pending.items = mergeItems(ti.pending.items, items)
+			return nil
+		}
+	}
+	if err := ti.start(timestamp); err != nil {
+		return err
+	}
+	if err := ti.flush(); err != nil {
+		return err
+	}
+	ti.pending = &tableRow{step: step, timestamp: timestamp, items: items}
+	return nil
+}
+
+// start creates the run log if it does not exist yet
+func (ti *tableImporter) start(startTime time.Time) error {
+	if ti.runLog != nil {
+		return nil
+	}
+	ti.run.StartTime = timestamppb.New(startTime)
+	ti.run.Config = &service.ConfigRecord{Update: ti.config}
+	ti.config = nil
+	runLog, err := NewRunLog(ti.logger, ti.dir, ti.run)
+	if err != nil {
+		return err
+	}
+	ti.runLog = runLog
+	return nil
+}
+
+// flush writes the pending history row and config items
+func (ti *tableImporter) flush() error {
+	if err := ti.runLog.Config(ti.config); err != nil {
+		return err
+	}
+	ti.config = nil
+	if ti.pending == nil {
+		return nil
+	}
+	row := ti.pending
+	ti.pending = nil
+	return ti.runLog.History(row.step, row.timestamp, row.items)
+}
+
+func (ti *tableImporter) summaryItems() []*service.SummaryItem {
+	keys := make([]string, 0, len(ti.summary))
+	for key := range ti.summary {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]*service.SummaryItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, &service.SummaryItem{Key: key, ValueJson: ti.summary[key]})
+	}
+	return items
+}
+
+// mergeItems adds items to a row, replacing the values of the same keys
+func mergeItems(row []*service.HistoryItem, items []*service.HistoryItem) []*service.HistoryItem {
+	index := make(map[string]int, len(row))
+	for i, item := range row {
+		index[item.Key] = i
+	}
+	for _, item := range items {
+		if i, ok := index[item.Key]; ok {
+			row[i] = item
+			continue
+		}
+		row = append(row, item)
+	}
+	return row
+}
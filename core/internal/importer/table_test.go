@@ -0,0 +1,179 @@
+package importer_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/importer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// readRunLog returns the records of a transaction log
+func readRunLog(t *testing.T, path string) []*service.Record {
+	store := server.NewStore(context.Background(), path, observability.NewNoOpLogger())
+	require.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	var records []*service.Record
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+// historyRows returns the history rows of a run log as maps from keys to
+// JSON values
+func historyRows(records []*service.Record) []map[string]string {
+	var rows []map[string]string
+	for _, record := range records {
+		if history := record.GetHistory(); history != nil {
+			row := make(map[string]string)
+			for _, item := range history.GetItem() {
+				row[item.GetKey()] = item.GetValueJson()
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func summaryValues(records []*service.Record) map[string]string {
+	values := make(map[string]string)
+	for _, record := range records {
+		for _, item := range record.GetSummary().GetUpdate() {
+			values[item.GetKey()] = item.GetValueJson()
+		}
+	}
+	return values
+}
+
+func importFixture(t *testing.T, name string, mappingName string) (*importer.RunLog, []*service.Record) {
+	mapping, err := importer.LoadMapping(filepath.Join("testdata", mappingName))
+	require.NoError(t, err)
+	runLog, err := importer.ImportTable(
+		observability.NewNoOpLogger(),
+		filepath.Join("testdata", name),
+		mapping,
+		t.TempDir(),
+	)
+	require.NoError(t, err)
+	return runLog, readRunLog(t, runLog.SyncFile())
+}
+
+func TestImportCSV(t *testing.T) {
+	runLog, records := importFixture(t, "metrics.csv", "metrics_mapping.json")
+
+	assert.Equal(t, "run-csvrun.wandb", filepath.Base(runLog.SyncFile()))
+	assert.Contains(t, filepath.Base(runLog.Dir()), "offline-run-")
+	assert.DirExists(t, runLog.FilesDir())
+
+	run := records[0].GetRun()
+	require.NotNil(t, run)
+	assert.Equal(t, "csvrun", run.GetRunId())
+	assert.Equal(t, "metrics", run.GetDisplayName())
+	assert.Equal(t, "imported", run.GetProject())
+	assert.Equal(t, "legacy", run.GetRunGroup())
+	assert.Equal(t, []string{"csv"}, run.GetTags())
+	assert.Equal(t, time.Unix(1700000000, 0), run.GetStartTime().AsTime().Local())
+	assert.Equal(t,
+		[]*service.ConfigItem{{Key: "optimizer", ValueJson: `"adam"`}},
+		run.GetConfig().GetUpdate(),
+	)
+
+	// the two rows of epoch 0 are merged, keeping the time of the last one
+	rows := historyRows(records)
+	require.Len(t, rows, 3)
+	assert.Equal(t, map[string]string{
+		"loss":       "0.9",
+		"accuracy":   "0.55",
+		"lr":         "0.1",
+		"_step":      "0",
+		"_timestamp": "1700000010",
+		"_runtime":   "10",
+	}, rows[0])
+	assert.Equal(t, "1700000060.5", rows[1]["_timestamp"])
+	assert.Equal(t, "60.5", rows[1]["_runtime"])
+	assert.Equal(t, "2", rows[2]["_step"])
+	assert.Equal(t, "0.05", rows[2]["lr"])
+	assert.NotContains(t, rows[2], "final_acc")
+
+	summary := summaryValues(records)
+	assert.Equal(t, "0.5", summary["loss"])
+	assert.Equal(t, "0.7", summary["accuracy"])
+	assert.Equal(t, "0.71", summary["final_acc"])
+	assert.Equal(t, `{"runtime": 120}`, summary["_wandb"])
+
+	exit := records[len(records)-1].GetExit()
+	require.NotNil(t, exit)
+	assert.Equal(t, int32(0), exit.GetExitCode())
+	assert.Equal(t, int32(120), exit.GetRuntime())
+}
+
+func TestImportJSONL(t *testing.T) {
+	_, records := importFixture(t, "events.jsonl", "events_mapping.json")
+
+	run := records[0].GetRun()
+	assert.Equal(t, "jsonlrun", run.GetRunId())
+	assert.Equal(t, "events", run.GetDisplayName())
+	assert.Equal(t,
+		[]*service.ConfigItem{{Key: "params", ValueJson: `{"lr": 0.1}`}},
+		run.GetConfig().GetUpdate(),
+	)
+
+	rows := historyRows(records)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "1700000000", rows[0]["_timestamp"])
+	assert.Equal(t, "5", rows[1]["_step"])
+	assert.Equal(t, "60.25", rows[1]["_runtime"])
+	assert.Equal(t, `"warmup done"`, rows[1]["note"])
+	assert.NotContains(t, rows[1], "debug")
+}
+
+func TestImportDecreasingStep(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.csv")
+	require.NoError(t, os.WriteFile(path, []byte("step,loss\n3,1\n2,0.5\n"), 0644))
+
+	_, err := importer.ImportTable(
+		observability.NewNoOpLogger(),
+		path,
+		&importer.Mapping{Step: "step"},
+		t.TempDir(),
+	)
+	assert.ErrorContains(t, err, "row 2: step 2 is lower than the previous step 3")
+}
+
+func TestImportCSVValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.csv")
+	require.NoError(t, os.WriteFile(path,
+		[]byte("a,b,c,d,e,f\n1e3,true,nan,-inf,007,\"[1, 2]\"\n"), 0644))
+
+	runLog, err := importer.ImportTable(
+		observability.NewNoOpLogger(),
+		path,
+		&importer.Mapping{},
+		t.TempDir(),
+	)
+	require.NoError(t, err)
+
+	rows := historyRows(readRunLog(t, runLog.SyncFile()))
+	require.Len(t, rows, 1)
+	assert.Equal(t, "1e3", rows[0]["a"])
+	assert.Equal(t, "true", rows[0]["b"])
+	assert.Equal(t, "NaN", rows[0]["c"])
+	assert.Equal(t, "-Infinity", rows[0]["d"])
+	assert.Equal(t, `"007"`, rows[0]["e"])
+	assert.Equal(t, "[1, 2]", rows[0]["f"])
+	assert.Equal(t, "0", rows[0]["_step"])
+}
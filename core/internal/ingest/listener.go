@@ -0,0 +1,390 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// DefaultInterval is the interval the metrics are aggregated over when
+	// they are written as system metrics
+	DefaultInterval = 10 * time.Second
+
+	// DefaultPrefix is prepended to the keys of the ingested metrics
+	DefaultPrefix = "ingest/"
+
+	// maxPacketSize is the size of the largest StatsD packet read
+	maxPacketSize = 64 * 1024
+
+	// maxBodySize is the size of the largest HTTP request body read
+	maxBodySize = 1024 * 1024
+)
+
+// Target is where the ingested metrics are written.
+type Target string
+
+const (
+	// HistoryTarget adds the metrics to the history row of the current step
+	HistoryTarget Target = "history"
+	// SystemTarget writes the metrics as system metrics
+	SystemTarget Target = "system"
+)
+
+// ParseTarget parses the _ingest_target setting, which is the history if
+// empty.
+func ParseTarget(value string) (Target, error) {
+	switch Target(value) {
+	case "", HistoryTarget:
+		return HistoryTarget, nil
+	case SystemTarget:
+		return SystemTarget, nil
+	default:
+		return HistoryTarget, fmt.Errorf("ingest: unknown target %q", value)
+	}
+}
+
+// Listener receives metrics over StatsD on UDP, and over HTTP on the
+// loopback interface, and writes their aggregates to the run.
+//
+// With the system target, the aggregates are written as system metrics at
+// every interval. With the history target, the metrics are aggregated over
+// each history row instead: the handler takes their aggregates with
+// HistoryItems as it commits a row, so that the counters and timers of a row
+// cover all the intervals of its step.
+//
+// The HTTP endpoint takes POST requests to /metrics whose body is either
+// StatsD lines or, with a JSON content type, a JSON list of metrics such as
+// {"name": "queue_depth", "type": "gauge", "value": 3}.
+type Listener struct {
+	logger *observability.CoreLogger
+
+	// outChan is the channel the records of the metrics are sent to
+	outChan chan *service.Record
+
+	statsdAddress string
+	httpAddress   string
+	prefix        string
+	interval      time.Duration
+	target        Target
+
+	aggregator *Aggregator
+
+	packetConn net.PacketConn
+	httpServer *http.Server
+	httpAddr   net.Addr
+
+	wg   sync.WaitGroup
+	stop chan struct{}
+
+	// unsent are the records the flush loop had not sent when it stopped
+	unsent []*service.Record
+}
+
+// NewListener returns the listener of the _ingest settings, or nil if they
+// configure no address to listen on.
+func NewListener(
+	settings *service.Settings,
+	logger *observability.CoreLogger,
+	outChan chan *service.Record,
+) *Listener {
+	statsdAddress := settings.GetXIngestStatsdAddress().GetValue()
+	httpAddress := settings.GetXIngestHttpAddress().GetValue()
+	if statsdAddress == "" && httpAddress == "" {
+		return nil
+	}
+
+	target, err := ParseTarget(settings.GetXIngestTarget().GetValue())
+	if err != nil {
+		logger.CaptureError("ingest: writing the metrics to the history", err)
+	}
+	prefix := DefaultPrefix
+	if settings.XIngestPrefix != nil {
+		prefix = settings.GetXIngestPrefix().GetValue()
+	}
+	interval := DefaultInterval
+	if seconds := settings.GetXIngestIntervalSeconds().GetValue(); seconds > 0 {
+		interval = time.Duration(seconds * float64(time.Second))
+	}
+
+	return &Listener{
+		logger:        logger,
+		outChan:       outChan,
+		statsdAddress: statsdAddress,
+		httpAddress:   httpAddress,
+		prefix:        prefix,
+		interval:      interval,
+		target:        target,
+		aggregator:    NewAggregator(),
+	}
+}
+
+// Start starts listening on the configured addresses. The HTTP address must
+// be a loopback address, since the endpoint has no authentication.
+func (l *Listener) Start() error {
+	if l == nil {
+		return nil
+	}
+
+	if l.statsdAddress != "" {
+		conn, err := net.ListenPacket("udp", l.statsdAddress)
+		if err != nil {
+			return fmt.Errorf("ingest: listening for StatsD: %w", err)
+		}
+		l.packetConn = conn
+	}
+	if l.httpAddress != "" {
+		listener, err := listenLoopback(l.httpAddress)
+		if err != nil {
+			l.closeConns()
+			return err
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/metrics", l.serveMetrics)
+		l.httpServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		l.httpAddr = listener.Addr()
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			if err := l.httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+				l.logger.CaptureError("ingest: serving http", err)
+			}
+		}()
+	}
+	if l.packetConn != nil {
+		l.wg.Add(1)
+		go l.readStatsD()
+	}
+
+	l.stop = make(chan struct{})
+	if l.target == SystemTarget {
+		l.wg.Add(1)
+		go l.flushLoop(l.stop)
+	}
+
+	l.logger.Info("ingest: started", "statsd", l.StatsDAddr(), "http", l.HTTPAddr())
+	return nil
+}
+
+func listenLoopback(address string) (net.Listener, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: http address: %w", err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("ingest: http address %q is not a loopback address", address)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("ingest: listening for http: %w", err)
+	}
+	return listener, nil
+}
+
+// StatsDAddr returns the address StatsD packets are received on, or nil.
+func (l *Listener) StatsDAddr() net.Addr {
+	if l == nil || l.packetConn == nil {
+		return nil
+	}
+	return l.packetConn.LocalAddr()
+}
+
+// HTTPAddr returns the address of the HTTP endpoint, or nil.
+func (l *Listener) HTTPAddr() net.Addr {
+	if l == nil {
+		return nil
+	}
+	return l.httpAddr
+}
+
+// HistoryItems returns the history items of the metrics aggregated since the
+// previous call, for the row being committed. It returns nil with the system
+// target, whose metrics are sent at every interval.
+func (l *Listener) HistoryItems() []*service.HistoryItem {
+	if l == nil || l.target != HistoryTarget {
+		return nil
+	}
+	aggregates := l.aggregator.Flush()
+	if len(aggregates) == 0 {
+		return nil
+	}
+	return l.historyItems(aggregates)
+}
+
+// Stop stops listening, and returns the records of the metrics that were not
+// sent yet, including those of the last interval or row. They are returned rather
+// than sent, since the caller may be the reader of the channel.
+func (l *Listener) Stop() []*service.Record {
+	if l == nil || l.stop == nil {
+		return nil
+	}
+	close(l.stop)
+	l.stop = nil
+	l.closeConns()
+	l.wg.Wait()
+
+	records := l.unsent
+	l.unsent = nil
+	if aggregates := l.aggregator.Flush(); len(aggregates) > 0 {
+		records = append(records, l.record(aggregates, time.Now()))
+	}
+	l.logger.Info("ingest: stopped")
+	return records
+}
+
+func (l *Listener) closeConns() {
+	if l.packetConn != nil {
+		_ = l.packetConn.Close()
+	}
+	if l.httpServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = l.httpServer.Shutdown(ctx)
+	}
+}
+
+func (l *Listener) readStatsD() {
+	defer l.wg.Done()
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, _, err := l.packetConn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				l.logger.CaptureError("ingest: reading StatsD", err)
+			}
+			return
+		}
+		metrics, errs := ParseStatsD(string(buf[:n]))
+		for _, err := range errs {
+			l.logger.Warn("ingest: dropping StatsD line", "error", err)
+		}
+		for _, metric := range metrics {
+			l.aggregator.Add(metric)
+		}
+	}
+}
+
+func (l *Listener) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var metrics []Metric
+	var errs []error
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.Unmarshal(body, &metrics); err != nil {
+			http.Error(w, fmt.Sprintf("ingest: invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		for _, metric := range metrics {
+			if err := metric.validate(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	} else {
+		metrics, errs = ParseStatsD(string(body))
+	}
+	// a request is taken whole or not at all, so that it can be retried
+	if len(errs) > 0 {
+		http.Error(w, errors.Join(errs...).Error(), http.StatusBadRequest)
+		return
+	}
+	for _, metric := range metrics {
+		l.aggregator.Add(metric)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (l *Listener) flushLoop(stop <-chan struct{}) {
+	defer l.wg.Done()
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+		aggregates := l.aggregator.Flush()
+		if len(aggregates) == 0 {
+			continue
+		}
+		record := l.record(aggregates, time.Now())
+		select {
+		case l.outChan <- record:
+		case <-stop:
+			l.unsent = append(l.unsent, record)
+			return
+		}
+	}
+}
+
+// record returns the record of the aggregates of an interval, or of those
+// left at the end of the run
+func (l *Listener) record(aggregates map[string]float64, t time.Time) *service.Record {
+	if l.target == SystemTarget {
+		keys := sortedKeys(aggregates)
+		stats := &service.StatsRecord{
+			StatsType: service.StatsRecord_SYSTEM,
+			Timestamp: timestamppb.New(t),
+		}
+		for _, key := range keys {
+			stats.Item = append(stats.Item, &service.StatsItem{
+				Key:       l.prefix + key,
+				ValueJson: formatFloat(aggregates[key]),
+			})
+		}
+		return &service.Record{
+			RecordType: &service.Record_Stats{Stats: stats},
+			Control:    &service.Control{AlwaysSend: true},
+		}
+	}
+
+	// the metrics join the row of the current step, which the run commits
+	return &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_PartialHistory{PartialHistory: &service.PartialHistoryRequest{
+				Item:   l.historyItems(aggregates),
+				Action: &service.HistoryAction{Flush: false},
+			}},
+		}},
+		Control: &service.Control{Local: true},
+	}
+}
+
+// historyItems returns the history items of aggregates, in key order
+func (l *Listener) historyItems(aggregates map[string]float64) []*service.HistoryItem {
+	keys := sortedKeys(aggregates)
+	items := make([]*service.HistoryItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, &service.HistoryItem{
+			Key:       l.prefix + key,
+			ValueJson: formatFloat(aggregates[key]),
+		})
+	}
+	return items
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,221 @@
+package ingest_test
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/ingest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestParseStatsD(t *testing.T) {
+	metrics, errs := ingest.ParseStatsD(
+		"jobs:1|c\njobs:2|c|@0.5|#queue:a\nqueue:5|g\nqueue:-2|g\nlatency:12.5|ms\nsize:3|h\n" +
+			"bad\nusers:x|s\nnan:NaN|g\n_step:1|g\n")
+
+	assert.Equal(t, []ingest.Metric{
+		{Name: "jobs", Type: ingest.Counter, Value: 1},
+		{Name: "jobs", Type: ingest.Counter, Value: 4},
+		{Name: "queue", Type: ingest.Gauge, Value: 5},
+		{Name: "queue", Type: ingest.Gauge, Value: -2, Delta: true},
+		{Name: "latency", Type: ingest.Timer, Value: 12.5},
+		{Name: "size", Type: ingest.Timer, Value: 3},
+	}, metrics)
+	require.Len(t, errs, 4)
+	assert.ErrorContains(t, errs[0], `"bad": missing value`)
+	assert.ErrorContains(t, errs[1], `unsupported type "s"`)
+	assert.ErrorContains(t, errs[2], "value is not finite")
+	assert.ErrorContains(t, errs[3], "names starting with _ are reserved")
+}
+
+func TestAggregator(t *testing.T) {
+	aggregator := ingest.NewAggregator()
+	for _, metric := range []ingest.Metric{
+		{Name: "jobs", Type: ingest.Counter, Value: 1},
+		{Name: "jobs", Type: ingest.Counter, Value: 4},
+		{Name: "queue", Type: ingest.Gauge, Value: 5},
+		{Name: "queue", Type: ingest.Gauge, Value: 2, Delta: true},
+		{Name: "latency", Type: ingest.Timer, Value: 10},
+		{Name: "latency", Type: ingest.Timer, Value: 30},
+		{Name: "latency", Type: ingest.Timer, Value: 20},
+	} {
+		aggregator.Add(metric)
+	}
+	assert.Equal(t, map[string]float64{
+		"jobs":          5,
+		"queue":         7,
+		"latency/mean":  20,
+		"latency/min":   10,
+		"latency/max":   30,
+		"latency/count": 3,
+	}, aggregator.Flush())
+
+	// the next interval only has the updated metrics, and gauges change
+	// from their last value
+	aggregator.Add(ingest.Metric{Name: "queue", Type: ingest.Gauge, Value: -1, Delta: true})
+	assert.Equal(t, map[string]float64{"queue": 6}, aggregator.Flush())
+	assert.Empty(t, aggregator.Flush())
+}
+
+func startListener(t *testing.T, settings *service.Settings) (*ingest.Listener, chan *service.Record) {
+	outChan := make(chan *service.Record, 100)
+	listener := ingest.NewListener(settings, observability.NewNoOpLogger(), outChan)
+	require.NotNil(t, listener)
+	require.NoError(t, listener.Start())
+	t.Cleanup(func() { listener.Stop() })
+	return listener, outChan
+}
+
+// addHistoryValues adds the values of history items to values, summing those
+// of the jobs counter
+func addHistoryValues(items []*service.HistoryItem, values map[string]float64) {
+	for _, item := range items {
+		value, _ := strconv.ParseFloat(item.GetValueJson(), 64)
+		if strings.HasSuffix(item.GetKey(), "jobs") {
+			value += values[item.GetKey()]
+		}
+		values[item.GetKey()] = value
+	}
+}
+
+func TestListenerStatsD(t *testing.T) {
+	listener, outChan := startListener(t, &service.Settings{
+		XIngestStatsdAddress:   wrapperspb.String("127.0.0.1:0"),
+		XIngestIntervalSeconds: wrapperspb.Double(0.01),
+	})
+
+	conn, err := net.Dial("udp", listener.StatsDAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	for _, packet := range []string{"jobs:1|c\nqueue:5|g", "jobs:2|c|@0.5\nqueue:+2|g\nlatency:10|ms"} {
+		_, err = conn.Write([]byte(packet))
+		require.NoError(t, err)
+	}
+
+	// the packets may be read before one row or more
+	values := make(map[string]float64)
+	deadline := time.Now().Add(5 * time.Second)
+	for values["ingest/jobs"] < 5 || values["ingest/latency/count"] == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("missing metrics, got %v", values)
+		}
+		addHistoryValues(listener.HistoryItems(), values)
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Empty(t, outChan)
+	assert.Equal(t, map[string]float64{
+		"ingest/jobs":          5,
+		"ingest/queue":         7,
+		"ingest/latency/mean":  10,
+		"ingest/latency/min":   10,
+		"ingest/latency/max":   10,
+		"ingest/latency/count": 1,
+	}, values)
+}
+
+func TestListenerHistoryRow(t *testing.T) {
+	listener, outChan := startListener(t, &service.Settings{
+		XIngestHttpAddress:     wrapperspb.String("127.0.0.1:0"),
+		XIngestIntervalSeconds: wrapperspb.Double(0.01),
+	})
+	url := "http://" + listener.HTTPAddr().String() + "/metrics"
+	post := func(body string) {
+		resp, err := http.Post(url, "text/plain", strings.NewReader(body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	// two intervals pass within the step of the row
+	post("jobs:1|c\nlatency:10|ms\n")
+	time.Sleep(50 * time.Millisecond)
+	post("jobs:2|c\nlatency:30|ms\n")
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Empty(t, outChan)
+	assert.Equal(t, []*service.HistoryItem{
+		{Key: "ingest/jobs", ValueJson: "3"},
+		{Key: "ingest/latency/count", ValueJson: "2"},
+		{Key: "ingest/latency/max", ValueJson: "30"},
+		{Key: "ingest/latency/mean", ValueJson: "20"},
+		{Key: "ingest/latency/min", ValueJson: "10"},
+	}, listener.HistoryItems())
+	assert.Nil(t, listener.HistoryItems())
+
+	// the metrics of the last row are returned when stopping
+	post("jobs:4|c\n")
+	records := listener.Stop()
+	require.Len(t, records, 1)
+	history := records[0].GetRequest().GetPartialHistory()
+	assert.Equal(t, &service.HistoryAction{Flush: false}, history.GetAction())
+	assert.Equal(t, []*service.HistoryItem{{Key: "ingest/jobs", ValueJson: "4"}}, history.GetItem())
+}
+
+func TestListenerHTTP(t *testing.T) {
+	listener, outChan := startListener(t, &service.Settings{
+		XIngestHttpAddress: wrapperspb.String("127.0.0.1:0"),
+		XIngestPrefix:      wrapperspb.String("sidecar."),
+		XIngestTarget:      wrapperspb.String("system"),
+	})
+	url := "http://" + listener.HTTPAddr().String() + "/metrics"
+	assert.Nil(t, listener.HistoryItems())
+
+	resp, err := http.Post(url, "text/plain", strings.NewReader("jobs:3|c\n"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Post(url, "application/json",
+		strings.NewReader(`[{"name": "queue", "type": "gauge", "value": 4}, {"name": "jobs", "type": "counter", "value": 1}]`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// invalid requests are rejected whole
+	resp, err = http.Post(url, "application/json",
+		strings.NewReader(`[{"name": "queue", "type": "gauge", "value": 100}, {"name": "x", "type": "set", "value": 1}]`))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(url)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	records := listener.Stop()
+	assert.Empty(t, outChan)
+	require.Len(t, records, 1)
+	stats := records[0].GetStats()
+	require.NotNil(t, stats)
+	assert.Equal(t, service.StatsRecord_SYSTEM, stats.GetStatsType())
+	assert.Equal(t, []*service.StatsItem{
+		{Key: "sidecar.jobs", ValueJson: "4"},
+		{Key: "sidecar.queue", ValueJson: "4"},
+	}, stats.GetItem())
+}
+
+func TestListenerHTTPNotLoopback(t *testing.T) {
+	listener := ingest.NewListener(&service.Settings{
+		XIngestHttpAddress: wrapperspb.String("0.0.0.0:0"),
+	}, observability.NewNoOpLogger(), make(chan *service.Record))
+
+	assert.ErrorContains(t, listener.Start(), `http address "0.0.0.0:0" is not a loopback address`)
+}
+
+func TestNewListenerDisabled(t *testing.T) {
+	listener := ingest.NewListener(&service.Settings{}, observability.NewNoOpLogger(), nil)
+
+	assert.Nil(t, listener)
+	assert.NoError(t, listener.Start())
+	assert.Nil(t, listener.Stop())
+}
@@ -0,0 +1,213 @@
+// Package ingest receives metrics from the other processes of a job, such
+// as sidecars and shell scripts, and adds them to the run.
+package ingest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Type is the type of a metric, which decides how its values are aggregated.
+type Type string
+
+const (
+	// Gauge is a value at a time; the last value of an interval is kept
+	Gauge Type = "gauge"
+	// Counter is a count; the values of an interval are summed
+	Counter Type = "counter"
+	// Timer is a duration; the mean, min, max and count of the values of
+	// an interval are kept
+	Timer Type = "timer"
+)
+
+// Metric is a value of a metric.
+type Metric struct {
+	Name  string  `json:"name"`
+	Type  Type    `json:"type"`
+	Value float64 `json:"value"`
+	// Delta is whether the value of a gauge is added to its last value
+	Delta bool `json:"delta,omitempty"`
+}
+
+func (m *Metric) validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("ingest: metric has no name")
+	}
+	if strings.HasPrefix(m.Name, "_") {
+		return fmt.Errorf("ingest: metric %q: names starting with _ are reserved", m.Name)
+	}
+	switch m.Type {
+	case Gauge, Counter, Timer:
+	default:
+		return fmt.Errorf("ingest: metric %q: unknown type %q", m.Name, m.Type)
+	}
+	if math.IsNaN(m.Value) || math.IsInf(m.Value, 0) {
+		return fmt.Errorf("ingest: metric %q: value is not finite", m.Name)
+	}
+	return nil
+}
+
+// ParseStatsD parses the StatsD lines of a packet, of the form
+// "name:value|type" with an optional "|@rate" sample rate and "|#tags"
+// tags, which are ignored. The types are g (with a signed value for a
+// change of the gauge), c, ms and h, which is a timer. The lines that cannot
+// be parsed are returned as errors, along with the metrics of the others.
+func ParseStatsD(packet string) ([]Metric, []error) {
+	var metrics []Metric
+	var errs []error
+	for _, line := range strings.Split(packet, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		metric, err := parseStatsDLine(line)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, errs
+}
+
+func parseStatsDLine(line string) (Metric, error) {
+	name, rest, ok := strings.Cut(line, ":")
+	if !ok {
+		return Metric{}, fmt.Errorf("ingest: %q: missing value", line)
+	}
+	fields := strings.Split(rest, "|")
+	if len(fields) < 2 {
+		return Metric{}, fmt.Errorf("ingest: %q: missing type", line)
+	}
+	metric := Metric{Name: name}
+	switch fields[1] {
+	case "g":
+		metric.Type = Gauge
+		metric.Delta = strings.HasPrefix(fields[0], "+") || strings.HasPrefix(fields[0], "-")
+	case "c":
+		metric.Type = Counter
+	case "ms", "h":
+		metric.Type = Timer
+	default:
+		return Metric{}, fmt.Errorf("ingest: %q: unsupported type %q", line, fields[1])
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return Metric{}, fmt.Errorf("ingest: %q: invalid value", line)
+	}
+	metric.Value = value
+
+	for _, field := range fields[2:] {
+		rate, ok := strings.CutPrefix(field, "@")
+		if !ok || metric.Type != Counter {
+			continue
+		}
+		// a sampled counter stands for the values that were not sent
+		sampleRate, err := strconv.ParseFloat(rate, 64)
+		if err != nil || sampleRate <= 0 || sampleRate > 1 {
+			return Metric{}, fmt.Errorf("ingest: %q: invalid sample rate", line)
+		}
+		metric.Value /= sampleRate
+	}
+
+	if err := metric.validate(); err != nil {
+		return Metric{}, err
+	}
+	return metric, nil
+}
+
+// timerValues are the values of a timer in an interval.
+type timerValues struct {
+	sum, min, max float64
+	count         int
+}
+
+// Aggregator aggregates the values of metrics over an interval.
+type Aggregator struct {
+	mu       sync.Mutex
+	gauges   map[string]float64
+	counters map[string]float64
+	timers   map[string]*timerValues
+
+	// updated are the gauges updated in the interval; the last values of
+	// the gauges are kept across intervals for their changes
+	updated map[string]bool
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		gauges:   make(map[string]float64),
+		counters: make(map[string]float64),
+		timers:   make(map[string]*timerValues),
+		updated:  make(map[string]bool),
+	}
+}
+
+// Add adds a value of a metric to the interval.
+func (a *Aggregator) Add(metric Metric) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch metric.Type {
+	case Gauge:
+		if metric.Delta {
+			a.gauges[metric.Name] += metric.Value
+		} else {
+			a.gauges[metric.Name] = metric.Value
+		}
+		a.updated[metric.Name] = true
+	case Counter:
+		a.counters[metric.Name] += metric.Value
+	case Timer:
+		values, ok := a.timers[metric.Name]
+		if !ok {
+			values = &timerValues{min: metric.Value, max: metric.Value}
+			a.timers[metric.Name] = values
+		}
+		values.sum += metric.Value
+		values.min = min(values.min, metric.Value)
+		values.max = max(values.max, metric.Value)
+		values.count++
+	}
+}
+
+// Flush returns the aggregates of the metrics updated in the interval, by
+// key, and starts a new interval. A gauge or a counter has one key, its
+// name; a timer has one for each of its aggregates, as "name/mean".
+func (a *Aggregator) Flush() map[string]float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	aggregates := make(map[string]float64)
+	for name := range a.updated {
+		aggregates[name] = a.gauges[name]
+	}
+	for name, value := range a.counters {
+		aggregates[name] = value
+	}
+	for name, values := range a.timers {
+		aggregates[name+"/mean"] = values.sum / float64(values.count)
+		aggregates[name+"/min"] = values.min
+		aggregates[name+"/max"] = values.max
+		aggregates[name+"/count"] = float64(values.count)
+	}
+
+	a.updated = make(map[string]bool)
+	a.counters = make(map[string]float64)
+	a.timers = make(map[string]*timerValues)
+	return aggregates
+}
+
+// sortedKeys returns the keys of the aggregates in order
+func sortedKeys(aggregates map[string]float64) []string {
+	keys := make([]string, 0, len(aggregates))
+	for key := range aggregates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
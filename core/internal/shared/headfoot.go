@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/wandb/wandb/core/internal/clioutput"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
@@ -13,26 +14,32 @@ func PrintHeadFoot(run *service.RunRecord, settings *service.Settings, footer bo
 	if run == nil {
 		return
 	}
-	colorReset := "\033[0m"
-	colorBrightBlue := "\033[1;34m"
-	colorBrightMagenta := "\033[1;35m"
-	colorBlue := "\033[34m"
-	colorYellow := "\033[33m"
 
 	appURL := strings.Replace(settings.GetBaseUrl().GetValue(), "//api.", "//", 1)
-	url := fmt.Sprintf("%v/%v/%v/runs/%v", appURL, run.Entity, run.Project, run.RunId)
-	fmt.Printf("%vwandb%v: 🚀 View run %v%v%v at: %v%v%v\n", colorBrightBlue, colorReset, colorYellow, run.DisplayName, colorReset, colorBlue, url, colorReset)
-	if footer {
-		currentDir, err := os.Getwd()
-		if err != nil {
-			return
-		}
-		logDir := settings.GetLogDir().GetValue()
-		relLogDir, err := filepath.Rel(currentDir, logDir)
-		if err != nil {
-			return
-		}
-		fmt.Printf("%vwandb%v: Find logs at: %v%v%v\n",
-			colorBrightBlue, colorReset, colorBrightMagenta, relLogDir, colorReset)
+	info := &clioutput.Run{
+		ID:      run.RunId,
+		Name:    run.GetDisplayName(),
+		Entity:  run.Entity,
+		Project: run.Project,
+		URL:     fmt.Sprintf("%v/%v/%v/runs/%v", appURL, run.Entity, run.Project, run.RunId),
 	}
+	if !footer {
+		clioutput.Default().RunHeader(info)
+		return
+	}
+	clioutput.Default().RunFooter(info, relativeLogDir(settings))
+}
+
+// relativeLogDir returns the log directory of the run relative to the
+// working directory, or "" if that fails
+func relativeLogDir(settings *service.Settings) string {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	relLogDir, err := filepath.Rel(currentDir, settings.GetLogDir().GetValue())
+	if err != nil {
+		return ""
+	}
+	return relLogDir
 }
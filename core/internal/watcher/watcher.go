@@ -1,6 +1,7 @@
 package watcher
 
 import (
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -15,12 +16,22 @@ import (
 
 const pollingInterval = time.Millisecond * 100
 
+// ErrClosed is returned when a path is added to a closed watcher
+var ErrClosed = errors.New("watcher: closed")
+
 type Watcher struct {
 	watcher *fw.Watcher
 	pathMap map[string]string
 	outChan chan *service.Record
 	wg      *sync.WaitGroup
 	logger  *observability.CoreLogger
+
+	// mu guards started and closed, Add and Close may be called from
+	// different goroutines
+	mu      sync.Mutex
+	started bool
+	// closed is set by Close; a closed watcher cannot be started again
+	closed bool
 }
 
 func NewWatcher(logger *observability.CoreLogger, outChan chan *service.Record) *Watcher {
@@ -35,7 +46,20 @@ func NewWatcher(logger *observability.CoreLogger, outChan chan *service.Record)
 
 // Start starts the watcher and forwards upload requests
 // when watched files are created or written to.
+//
+// Add starts the watcher if needed, so that runs without watched files do not
+// pay for the polling goroutines. A closed watcher is not started.
 func (w *Watcher) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.start()
+}
+
+func (w *Watcher) start() {
+	if w.started || w.closed {
+		return
+	}
+	w.started = true
 	w.wg.Add(1)
 	go func() {
 		w.logger.Debug("starting watcher")
@@ -64,8 +88,9 @@ func (w *Watcher) Start() {
 						}
 						path = absolutePath
 					}
-					// skip directories and files that don't exist
-					if fileInfo, err := os.Stat(path); err != nil || fileInfo.IsDir() {
+					// skip files that don't exist, directories and special files,
+					// such as FIFOs and devices, which are not uploaded
+					if fileInfo, err := os.Stat(path); err != nil || !fileInfo.Mode().IsRegular() {
 						continue
 					}
 
@@ -106,8 +131,17 @@ func (w *Watcher) Start() {
 	w.logger.Debug("watcher started")
 }
 
-// Close closes the watcher
+// Close closes the watcher for good, it is safe to call more than once
 func (w *Watcher) Close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	w.closed = true
+	if !w.started {
+		return
+	}
 	w.watcher.Close()
 	w.wg.Wait()
 	w.logger.Debug("watcher closed")
@@ -122,8 +156,15 @@ func (e *EventFileInfo) Name() string {
 	return e.name
 }
 
-// Add adds a path to the watcher's watch list
+// Add adds a path to the watcher's watch list, it fails with ErrClosed once
+// the watcher is closed
 func (w *Watcher) Add(path string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return ErrClosed
+	}
+	w.start()
 	fileInfo, err := os.Stat(path)
 	if err != nil {
 		return err
@@ -16,6 +16,9 @@ int main(int argc, char **argv) {
         printf("log %d\n", i);
         wandb_log_scaler(&run, "key", i);
     }
+    const char *keys[] = {"reason"};
+    const char *values[] = {"done"};
+    wandb_log_event(&run, "stopped", WANDB_CURRENT_STEP, 1, keys, values);
     wandb_finish(&run);
     return 0;
 }
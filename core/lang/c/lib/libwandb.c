@@ -3,6 +3,12 @@
 #include <libwandb.h>
 #include <libwandb_core.h>
 
+struct wandb_callback_state_s {
+    wandb_run *run;
+    wandb_callbacks callbacks;
+    void *user_data;
+};
+
 void wandb_setup() {
     wandbcoreSetup();
     atexit(wandb_teardown);
@@ -12,6 +18,7 @@ int wandb_init(wandb_run *run) {
     wandb_setup();
     int n = wandbcoreInit(0, "", "", "", LIB_C);
     run->num = n;
+    run->callback_state = NULL;
     return 0;
 }
 
@@ -20,9 +27,88 @@ void wandb_log_scaler(wandb_run *run, const char *key, double value) {
     wandbcoreLogData(run->num, data);
 }
 
+void wandb_log_event(wandb_run *run, const char *name, long long step,
+                     int num_attributes, const char **keys, const char **values) {
+    int data = WANDBCORE_DATA_CREATE;
+    if (num_attributes > 0) {
+        data = wandbcoreDataAddStrings(WANDBCORE_DATA_CREATE, num_attributes, keys, values);
+    }
+    wandbcoreLogEvent(run->num, name, step, data);
+}
+
+void wandb_set_checkpoint_policy(wandb_run *run, int keep_last, int keep_best,
+                                 const char *metric, int minimize) {
+    wandbcoreSetCheckpointPolicy(run->num, keep_last, keep_best, metric, minimize);
+}
+
+int wandb_log_checkpoint(wandb_run *run, const char *path, long long step,
+                         int num_metrics, const char **keys, const double *values) {
+    int data = WANDBCORE_DATA_CREATE;
+    if (num_metrics > 0) {
+        data = wandbcoreDataAddDoubles(WANDBCORE_DATA_CREATE, num_metrics, keys, (double *)values);
+    }
+    return wandbcoreLogCheckpoint(run->num, path, step, data);
+}
+
+static void wandb_dispatch_event(int num, const wandbcore_event_t *event, void *data) {
+    struct wandb_callback_state_s *state = data;
+    wandb_callbacks *callbacks = &state->callbacks;
+    switch (event->type) {
+    case WANDBCORE_EVENT_STOP_REQUESTED:
+        if (callbacks->on_stop_requested) {
+            callbacks->on_stop_requested(state->run, state->user_data);
+        }
+        break;
+    case WANDBCORE_EVENT_UPLOAD_PROGRESS:
+        if (callbacks->on_upload_progress) {
+            callbacks->on_upload_progress(
+                state->run, event->uploaded_bytes, event->total_bytes, state->user_data);
+        }
+        break;
+    case WANDBCORE_EVENT_NETWORK_ERROR:
+        if (callbacks->on_network_error) {
+            callbacks->on_network_error(
+                state->run, event->http_status_code, event->message, state->user_data);
+        }
+        break;
+    case WANDBCORE_EVENT_FINISHED:
+        if (callbacks->on_finish) {
+            callbacks->on_finish(state->run, event->exit_code, event->message, state->user_data);
+        }
+        break;
+    }
+}
+
+int wandb_set_callbacks(wandb_run *run, const wandb_callbacks *callbacks, void *user_data) {
+    struct wandb_callback_state_s *previous = run->callback_state;
+    struct wandb_callback_state_s *state = NULL;
+    if (callbacks != NULL) {
+        state = malloc(sizeof(*state));
+        if (state == NULL) {
+            return -1;
+        }
+        state->run = run;
+        state->callbacks = *callbacks;
+        state->user_data = user_data;
+        wandbcoreSetEventCallback(run->num, wandb_dispatch_event, state);
+    } else {
+        wandbcoreSetEventCallback(run->num, NULL, NULL);
+    }
+    run->callback_state = state;
+    // the core has stopped calling the previous callbacks at this point
+    free(previous);
+    return 0;
+}
+
 void wandb_finish(wandb_run *run) {
+    wandb_finish_with_exit_code(run, 0);
+}
+
+void wandb_finish_with_exit_code(wandb_run *run, int exit_code) {
     int num = run->num;
-    wandbcoreFinish(num);
+    wandbcoreFinish(num, exit_code);
+    free(run->callback_state);
+    run->callback_state = NULL;
 }
 
 void wandb_teardown() {
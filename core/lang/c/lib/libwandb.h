@@ -1,11 +1,98 @@
+struct wandb_callback_state_s;
+
 struct wandb_run_s {
     int num;
+    /* private, managed by wandb_set_callbacks() */
+    struct wandb_callback_state_s *callback_state;
 };
 
 typedef struct wandb_run_s wandb_run;
 
+/*
+ * Run event callbacks
+ *
+ * Threading: all callbacks of a run are called from a single dedicated
+ * thread owned by the library, never from the thread that called into the
+ * library, and never concurrently with each other. Callbacks must be quick
+ * and must synchronize any state they share with the rest of the program.
+ *
+ * Lifetime: the callbacks are copied by wandb_set_callbacks(), but the run
+ * and user_data must stay valid until wandb_finish() returns. on_finish is
+ * the last callback of a run and is called before wandb_finish() returns.
+ * Strings passed to callbacks are only valid during the call.
+ *
+ * Callbacks must not call wandb_finish() or wandb_set_callbacks(); doing so
+ * deadlocks. Any callback may be NULL.
+ */
+
+/* The run was stopped from the UI; called at most once per run. */
+typedef void (*wandb_stop_requested_callback)(wandb_run *run, void *user_data);
+
+/* The number of uploaded bytes changed. */
+typedef void (*wandb_upload_progress_callback)(
+    wandb_run *run, long long uploaded_bytes, long long total_bytes, void *user_data);
+
+/* A request to the server failed. */
+typedef void (*wandb_network_error_callback)(
+    wandb_run *run, int http_status_code, const char *message, void *user_data);
+
+/* The run finished; error is NULL unless errors happened while finishing. */
+typedef void (*wandb_finish_callback)(
+    wandb_run *run, int exit_code, const char *error, void *user_data);
+
+struct wandb_callbacks_s {
+    wandb_stop_requested_callback on_stop_requested;
+    wandb_upload_progress_callback on_upload_progress;
+    wandb_network_error_callback on_network_error;
+    wandb_finish_callback on_finish;
+};
+
+typedef struct wandb_callbacks_s wandb_callbacks;
+
 int wandb_init(wandb_run *run);
 void wandb_log_scaler(wandb_run *run, const char *key, double value);
+
+/*
+ * Pass as the step of wandb_log_event() or wandb_log_checkpoint() to use the
+ * current step of the run.
+ */
+#define WANDB_CURRENT_STEP (-1LL)
+
+/*
+ * Record a discrete event, such as "checkpoint_saved", on the timeline of a
+ * run, with num_attributes string attributes. keys and values may be NULL if
+ * there are none.
+ */
+void wandb_log_event(wandb_run *run, const char *name, long long step,
+                     int num_attributes, const char **keys, const char **values);
+
+/*
+ * Set the policy of the checkpoints of a run logged after it: those among the
+ * last keep_last and those among the best keep_best by metric are uploaded
+ * when the run finishes. Lower values of metric are better if minimize is
+ * nonzero. All checkpoints are uploaded if both are 0, or if no policy is set.
+ */
+void wandb_set_checkpoint_policy(wandb_run *run, int keep_last, int keep_best,
+                                 const char *metric, int minimize);
+
+/*
+ * Record the checkpoint file or directory at path, saved at step with
+ * num_metrics metrics. The checkpoint is copied, so path may be overwritten
+ * once this returns. Returns 1 if the policy retains the checkpoint, 0 if it
+ * does not, and -1 on error.
+ */
+int wandb_log_checkpoint(wandb_run *run, const char *path, long long step,
+                         int num_metrics, const char **keys, const double *values);
+/*
+ * Register the event callbacks of a run, replacing any previous ones.
+ * Passing NULL callbacks unregisters them. When this returns 0, the previous
+ * callbacks are no longer running and will not be called again. Returns -1
+ * if the callbacks could not be allocated, in which case the previous ones
+ * stay registered.
+ */
+int wandb_set_callbacks(wandb_run *run, const wandb_callbacks *callbacks, void *user_data);
 void wandb_finish(wandb_run *run);
+/* Finish a run with the exit code of the program, passed to on_finish. */
+void wandb_finish_with_exit_code(wandb_run *run, int exit_code);
 void wandb_setup();
 void wandb_teardown();
@@ -0,0 +1,168 @@
+/*
+ * Tests for the run event callbacks of libwandb.
+ *
+ * Meant to run offline (WANDB_MODE=offline), so that no stop request is
+ * expected. The mode has to be set in the environment of the process, as the
+ * library reads it when it is loaded.
+ */
+#include <assert.h>
+#include <pthread.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <unistd.h>
+
+#include <libwandb.h>
+
+struct counters {
+    pthread_mutex_t mutex;
+    pthread_t main_thread;
+    pthread_t callback_thread;
+    int callback_threads;
+    int stop_requested;
+    int finished;
+    int exit_code;
+    wandb_run *run;
+};
+
+static void counters_init(struct counters *c) {
+    memset(c, 0, sizeof(*c));
+    pthread_mutex_init(&c->mutex, NULL);
+    c->main_thread = pthread_self();
+}
+
+static void seen_thread(struct counters *c) {
+    pthread_t self = pthread_self();
+    assert(!pthread_equal(self, c->main_thread));
+    if (c->callback_threads == 0) {
+        c->callback_thread = self;
+        c->callback_threads = 1;
+    }
+    assert(pthread_equal(self, c->callback_thread));
+}
+
+static void on_stop_requested(wandb_run *run, void *user_data) {
+    struct counters *c = user_data;
+    pthread_mutex_lock(&c->mutex);
+    seen_thread(c);
+    c->stop_requested++;
+    pthread_mutex_unlock(&c->mutex);
+}
+
+static void on_upload_progress(wandb_run *run, long long uploaded, long long total, void *user_data) {
+    struct counters *c = user_data;
+    pthread_mutex_lock(&c->mutex);
+    seen_thread(c);
+    assert(uploaded <= total);
+    pthread_mutex_unlock(&c->mutex);
+}
+
+static void on_network_error(wandb_run *run, int code, const char *message, void *user_data) {
+    struct counters *c = user_data;
+    pthread_mutex_lock(&c->mutex);
+    seen_thread(c);
+    assert(message != NULL);
+    pthread_mutex_unlock(&c->mutex);
+}
+
+static void on_finish(wandb_run *run, int exit_code, const char *error, void *user_data) {
+    struct counters *c = user_data;
+    pthread_mutex_lock(&c->mutex);
+    seen_thread(c);
+    c->finished++;
+    c->exit_code = exit_code;
+    c->run = run;
+    pthread_mutex_unlock(&c->mutex);
+}
+
+static const wandb_callbacks all_callbacks = {
+    on_stop_requested,
+    on_upload_progress,
+    on_network_error,
+    on_finish,
+};
+
+static void log_some(wandb_run *run) {
+    int i;
+    for (i = 0; i < 10; i++) {
+        wandb_log_scaler(run, "key", i);
+    }
+}
+
+/* on_finish is called once, off the main thread, before wandb_finish returns */
+static void test_finish_callback() {
+    struct counters c;
+    wandb_run run;
+    counters_init(&c);
+
+    assert(wandb_init(&run) == 0);
+    assert(wandb_set_callbacks(&run, &all_callbacks, &c) == 0);
+    log_some(&run);
+    /* give the run time to poll for events at least once */
+    sleep(3);
+    wandb_finish(&run);
+
+    assert(c.finished == 1);
+    assert(c.exit_code == 0);
+    assert(c.run == &run);
+    assert(c.stop_requested == 0);
+}
+
+/* on_finish gets the exit code the run finished with */
+static void test_finish_exit_code() {
+    struct counters c;
+    wandb_run run;
+    counters_init(&c);
+
+    assert(wandb_init(&run) == 0);
+    assert(wandb_set_callbacks(&run, &all_callbacks, &c) == 0);
+    log_some(&run);
+    wandb_finish_with_exit_code(&run, 3);
+
+    assert(c.finished == 1);
+    assert(c.exit_code == 3);
+}
+
+/* callbacks can be registered without any function set */
+static void test_empty_callbacks() {
+    wandb_callbacks none;
+    wandb_run run;
+    memset(&none, 0, sizeof(none));
+
+    assert(wandb_init(&run) == 0);
+    assert(wandb_set_callbacks(&run, &none, NULL) == 0);
+    log_some(&run);
+    wandb_finish(&run);
+}
+
+/* replaced and unregistered callbacks are not called anymore */
+static void test_replace_callbacks() {
+    struct counters first, second;
+    wandb_run run;
+    counters_init(&first);
+    counters_init(&second);
+
+    assert(wandb_init(&run) == 0);
+    assert(wandb_set_callbacks(&run, &all_callbacks, &first) == 0);
+    assert(wandb_set_callbacks(&run, &all_callbacks, &second) == 0);
+    log_some(&run);
+    wandb_finish(&run);
+    assert(first.finished == 0);
+    assert(second.finished == 1);
+
+    counters_init(&first);
+    assert(wandb_init(&run) == 0);
+    assert(wandb_set_callbacks(&run, &all_callbacks, &first) == 0);
+    assert(wandb_set_callbacks(&run, NULL, NULL) == 0);
+    wandb_finish(&run);
+    assert(first.finished == 0);
+}
+
+int main(int argc, char **argv) {
+    test_finish_callback();
+    test_finish_exit_code();
+    test_empty_callbacks();
+    test_replace_callbacks();
+    printf("test_callbacks: ok\n");
+    return 0;
+}
@@ -0,0 +1,114 @@
+package main
+
+/*
+#include <stdlib.h>
+#define WANDBCORE_EVENT_STOP_REQUESTED 1
+#define WANDBCORE_EVENT_UPLOAD_PROGRESS 2
+#define WANDBCORE_EVENT_NETWORK_ERROR 3
+#define WANDBCORE_EVENT_FINISHED 4
+typedef struct {
+	int type;
+	long long uploaded_bytes;
+	long long total_bytes;
+	int http_status_code;
+	const char *message;
+	int exit_code;
+} wandbcore_event_t;
+typedef void (*wandbcore_event_callback_t)(int run, const wandbcore_event_t *event, void *user_data);
+static inline void wandbcore_call_event_callback(
+	wandbcore_event_callback_t callback, int run, const wandbcore_event_t *event, void *user_data
+) {
+	callback(run, event, user_data);
+}
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/wandb/wandb/core/pkg/gowandb"
+)
+
+// eventBufferSize is the number of events that can be queued before the run
+// waits for the C callback to catch up
+const eventBufferSize = 64
+
+// EventDispatcher calls the C event callback of a run.
+//
+// All calls are made from a single goroutine locked to its OS thread, so C code
+// sees every callback of a run on the same dedicated thread, one at a time.
+type EventDispatcher struct {
+	runNum   int
+	callback C.wandbcore_event_callback_t
+	userData unsafe.Pointer
+	events   chan *gowandb.Event
+	done     chan struct{}
+}
+
+func NewEventDispatcher(runNum int, callback C.wandbcore_event_callback_t, userData unsafe.Pointer) *EventDispatcher {
+	return &EventDispatcher{
+		runNum:   runNum,
+		callback: callback,
+		userData: userData,
+		events:   make(chan *gowandb.Event, eventBufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+func (d *EventDispatcher) Start() {
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		for event := range d.events {
+			d.call(event)
+		}
+		close(d.done)
+	}()
+}
+
+// Send queues an event for the callback; it is a gowandb.EventCallback
+func (d *EventDispatcher) Send(event *gowandb.Event) {
+	d.events <- event
+}
+
+// Close waits until all the queued events were passed to the callback.
+func (d *EventDispatcher) Close() {
+	close(d.events)
+	<-d.done
+}
+
+func (d *EventDispatcher) call(event *gowandb.Event) {
+	cEvent := C.wandbcore_event_t{
+		_type:            C.int(event.Type),
+		uploaded_bytes:   C.longlong(event.UploadedBytes),
+		total_bytes:      C.longlong(event.TotalBytes),
+		http_status_code: C.int(event.HttpStatusCode),
+		exit_code:        C.int(event.ExitCode),
+	}
+	if event.Message != "" {
+		cEvent.message = C.CString(event.Message)
+		defer C.free(unsafe.Pointer(cEvent.message))
+	}
+	C.wandbcore_call_event_callback(d.callback, C.int(d.runNum), &cEvent, d.userData)
+}
+
+//export wandbcoreSetEventCallback
+func wandbcoreSetEventCallback(num int, callback C.wandbcore_event_callback_t, userData unsafe.Pointer) {
+	run := wandbRuns.Get(num)
+	if run == nil {
+		return
+	}
+	// stop the previous dispatcher before its user data can be released
+	run.SetEventCallback(nil)
+	if previous := wandbRuns.SetDispatcher(num, nil); previous != nil {
+		previous.Close()
+	}
+	if callback == nil {
+		return
+	}
+	dispatcher := NewEventDispatcher(num, callback, userData)
+	dispatcher.Start()
+	wandbRuns.SetDispatcher(num, dispatcher)
+	run.SetEventCallback(dispatcher.Send)
+}
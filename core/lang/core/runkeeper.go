@@ -7,16 +7,18 @@ import (
 )
 
 type RunKeeper struct {
-	index int
-	runs  map[int]*gowandb.Run
-	mutex sync.Mutex
+	index       int
+	runs        map[int]*gowandb.Run
+	dispatchers map[int]*EventDispatcher
+	mutex       sync.Mutex
 }
 
 func NewRunKeeper() *RunKeeper {
 	return &RunKeeper{
 		// arbitrary number to start counting from
-		index: 42,
-		runs:  make(map[int]*gowandb.Run),
+		index:       42,
+		runs:        make(map[int]*gowandb.Run),
+		dispatchers: make(map[int]*EventDispatcher),
 	}
 }
 
@@ -28,6 +30,20 @@ func (k *RunKeeper) Remove(num int) {
 	k.mutex.Lock()
 	defer k.mutex.Unlock()
 	delete(k.runs, num)
+	delete(k.dispatchers, num)
+}
+
+// SetDispatcher sets the event dispatcher of a run, and returns the previous one.
+func (k *RunKeeper) SetDispatcher(num int, dispatcher *EventDispatcher) *EventDispatcher {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	previous := k.dispatchers[num]
+	if dispatcher == nil {
+		delete(k.dispatchers, num)
+	} else {
+		k.dispatchers[num] = dispatcher
+	}
+	return previous
 }
 
 func (k *RunKeeper) Add(run *gowandb.Run) int {
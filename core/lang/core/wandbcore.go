@@ -143,15 +143,87 @@ func wandbcoreLogData(runNum int, dataNum int) {
 	wandbData.Remove(dataNum)
 }
 
+// wandbcoreLogEvent records an event at the given step of the run, or at its
+// current step if step is negative
+//
+//export wandbcoreLogEvent
+func wandbcoreLogEvent(runNum int, name *C.cchar_t, step int64, dataNum int) {
+	run := wandbRuns.Get(runNum)
+	data := wandbData.Get(dataNum)
+	if step < 0 {
+		run.LogEvent(C.GoString(name), data)
+	} else {
+		run.LogEventAtStep(C.GoString(name), step, data)
+	}
+	wandbData.Remove(dataNum)
+}
+
+// wandbcoreSetCheckpointPolicy sets the policy of the checkpoints of the run
+// logged after it
+//
+//export wandbcoreSetCheckpointPolicy
+func wandbcoreSetCheckpointPolicy(runNum int, keepLast int, keepBest int, metric *C.cchar_t, minimize int) {
+	run := wandbRuns.Get(runNum)
+	policy := gowandb.CheckpointPolicy{
+		KeepLast: keepLast,
+		KeepBest: keepBest,
+		Minimize: minimize != 0,
+	}
+	if metric != nil {
+		policy.Metric = C.GoString(metric)
+	}
+	run.SetCheckpointPolicy(policy)
+}
+
+// wandbcoreLogCheckpoint records a checkpoint at the given step of the run,
+// or at its current step if step is negative, with the doubles of dataNum as
+// its metrics. It returns 1 if the checkpoint is retained, 0 if it is not and
+// -1 on error.
+//
+//export wandbcoreLogCheckpoint
+func wandbcoreLogCheckpoint(runNum int, path *C.cchar_t, step int64, dataNum int) int {
+	run := wandbRuns.Get(runNum)
+	metrics := make(map[string]float64)
+	for key, value := range wandbData.Get(dataNum) {
+		if value, ok := value.(float64); ok {
+			metrics[key] = value
+		}
+	}
+	wandbData.Remove(dataNum)
+
+	var result *gowandb.CheckpointResult
+	var err error
+	if step < 0 {
+		result, err = run.LogCheckpoint(C.GoString(path), metrics)
+	} else {
+		result, err = run.LogCheckpointAtStep(C.GoString(path), step, metrics)
+	}
+	switch {
+	case err != nil:
+		return -1
+	case result.Retained:
+		return 1
+	default:
+		return 0
+	}
+}
+
 //export wandbcoreFinish
-func wandbcoreFinish(num int) {
+func wandbcoreFinish(num int, exitCode int) {
 	run := wandbRuns.Get(num)
-	run.Finish()
+	run.FinishWithExitCode(int32(exitCode))
+	// the finished event was queued by Finish, wait for it to be delivered
+	if dispatcher := wandbRuns.SetDispatcher(num, nil); dispatcher != nil {
+		dispatcher.Close()
+	}
 	wandbRuns.Remove(num)
 }
 
 //export wandbcoreTeardown
 func wandbcoreTeardown() {
+	if wandbSession == nil {
+		return
+	}
 	wandbSession.Close()
 	wandbSession = nil
 }
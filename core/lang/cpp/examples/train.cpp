@@ -19,6 +19,7 @@ int main() {
     };
     run.log(history);
   }
+  run.logEvent("stopped", {{"reason", "done"}});
 
   run.finish();
   return 0;
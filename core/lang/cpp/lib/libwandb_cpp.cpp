@@ -80,7 +80,18 @@ void Run::log(const std::unordered_map<std::string, Value> &myMap) {
   wandbcoreLogData(this->_num, data->num);
 }
 
-void Run::finish() { wandbcoreFinish(this->_num); }
+void Run::logEvent(const std::string &name,
+                   const std::unordered_map<std::string, Value> &attributes) {
+  this->logEvent(name, -1, attributes);
+}
+
+void Run::logEvent(const std::string &name, long long step,
+                   const std::unordered_map<std::string, Value> &attributes) {
+  Data data(&attributes);
+  wandbcoreLogEvent(this->_num, name.c_str(), step, data.num);
+}
+
+void Run::finish() { wandbcoreFinish(this->_num, 0); }
 
 void _session_teardown() { wandbcoreTeardown(); }
 
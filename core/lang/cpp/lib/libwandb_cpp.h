@@ -88,6 +88,12 @@ public:
   Run(const Settings &settings);
 
   void log(const std::unordered_map<std::string, Value> &values_map);
+  // logEvent records a discrete event, such as "checkpoint_saved", at the
+  // current step of the run, or at the given step
+  void logEvent(const std::string &name,
+                const std::unordered_map<std::string, Value> &attributes = {});
+  void logEvent(const std::string &name, long long step,
+                const std::unordered_map<std::string, Value> &attributes = {});
   void finish();
 
   friend class Session;
@@ -9,6 +9,7 @@ mkdir export
 mkdir export/lib
 mkdir export/include
 mkdir export/examples
+mkdir export/tests
 
 # build core binary
 rm -rf tmpbuild
@@ -77,6 +78,13 @@ LD_RUN_PATH="$PWD/../../export/lib/" gcc train.c -o ../../export/examples/train
 # gcc train.c -static -o ../export/examples/train-static -I../export/include/ -L../export/lib/ -l:libwandb.a -l:libwandbcore.a
 cd -
 
+# build c test progs, run them with WANDB_MODE=offline
+cd c/tests/
+for test in test_*.c; do
+LD_RUN_PATH="$PWD/../../export/lib/" gcc -Wall -Werror $test -o ../../export/tests/${test%.c} -I../../export/include/ -L../../export/lib/ -lwandb -lwandb_core -lpthread
+done
+cd -
+
 # build client prog (cpp)
 cd cpp/examples/
 LD_RUN_PATH="$PWD/../../export/lib/" g++ -std=c++17 train.cpp -o ../../export/examples/traincpp -I../../export/include/ -L../../export/lib/ -lwandb_cpp -lwandb_core
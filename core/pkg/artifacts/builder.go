@@ -3,6 +3,7 @@ package artifacts
 import (
 	"crypto/md5"
 	"fmt"
+	"os"
 	"sort"
 
 	"github.com/wandb/wandb/core/pkg/service"
@@ -54,10 +55,36 @@ func (b *ArtifactBuilder) AddData(name string, dataMap map[string]interface{}) e
 	return nil
 }
 
+// AddFile adds the file at localPath to the artifact as name. The file is
+// not hashed: the saver computes its digest, and then that of the artifact,
+// with the digest cache.
+func (b *ArtifactBuilder) AddFile(name string, localPath string) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	b.artifactRecord.Manifest.Contents = append(b.artifactRecord.Manifest.Contents,
+		&service.ArtifactManifestEntry{
+			Path:      name,
+			Size:      info.Size(),
+			LocalPath: localPath,
+		})
+	b.isDigestUpToDate = false
+	return nil
+}
+
 func (b *ArtifactBuilder) updateManifestDigest() {
 	if b.isDigestUpToDate {
 		return
 	}
+	// without the digests of all its files, the saver computes it
+	for _, entry := range b.artifactRecord.Manifest.Contents {
+		if entry.Digest == "" {
+			b.artifactRecord.Digest = ""
+			b.isDigestUpToDate = true
+			return
+		}
+	}
 	manifest, err := NewManifestFromProto(b.artifactRecord.Manifest)
 	if err != nil {
 		panic("unable to create manifest (unexpected)")
@@ -3,6 +3,8 @@ package artifacts
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,3 +64,22 @@ func TestArtifactBuilder(t *testing.T) {
 	assert.Equal(t, art.Digest, "62103abbae3f3d159ba71d1ffe37f2b1")
 	fmt.Printf("ART %+v\n", art)
 }
+
+func TestArtifactBuilderAddFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, []byte("weights"), 0644))
+
+	builder := NewArtifactBuilder(&service.ArtifactRecord{Name: "model", Type: "model"})
+	assert.Nil(t, builder.AddFile("model.pt", path))
+	assert.NotNil(t, builder.AddFile("missing.pt", filepath.Join(t.TempDir(), "missing.pt")))
+
+	art := builder.GetArtifact()
+	assert.Len(t, art.Manifest.Contents, 1)
+	entry := art.Manifest.Contents[0]
+	assert.Equal(t, "model.pt", entry.Path)
+	assert.Equal(t, path, entry.LocalPath)
+	assert.Equal(t, int64(7), entry.Size)
+	// the saver computes the digests
+	assert.Empty(t, entry.Digest)
+	assert.Empty(t, art.Digest)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/wandb/wandb/core/internal/digestcache"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/pkg/utils"
@@ -20,6 +21,7 @@ type ArtifactDownloader struct {
 	Ctx             context.Context
 	GraphqlClient   graphql.Client
 	DownloadManager *filetransfer.FileTransferManager
+	DigestCache     *digestcache.Cache
 	// Input
 	ArtifactID             string
 	DownloadRoot           string
@@ -30,6 +32,7 @@ func NewArtifactDownloader(
 	ctx context.Context,
 	graphQLClient graphql.Client,
 	downloadManager *filetransfer.FileTransferManager,
+	digestCache *digestcache.Cache,
 	artifactID string,
 	downloadRoot string,
 	allowMissingReferences *bool,
@@ -38,6 +41,7 @@ func NewArtifactDownloader(
 		Ctx:                    ctx,
 		GraphqlClient:          graphQLClient,
 		DownloadManager:        downloadManager,
+		DigestCache:            digestCache,
 		ArtifactID:             artifactID,
 		DownloadRoot:           downloadRoot,
 		AllowMissingReferences: allowMissingReferences,
@@ -141,7 +145,7 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 						return err
 					}
 					if exists {
-						existingDigest, err := utils.ComputeFileB64MD5(downloadLocalPath)
+						existingDigest, err := ad.DigestCache.B64MD5(downloadLocalPath)
 						if err != nil {
 							return err
 						}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
 
+	"github.com/wandb/wandb/core/internal/digestcache"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -21,16 +23,29 @@ type ArtifactSaver struct {
 	Ctx                 context.Context
 	GraphqlClient       graphql.Client
 	FileTransferManager *filetransfer.FileTransferManager
+	DigestCache         *digestcache.Cache
+	// FilePolicy decides what happens to the symlinks and special files of
+	// the artifact, all symlinks are followed if nil.
+	FilePolicy *filetransfer.FilePolicy
 	// Input.
 	Artifact    *service.ArtifactRecord
 	HistoryStep int64
 	StagingDir  string
+
+	// Skipped are the paths in the artifact of the files the file policy
+	// left out, such as special files and files that vanished. Save sets
+	// them, and saves the artifact without them.
+	Skipped []string
+
+	// cleanups remove the copies made by the file policy
+	cleanups []func()
 }
 
 func NewArtifactSaver(
 	ctx context.Context,
 	graphQLClient graphql.Client,
 	uploadManager *filetransfer.FileTransferManager,
+	digestCache *digestcache.Cache,
 	artifact *service.ArtifactRecord,
 	historyStep int64,
 	stagingDir string,
@@ -39,6 +54,7 @@ func NewArtifactSaver(
 		Ctx:                 ctx,
 		GraphqlClient:       graphQLClient,
 		FileTransferManager: uploadManager,
+		DigestCache:         digestCache,
 		Artifact:            artifact,
 		HistoryStep:         historyStep,
 		StagingDir:          stagingDir,
@@ -216,6 +232,68 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 	return nil
 }
 
+// resolveFiles applies the file policy to the local files: the skipped
+// files are left out of the manifest and listed in Skipped, and the copies
+// of symlinks replace them.
+func (as *ArtifactSaver) resolveFiles(manifest *Manifest) error {
+	for name, entry := range manifest.Contents {
+		if entry.LocalPath == nil {
+			continue
+		}
+		path, cleanup, err := as.FilePolicy.Resolve(*entry.LocalPath)
+		if skipErr := filetransfer.AsSkipError(err); skipErr != nil {
+			as.FileTransferManager.Progress().Skip(skipErr)
+			as.deleteStagingFile(*entry.LocalPath)
+			delete(manifest.Contents, name)
+			as.Skipped = append(as.Skipped, name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if path != *entry.LocalPath {
+			as.cleanups = append(as.cleanups, cleanup)
+			as.deleteStagingFile(*entry.LocalPath)
+			entry.LocalPath = &path
+			manifest.Contents[name] = entry
+		}
+	}
+	sort.Strings(as.Skipped)
+	return nil
+}
+
+// computeDigests fills in the digests of the local files that have none,
+// reusing those of the files that did not change since they were last hashed.
+//
+// The digest the client gave a local file is checked against the cached one,
+// which wins if the file changed since the client hashed it, and is otherwise
+// remembered for the next artifacts that add the same file.
+func (as *ArtifactSaver) computeDigests(manifest *Manifest) error {
+	for name, entry := range manifest.Contents {
+		if entry.LocalPath == nil {
+			continue
+		}
+		if entry.Digest != "" {
+			cached, ok := as.DigestCache.Lookup(*entry.LocalPath)
+			switch {
+			case !ok:
+				as.DigestCache.Remember(*entry.LocalPath, entry.Digest)
+			case cached != entry.Digest:
+				entry.Digest = cached
+				manifest.Contents[name] = entry
+			}
+			continue
+		}
+		digest, err := as.DigestCache.B64MD5(*entry.LocalPath)
+		if err != nil {
+			return err
+		}
+		entry.Digest = digest
+		manifest.Contents[name] = entry
+	}
+	return nil
+}
+
 func (as *ArtifactSaver) resolveClientIDReferences(manifest *Manifest) error {
 	cache := map[string]string{}
 	for name, entry := range manifest.Contents {
@@ -277,12 +355,24 @@ func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 
 func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
 	for _, entry := range manifest.Contents {
-		if entry.LocalPath != nil && strings.HasPrefix(*entry.LocalPath, as.StagingDir) {
-			// We intentionally ignore errors below.
-			_ = os.Chmod(*entry.LocalPath, 0600)
-			_ = os.Remove(*entry.LocalPath)
+		if entry.LocalPath != nil {
+			as.deleteStagingFile(*entry.LocalPath)
 		}
 	}
+	for _, cleanup := range as.cleanups {
+		cleanup()
+	}
+}
+
+// deleteStagingFile deletes a file if it is in the staging dir
+func (as *ArtifactSaver) deleteStagingFile(path string) {
+	// without a staging dir, all the files belong to the user
+	if as.StagingDir == "" || !strings.HasPrefix(path, as.StagingDir) {
+		return
+	}
+	// We intentionally ignore errors below.
+	_ = os.Chmod(path, 0600)
+	_ = os.Remove(path)
 }
 
 func (as *ArtifactSaver) Save() (artifactID string, rerr error) {
@@ -293,6 +383,18 @@ func (as *ArtifactSaver) Save() (artifactID string, rerr error) {
 
 	defer as.deleteStagingFiles(&manifest)
 
+	if err := as.resolveFiles(&manifest); err != nil {
+		return "", fmt.Errorf("ArtifactSaver.resolveFiles: %w", err)
+	}
+	if err := as.computeDigests(&manifest); err != nil {
+		return "", fmt.Errorf("ArtifactSaver.computeDigests: %w", err)
+	}
+	// the digest of the client covers the skipped files, and an artifact
+	// built without the digests of its files has none
+	if as.Artifact.Digest == "" || len(as.Skipped) > 0 {
+		as.Artifact.Digest = computeManifestDigest(&manifest)
+	}
+
 	artifactAttrs, err := as.createArtifact()
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.createArtifact: %w", err)
@@ -0,0 +1,99 @@
+package artifacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/coretest"
+	"github.com/wandb/wandb/core/internal/digestcache"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestArtifactSaverSkippedFiles(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+	dir := t.TempDir()
+	kept := filepath.Join(dir, "kept.txt")
+	require.NoError(t, os.WriteFile(kept, []byte("kept"), 0644))
+
+	artifact := NewArtifactBuilder(&service.ArtifactRecord{Name: "data", Type: "dataset"}).GetArtifact()
+	artifact.Digest = "digest-of-the-client"
+	artifact.Manifest.Contents = []*service.ArtifactManifestEntry{
+		{Path: "kept.txt", Digest: "a2VwdA==", LocalPath: kept},
+		{Path: "vanished.txt", Digest: "Z29uZQ==", LocalPath: filepath.Join(dir, "vanished.txt")},
+	}
+	want, err := NewManifestFromProto(&service.ArtifactManifest{
+		Contents: artifact.Manifest.Contents[:1],
+	})
+	require.NoError(t, err)
+
+	// the artifact is created with the digest of the files that are kept
+	to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Do(coretest.InjectResponse(&graphql.Response{Data: &gql.CreateArtifactResponse{
+		CreateArtifact: &gql.CreateArtifactCreateArtifactCreateArtifactPayload{
+			Artifact: gql.CreateArtifactCreateArtifactCreateArtifactPayloadArtifact{
+				Id:    "artifact-id",
+				State: gql.ArtifactStateCommitted,
+			},
+		},
+	}}, func(vars coretest.RequestVars) {
+		assert.Equal(t, computeManifestDigest(&want), vars["digest"])
+	}))
+
+	saver := NewArtifactSaver(context.Background(), to.MockClient, nil, nil, artifact, 0, "")
+	id, err := saver.Save()
+	require.NoError(t, err)
+	assert.Equal(t, "artifact-id", id)
+	assert.Equal(t, []string{"vanished.txt"}, saver.Skipped)
+}
+
+func TestArtifactSaverComputeDigests(t *testing.T) {
+	dir := t.TempDir()
+	cache := digestcache.New(filepath.Join(dir, "digests.json"))
+	paths := map[string]string{}
+	for _, name := range []string{"new.txt", "hashed.txt", "changed.txt"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0644))
+		mtime := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+		paths[name] = path
+	}
+	digest := func(name string) string {
+		digest, err := utils.ComputeFileB64MD5(paths[name])
+		require.NoError(t, err)
+		return digest
+	}
+	_, err := cache.B64MD5(paths["changed.txt"])
+	require.NoError(t, err)
+
+	manifest, err := NewManifestFromProto(&service.ArtifactManifest{
+		Contents: []*service.ArtifactManifestEntry{
+			{Path: "new.txt", LocalPath: paths["new.txt"]},
+			{Path: "hashed.txt", Digest: digest("hashed.txt"), LocalPath: paths["hashed.txt"]},
+			// the file was changed after the client hashed it
+			{Path: "changed.txt", Digest: "c3RhbGU=", LocalPath: paths["changed.txt"]},
+		},
+	})
+	require.NoError(t, err)
+
+	saver := &ArtifactSaver{DigestCache: cache}
+	require.NoError(t, saver.computeDigests(&manifest))
+	for name := range paths {
+		assert.Equal(t, digest(name), manifest.Contents[name].Digest, name)
+	}
+
+	// the digest of the client is remembered
+	cached, ok := cache.Lookup(paths["hashed.txt"])
+	assert.True(t, ok)
+	assert.Equal(t, digest("hashed.txt"), cached)
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCredentialHelperTimeout is how long a credential helper may run
+	// when the context has no deadline
+	DefaultCredentialHelperTimeout = 10 * time.Second
+
+	// DefaultCredentialHelperTTL is how long the keys of a credential helper
+	// are cached
+	DefaultCredentialHelperTTL = 5 * time.Minute
+
+	// maxHelperErrorLength is the length of the error output of a helper
+	// kept in the errors it causes
+	maxHelperErrorLength = 200
+)
+
+// CredentialHelper gets API keys from an external command, much as git gets
+// credentials from its credential helpers, so that they can be kept in a
+// vault or a keychain rather than in plain text.
+//
+// The command is run by the shell with the argument "get", and is given the
+// host of the W&B server on its standard input as a "host=<host>" line
+// followed by an empty line. It prints the API key on its standard output,
+// either alone or as a "password=<key>" line like git credential helpers.
+// It exits with a non-zero status if it has no key, with the reason on its
+// standard error.
+type CredentialHelper struct {
+	command string
+	ttl     time.Duration
+	now     func() time.Time
+
+	// mu is held while the helper runs, so that concurrent callers wait for
+	// the key rather than running the helper again
+	mu    sync.Mutex
+	cache map[string]cachedKey
+}
+
+type cachedKey struct {
+	key     string
+	expires time.Time
+}
+
+type CredentialHelperOption func(*CredentialHelper)
+
+// WithCredentialHelperTTL sets how long keys are cached, not at all if 0.
+func WithCredentialHelperTTL(ttl time.Duration) CredentialHelperOption {
+	return func(h *CredentialHelper) {
+		h.ttl = ttl
+	}
+}
+
+// WithCredentialHelperClock sets the clock the cached keys expire by.
+func WithCredentialHelperClock(now func() time.Time) CredentialHelperOption {
+	return func(h *CredentialHelper) {
+		h.now = now
+	}
+}
+
+func NewCredentialHelper(command string, opts ...CredentialHelperOption) *CredentialHelper {
+	h := &CredentialHelper{
+		command: command,
+		ttl:     DefaultCredentialHelperTTL,
+		now:     time.Now,
+		cache:   make(map[string]cachedKey),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+var (
+	credentialHelpersMu sync.Mutex
+	credentialHelpers   = make(map[string]*CredentialHelper)
+)
+
+// CredentialHelperFor returns the credential helper running command, which
+// is shared in the process so that its keys are cached across runs.
+func CredentialHelperFor(command string) *CredentialHelper {
+	credentialHelpersMu.Lock()
+	defer credentialHelpersMu.Unlock()
+	h, ok := credentialHelpers[command]
+	if !ok {
+		h = NewCredentialHelper(command)
+		credentialHelpers[command] = h
+	}
+	return h
+}
+
+// APIKey returns the API key of host, running the helper unless the key is
+// cached. The helper is stopped when ctx is done, or after
+// DefaultCredentialHelperTimeout if ctx has no deadline.
+func (h *CredentialHelper) APIKey(ctx context.Context, host string) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if cached, ok := h.cache[host]; ok && h.now().Before(cached.expires) {
+		return cached.key, nil
+	}
+
+	key, err := h.run(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	if h.ttl > 0 {
+		h.cache[host] = cachedKey{key: key, expires: h.now().Add(h.ttl)}
+	}
+	return key, nil
+}
+
+func (h *CredentialHelper) run(ctx context.Context, host string) (string, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultCredentialHelperTimeout)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", h.command+" get")
+	} else {
+		// the command is given its arguments as git does, so that it may be
+		// a command line with arguments of its own
+		cmd = exec.CommandContext(ctx, "sh", "-c", h.command+` "$@"`, h.command, "get")
+	}
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("host=%s\n\n", host))
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// the helper may leave children holding the output open
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return "", fmt.Errorf("auth: credential helper %q timed out: %w", h.command, ctx.Err())
+	case ctx.Err() != nil:
+		return "", fmt.Errorf("auth: credential helper %q: %w", h.command, ctx.Err())
+	case err != nil:
+		var exitErr *exec.ExitError
+		if message := helperError(stderr.String()); errors.As(err, &exitErr) && message != "" {
+			return "", fmt.Errorf("auth: credential helper %q: %v: %s", h.command, err, message)
+		}
+		return "", fmt.Errorf("auth: credential helper %q: %w", h.command, err)
+	}
+
+	key := parseHelperOutput(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("auth: credential helper %q returned no key for %s", h.command, host)
+	}
+	return key, nil
+}
+
+// parseHelperOutput returns the key in the output of a helper: the value of
+// its "password" line, or its only line
+func parseHelperOutput(output string) string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if key, ok := strings.CutPrefix(line, "password="); ok {
+			return key
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 1 || strings.Contains(lines[0], "=") {
+		return ""
+	}
+	return lines[0]
+}
+
+// helperError returns the error output of a helper, shortened to a line
+func helperError(stderr string) string {
+	message := strings.TrimSpace(stderr)
+	if i := strings.IndexByte(message, '\n'); i >= 0 {
+		message = message[:i]
+	}
+	if len(message) > maxHelperErrorLength {
+		message = message[:maxHelperErrorLength] + "..."
+	}
+	return message
+}
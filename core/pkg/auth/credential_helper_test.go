@@ -0,0 +1,138 @@
+package auth_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/pkg/auth"
+)
+
+// writeHelper writes a shell script credential helper and returns its path
+func writeHelper(t *testing.T, script string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper scripts are shell scripts")
+	}
+	path := filepath.Join(t.TempDir(), "helper.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755))
+	return path
+}
+
+func TestCredentialHelper(t *testing.T) {
+	testCases := []struct {
+		name   string
+		script string
+		key    string
+		err    string
+	}{
+		{
+			name:   "plain key",
+			script: "echo secret-key\n",
+			key:    "secret-key",
+		},
+		{
+			name:   "git credential format",
+			script: "echo username=api\necho password=secret-key\n",
+			key:    "secret-key",
+		},
+		{
+			name:   "key of the host",
+			script: `[ "$1" = get ] || exit 2; read line; echo "key-${line#host=}"` + "\n",
+			key:    "key-api.wandb.ai",
+		},
+		{
+			name:   "failure",
+			script: "echo 'vault is sealed' >&2\nexit 3\n",
+			err:    "exit status 3: vault is sealed",
+		},
+		{
+			name:   "no key",
+			script: "echo username=api\n",
+			err:    "returned no key for api.wandb.ai",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			helper := auth.NewCredentialHelper(writeHelper(t, tc.script))
+			key, err := helper.APIKey(context.Background(), "api.wandb.ai")
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.key, key)
+		})
+	}
+}
+
+func TestCredentialHelperArguments(t *testing.T) {
+	path := writeHelper(t, `echo "$1-$2"`+"\n")
+	helper := auth.NewCredentialHelper(path + " vault")
+
+	key, err := helper.APIKey(context.Background(), "api.wandb.ai")
+	require.NoError(t, err)
+	assert.Equal(t, "vault-get", key)
+}
+
+func TestCredentialHelperTimeout(t *testing.T) {
+	helper := auth.NewCredentialHelper(writeHelper(t, "sleep 10\necho late\n"))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := helper.APIKey(ctx, "api.wandb.ai")
+	assert.ErrorContains(t, err, "timed out")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestCredentialHelperCache(t *testing.T) {
+	calls := filepath.Join(t.TempDir(), "calls")
+	path := writeHelper(t, "echo call >> "+calls+"\necho secret-key\n")
+	now := time.Unix(1700000000, 0)
+	helper := auth.NewCredentialHelper(path,
+		auth.WithCredentialHelperTTL(time.Minute),
+		auth.WithCredentialHelperClock(func() time.Time { return now }),
+	)
+	countCalls := func() int {
+		data, _ := os.ReadFile(calls)
+		return strings.Count(string(data), "call")
+	}
+
+	for i := 0; i < 3; i++ {
+		key, err := helper.APIKey(context.Background(), "api.wandb.ai")
+		require.NoError(t, err)
+		assert.Equal(t, "secret-key", key)
+	}
+	assert.Equal(t, 1, countCalls())
+
+	// keys are cached by host
+	_, err := helper.APIKey(context.Background(), "wandb.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 2, countCalls())
+
+	now = now.Add(2 * time.Minute)
+	_, err = helper.APIKey(context.Background(), "api.wandb.ai")
+	require.NoError(t, err)
+	assert.Equal(t, 3, countCalls())
+}
+
+func TestCredentialHelperFailuresNotCached(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "unsealed")
+	path := writeHelper(t, "[ -f "+marker+" ] || exit 1\necho secret-key\n")
+	helper := auth.NewCredentialHelper(path)
+
+	_, err := helper.APIKey(context.Background(), "api.wandb.ai")
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(marker, nil, 0644))
+	key, err := helper.APIKey(context.Background(), "api.wandb.ai")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-key", key)
+}
@@ -1,6 +1,7 @@
 package filestream
 
 import (
+	"sync/atomic"
 	"time"
 )
 
@@ -15,6 +16,8 @@ var chunkFilename = map[ChunkTypeEnum]string{
 
 type chunkCollector struct {
 	input             <-chan processedChunk
+	wake              <-chan struct{}
+	paused            *atomic.Bool
 	isDone            bool
 	heartbeatTime     time.Duration
 	delayProcess      time.Duration
@@ -46,11 +49,21 @@ func (cr *chunkCollector) read() bool {
 		}
 		cr.addFileChunk(chunk)
 		return true
-	case <-time.After(cr.heartbeatTime):
+	case <-cr.wake:
+	case <-cr.heartbeat():
 	}
 	return false
 }
 
+// heartbeat returns the channel of the next heartbeat, or nil while the
+// stream is paused, since nothing is sent until it resumes
+func (cr *chunkCollector) heartbeat() <-chan time.Time {
+	if cr.paused != nil && cr.paused.Load() {
+		return nil
+	}
+	return time.After(cr.heartbeatTime)
+}
+
 func (cr *chunkCollector) delayTime() time.Duration {
 	delayTime := cr.delayProcess
 	// do not delay for more chunks if we overflowed on last iteration
@@ -33,6 +33,7 @@ package filestream
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -91,6 +92,12 @@ type FileStream struct {
 	maxItemsPerPush int
 	delayProcess    time.Duration
 	heartbeatTime   time.Duration
+
+	// paused holds back the requests to the server, see Pause
+	paused atomic.Bool
+
+	// resumeChan wakes up the transmit loop when the stream resumes
+	resumeChan chan struct{}
 }
 
 type FileStreamOption func(fs *FileStream)
@@ -160,6 +167,7 @@ func NewFileStream(opts ...FileStreamOption) *FileStream {
 		processChan:     make(chan protoreflect.ProtoMessage, BufferSize),
 		transmitChan:    make(chan processedChunk, BufferSize),
 		feedbackChan:    make(chan map[string]interface{}, BufferSize),
+		resumeChan:      make(chan struct{}, 1),
 		offsetMap:       make(FileStreamOffsetMap),
 		maxItemsPerPush: defaultMaxItemsPerPush,
 		delayProcess:    defaultDelayProcess,
@@ -203,6 +211,29 @@ func (fs *FileStream) StreamRecord(rec *service.Record) {
 	fs.addProcess(rec)
 }
 
+// Pause stops sending requests to the server, heartbeats included. Records
+// are still processed, and are sent as one request when the stream resumes or
+// closes.
+func (fs *FileStream) Pause() {
+	if fs == nil {
+		return
+	}
+	fs.logger.Debug("filestream: pause")
+	fs.paused.Store(true)
+}
+
+// Resume sends the requests held back while paused and resumes streaming
+func (fs *FileStream) Resume() {
+	if fs == nil || !fs.paused.Swap(false) {
+		return
+	}
+	fs.logger.Debug("filestream: resume")
+	select {
+	case fs.resumeChan <- struct{}{}:
+	default:
+	}
+}
+
 func (fs *FileStream) GetInputChan() chan protoreflect.ProtoMessage {
 	return fs.processChan
 }
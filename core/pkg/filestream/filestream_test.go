@@ -20,6 +20,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type captureState struct {
@@ -200,3 +202,92 @@ func BenchmarkHistory(b *testing.B) {
 		})
 	assert.Equal(b, num, tst.capture.m["total"].(int))
 }
+
+func (hs *captureState) count(s string) int {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+	n, _ := hs.m[s].(int)
+	return n
+}
+
+func TestPauseHoldsBackRequests(t *testing.T) {
+	tserver := NewTestServer()
+	defer tserver.close()
+	capture := &captureState{m: make(map[string]interface{})}
+	fstreamPath := "/test/" + t.Name()
+	tserver.mux.Handle(fstreamPath, apiHandler{capture})
+
+	fs := filestream.NewFileStream(
+		filestream.WithPath(tserver.hserver.URL+fstreamPath),
+		filestream.WithSettings(tserver.settings),
+		filestream.WithLogger(tserver.logger),
+		filestream.WithHttpClient(clients.NewRetryClient(
+			clients.WithRetryClientLogger(tserver.logger))),
+		filestream.WithHeartbeatTime(5*time.Millisecond),
+		filestream.WithDelayProcess(time.Millisecond),
+	)
+	fs.Start()
+
+	fs.Pause()
+	msg := NewHistoryRecord()
+	for i := 0; i < 10; i++ {
+		fs.StreamRecord(msg)
+		// the records are collected in several batches
+		time.Sleep(10 * time.Millisecond)
+	}
+	// several heartbeats go by without a request
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, capture.count("records"))
+
+	// the records held back are sent in one request
+	fs.Resume()
+	assert.Eventually(t, func() bool { return capture.count("total") == 10 },
+		time.Second, 5*time.Millisecond)
+	assert.Equal(t, 1, capture.count("records"))
+
+	fs.Close()
+}
+
+func TestSendEvent(t *testing.T) {
+	tserver := NewTestServer()
+	defer tserver.close()
+	tserver.settings.XStartTime = wrapperspb.Double(1700000000)
+	var lock sync.Mutex
+	var lines []string
+	fstreamPath := "/test/" + t.Name()
+	tserver.mux.HandleFunc(fstreamPath, func(w http.ResponseWriter, r *http.Request) {
+		var msg filestream.FsTransmitData
+		_ = json.NewDecoder(r.Body).Decode(&msg)
+		lock.Lock()
+		lines = append(lines, msg.Files[filestream.EventsFileName].Content...)
+		lock.Unlock()
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	fs := filestream.NewFileStream(
+		filestream.WithPath(tserver.hserver.URL+fstreamPath),
+		filestream.WithSettings(tserver.settings),
+		filestream.WithLogger(tserver.logger),
+		filestream.WithHttpClient(clients.NewRetryClient(
+			clients.WithRetryClientLogger(tserver.logger))),
+	)
+	fs.Start()
+	fs.StreamRecord(&service.Record{
+		RecordType: &service.Record_Event{
+			Event: &service.EventRecord{
+				Name:      "lr_decayed",
+				Step:      &service.HistoryStep{Num: 7},
+				Timestamp: timestamppb.New(time.Unix(1700000012, 500_000_000)),
+				Attribute: []*service.EventItem{{Key: "lr", ValueJson: "0.01"}},
+			},
+		},
+	})
+	fs.Close()
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t,
+		[]string{`{"_attributes":{"lr":0.01},"_event":"lr_decayed","_runtime":12.5,"_step":7,"_timestamp":1700000012.5}`},
+		lines,
+	)
+}
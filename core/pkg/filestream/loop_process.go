@@ -33,6 +33,8 @@ func (fs *FileStream) processRecord(record *service.Record) {
 		fs.streamSummary(x.Summary)
 	case *service.Record_Stats:
 		fs.streamSystemMetrics(x.Stats)
+	case *service.Record_Event:
+		fs.streamEvent(x.Event)
 	case *service.Record_OutputRaw:
 		fs.streamOutputRaw(x.OutputRaw)
 	case *service.Record_Exit:
@@ -132,6 +134,42 @@ func (fs *FileStream) streamSystemMetrics(msg *service.StatsRecord) {
 	})
 }
 
+// streamEvent sends an event as a line of the events file, along with the
+// system metrics; the line holds the name of the event under "_event" and
+// its attributes under "_attributes"
+func (fs *FileStream) streamEvent(msg *service.EventRecord) {
+	attributes := make(map[string]interface{})
+	for _, item := range msg.Attribute {
+		var val interface{}
+		if err := json.Unmarshal([]byte(item.ValueJson), &val); err != nil {
+			e := fmt.Errorf("json unmarshal error: %v, items: %v", err, item)
+			errMsg := fmt.Sprintf("sender: sendEvent: failed to unmarshal value: %s for key: %s", item.ValueJson, item.Key)
+			fs.logger.CaptureError(errMsg, e)
+			continue
+		}
+		attributes[item.Key] = val
+	}
+
+	timestamp := float64(msg.GetTimestamp().Seconds) + float64(msg.GetTimestamp().Nanos)/1e9
+	row := map[string]interface{}{
+		"_event":      msg.Name,
+		"_step":       msg.GetStep().GetNum(),
+		"_timestamp":  timestamp,
+		"_runtime":    timestamp - fs.settings.XStartTime.GetValue(),
+		"_attributes": attributes,
+	}
+	line, err := json.Marshal(row)
+	if err != nil {
+		fs.logger.CaptureError("sender: sendEvent: failed to marshal event", err)
+		return
+	}
+
+	fs.addTransmit(processedChunk{
+		fileType: EventsChunk,
+		fileLine: string(line),
+	})
+}
+
 func (fs *FileStream) streamPreempting(exitRecord *service.RunPreemptingRecord) {
 	fs.addTransmit(processedChunk{
 		Preempting: true,
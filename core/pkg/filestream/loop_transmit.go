@@ -33,19 +33,55 @@ func (fs *FileStream) addTransmit(chunk processedChunk) {
 func (fs *FileStream) loopTransmit(inChan <-chan processedChunk) {
 	collector := chunkCollector{
 		input:           inChan,
+		wake:            fs.resumeChan,
+		paused:          &fs.paused,
 		heartbeatTime:   fs.heartbeatTime,
 		delayProcess:    fs.delayProcess,
 		maxItemsPerPush: fs.maxItemsPerPush,
 	}
+	// pending is the request collected while the stream is paused
+	var pending *FsTransmitData
 	for !collector.isDone {
 		if readMore := collector.read(); readMore {
 			collector.readMore()
 		}
-		data := collector.dump(fs.offsetMap)
-		if data != nil {
-			fs.send(data)
+		if data := collector.dump(fs.offsetMap); data != nil {
+			if pending == nil {
+				pending = data
+			} else {
+				pending.merge(data)
+			}
 		}
+		if pending == nil || fs.paused.Load() && !collector.isDone {
+			continue
+		}
+		fs.send(pending)
+		pending = nil
+	}
+}
+
+// merge appends the request that follows data to it, as if they had been
+// collected together
+func (data *FsTransmitData) merge(next *FsTransmitData) {
+	for name, file := range next.Files {
+		if data.Files == nil {
+			data.Files = make(map[string]fsTransmitFileData)
+		}
+		if held, ok := data.Files[name]; ok {
+			held.Content = append(held.Content, file.Content...)
+			file = held
+		}
+		data.Files[name] = file
+	}
+	if next.Complete != nil {
+		data.Complete = next.Complete
+	}
+	if next.Exitcode != nil {
+		data.Exitcode = next.Exitcode
 	}
+	data.Preempting = data.Preempting || next.Preempting
+	data.Dropped += next.Dropped
+	data.Uploaded = append(data.Uploaded, next.Uploaded...)
 }
 
 func (fs *FileStream) send(data interface{}) {
@@ -0,0 +1,73 @@
+package gowandb
+
+import (
+	"errors"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// CheckpointPolicy decides which checkpoints of a run are uploaded: those
+// among the last KeepLast, and those among the best KeepBest by Metric. All
+// checkpoints are uploaded if both are 0.
+type CheckpointPolicy struct {
+	KeepLast int
+	KeepBest int
+	Metric   string
+	// Minimize makes lower values of Metric better, such as for a loss
+	Minimize bool
+}
+
+// CheckpointResult is the outcome of LogCheckpoint.
+type CheckpointResult struct {
+	// Retained is whether the policy retains the checkpoint
+	Retained bool
+	// EvictedSteps are the steps of the checkpoints no longer retained
+	EvictedSteps []int64
+}
+
+// SetCheckpointPolicy sets the policy of the checkpoints logged after it.
+func (r *Run) SetCheckpointPolicy(policy CheckpointPolicy) {
+	r.checkpointPolicy = &service.CheckpointPolicy{
+		KeepLast: int32(policy.KeepLast),
+		KeepBest: int32(policy.KeepBest),
+		Metric:   policy.Metric,
+		Minimize: policy.Minimize,
+	}
+}
+
+// LogCheckpoint records the checkpoint file or directory at path, saved at
+// the current step of the run with the given metrics, which may be nil. The
+// checkpoint is copied, so path may be overwritten once it returns.
+//
+// The checkpoints retained by the policy are uploaded when the run finishes,
+// as versions of the checkpoint-<run id> model artifact aliased with their
+// step.
+func (r *Run) LogCheckpoint(path string, metrics map[string]float64) (*CheckpointResult, error) {
+	return r.logCheckpoint(&service.CheckpointRequest{Path: path, Metrics: metrics})
+}
+
+// LogCheckpointAtStep records a checkpoint saved at the given step of the run.
+func (r *Run) LogCheckpointAtStep(path string, step int64, metrics map[string]float64) (*CheckpointResult, error) {
+	return r.logCheckpoint(&service.CheckpointRequest{
+		Path:    path,
+		Step:    &service.HistoryStep{Num: step},
+		Metrics: metrics,
+	})
+}
+
+func (r *Run) logCheckpoint(checkpoint *service.CheckpointRequest) (*CheckpointResult, error) {
+	checkpoint.Policy = r.checkpointPolicy
+	response := r.request(r.ctx, &service.Request{
+		RequestType: &service.Request_Checkpoint{Checkpoint: checkpoint},
+	}).GetCheckpointResponse()
+	if response == nil {
+		return nil, errors.New("gowandb: no response to checkpoint request")
+	}
+	if response.GetError() != nil {
+		return nil, errors.New(response.GetError().GetMessage())
+	}
+	return &CheckpointResult{
+		Retained:     response.GetRetained(),
+		EvictedSteps: response.GetEvictedSteps(),
+	}, nil
+}
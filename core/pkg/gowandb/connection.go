@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -22,6 +23,9 @@ type Connection struct {
 	// Conn is the connection to the server
 	net.Conn
 	Mbox *Mailbox
+
+	// sendMutex serializes writes, as runs may send from several goroutines
+	sendMutex sync.Mutex
 }
 
 // NewConnection creates a new connection to the server.
@@ -46,6 +50,8 @@ func (c *Connection) Send(msg proto.Message) error {
 	if err != nil {
 		return fmt.Errorf("error marshaling message: %w", err)
 	}
+	c.sendMutex.Lock()
+	defer c.sendMutex.Unlock()
 	writer := bufio.NewWriterSize(c, 16384)
 
 	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
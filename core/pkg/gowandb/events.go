@@ -0,0 +1,289 @@
+package gowandb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// eventPollInterval is how often the run is polled for upload progress and
+// network errors
+const eventPollInterval = 2 * time.Second
+
+// defaultStopPollInterval is how often the run is polled for a stop request
+// if the _stop_polling_interval_seconds setting is not set. Each poll is a
+// query to the server, so it is much longer than eventPollInterval.
+const defaultStopPollInterval = 15 * time.Second
+
+// EventType is the kind of an Event.
+type EventType int
+
+const (
+	// EventStopRequested is delivered once, when the run was stopped from the UI.
+	EventStopRequested EventType = iota + 1
+	// EventUploadProgress is delivered when the number of uploaded bytes changes.
+	EventUploadProgress
+	// EventNetworkError is delivered for every failed request to the server.
+	EventNetworkError
+	// EventFinished is the last event of a run, delivered by Finish.
+	EventFinished
+)
+
+// Event is a notification about a run delivered to an EventCallback.
+type Event struct {
+	Type EventType
+
+	// UploadedBytes and TotalBytes are set for EventUploadProgress and EventFinished
+	UploadedBytes int64
+	TotalBytes    int64
+
+	// BytesPerSecond, ETA and Files are the smoothed rate of the transfers,
+	// the estimated time left (negative if not known) and the files not
+	// transferred yet, set for EventUploadProgress and EventFinished
+	BytesPerSecond float64
+	ETA            time.Duration
+	Files          []FileProgress
+
+	// HttpStatusCode is set for EventNetworkError
+	HttpStatusCode int32
+
+	// Message describes the failed request for EventNetworkError, and the
+	// errors seen while finishing for EventFinished (empty if there were none)
+	Message string
+
+	// ExitCode is set for EventFinished
+	ExitCode int32
+}
+
+// FileProgress is the progress of the transfer of a file.
+type FileProgress struct {
+	Path string
+	// State is "queued", "transferring" or "failed"
+	State            string
+	Size             int64
+	TransferredBytes int64
+	// Retries is the number of times the transfer restarted
+	Retries        int
+	BytesPerSecond float64
+	// ETA is the estimated time left, negative if not known
+	ETA   time.Duration
+	Error string
+}
+
+// etaDuration converts an estimate in seconds, negative if not known
+func etaDuration(seconds float64) time.Duration {
+	if seconds < 0 {
+		return -1
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func newFileProgress(status *service.FileTransferStatus) FileProgress {
+	return FileProgress{
+		Path:             status.GetPath(),
+		State:            strings.ToLower(status.GetState().String()),
+		Size:             status.GetSize(),
+		TransferredBytes: status.GetTransferredBytes(),
+		Retries:          int(status.GetRetries()),
+		BytesPerSecond:   status.GetBytesPerSecond(),
+		ETA:              etaDuration(status.GetEtaSeconds()),
+		Error:            status.GetError(),
+	}
+}
+
+// EventCallback receives the events of a run.
+//
+// Calls are serialized: the callback is never called concurrently with itself.
+// It is called from a goroutine owned by the run, and finally from Finish with
+// EventFinished. It must not call Finish.
+type EventCallback func(event *Event)
+
+// eventWatcher polls the stream of a run for events and delivers them
+type eventWatcher struct {
+	run      *Run
+	callback EventCallback
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	stopRequested bool
+	uploadedBytes int64
+	totalBytes    int64
+	progress      *service.FileTransferProgressResponse
+}
+
+// SetEventCallback registers callback to receive the events of the run,
+// replacing any previous callback. A nil callback stops the delivery of events.
+func (r *Run) SetEventCallback(callback EventCallback) {
+	r.watcherMu.Lock()
+	defer r.watcherMu.Unlock()
+
+	if r.watcher != nil {
+		r.watcher.stop()
+		r.watcher = nil
+	}
+	if callback == nil {
+		return
+	}
+	r.watcher = newEventWatcher(r, callback)
+	r.watcher.start()
+}
+
+func newEventWatcher(run *Run, callback EventCallback) *eventWatcher {
+	return &eventWatcher{run: run, callback: callback}
+}
+
+func (w *eventWatcher) start() {
+	ctx, cancel := context.WithCancel(w.run.ctx)
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(eventPollInterval)
+		defer ticker.Stop()
+		stopTicker := time.NewTicker(w.stopPollInterval())
+		defer stopTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.poll(ctx)
+			case <-stopTicker.C:
+				w.pollStopStatus(ctx)
+			}
+		}
+	}()
+}
+
+// stopPollInterval is the interval of the _stop_polling_interval_seconds
+// setting, or defaultStopPollInterval
+func (w *eventWatcher) stopPollInterval() time.Duration {
+	if seconds := w.run.settings.GetXStopPollingIntervalSeconds().GetValue(); seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return defaultStopPollInterval
+}
+
+// stop stops polling and waits for an in-flight callback to return
+func (w *eventWatcher) stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *eventWatcher) poll(ctx context.Context) {
+	w.pollUploadProgress(ctx)
+	w.pollNetworkErrors(ctx)
+}
+
+// pollStopStatus delivers EventStopRequested the first time the run should
+// stop
+func (w *eventWatcher) pollStopStatus(ctx context.Context) {
+	if w.stopRequested {
+		return
+	}
+	response := w.run.request(ctx, &service.Request{
+		RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+	})
+	if response.GetStopStatusResponse().GetRunShouldStop() {
+		w.stopRequested = true
+		w.callback(&Event{Type: EventStopRequested})
+	}
+}
+
+func (w *eventWatcher) pollUploadProgress(ctx context.Context) {
+	response := w.run.request(ctx, &service.Request{
+		RequestType: &service.Request_PollExit{PollExit: &service.PollExitRequest{}},
+	})
+	stats := response.GetPollExitResponse().GetPusherStats()
+	if stats == nil {
+		return
+	}
+	progress := response.GetPollExitResponse().GetFileTransferProgress()
+	if stats.GetUploadedBytes() == w.uploadedBytes && stats.GetTotalBytes() == w.totalBytes &&
+		len(progress.GetFiles()) == len(w.progress.GetFiles()) {
+		return
+	}
+	w.uploadedBytes = stats.GetUploadedBytes()
+	w.totalBytes = stats.GetTotalBytes()
+	w.progress = progress
+	event := &Event{Type: EventUploadProgress}
+	w.setUploadProgress(event)
+	w.callback(event)
+}
+
+// setUploadProgress sets the upload progress fields of an event from the
+// last poll
+func (w *eventWatcher) setUploadProgress(event *Event) {
+	event.UploadedBytes = w.uploadedBytes
+	event.TotalBytes = w.totalBytes
+	if w.progress == nil {
+		event.ETA = -1
+		return
+	}
+	event.BytesPerSecond = w.progress.GetBytesPerSecond()
+	event.ETA = etaDuration(w.progress.GetEtaSeconds())
+	for _, status := range w.progress.GetFiles() {
+		event.Files = append(event.Files, newFileProgress(status))
+	}
+}
+
+// pollNetworkErrors delivers the network errors since the last poll, and
+// returns them
+func (w *eventWatcher) pollNetworkErrors(ctx context.Context) []*service.HttpResponse {
+	response := w.run.request(ctx, &service.Request{
+		RequestType: &service.Request_NetworkStatus{NetworkStatus: &service.NetworkStatusRequest{}},
+	})
+	failures := response.GetNetworkStatusResponse().GetNetworkResponses()
+	for _, failure := range failures {
+		w.callback(&Event{
+			Type:           EventNetworkError,
+			HttpStatusCode: failure.GetHttpStatusCode(),
+			Message:        failure.GetHttpResponseText(),
+		})
+	}
+	return failures
+}
+
+// finish delivers the remaining events of a run that has exited with
+// exitCode, ending with EventFinished. The watcher must be stopped.
+func (w *eventWatcher) finish(exitCode int32) {
+	ctx := w.run.ctx
+	w.pollUploadProgress(ctx)
+	failures := w.pollNetworkErrors(ctx)
+
+	event := &Event{
+		Type:     EventFinished,
+		ExitCode: exitCode,
+	}
+	w.setUploadProgress(event)
+	if len(failures) > 0 {
+		last := failures[len(failures)-1]
+		event.Message = fmt.Sprintf(
+			"%d failed requests while finishing, last: %s",
+			len(failures),
+			last.GetHttpResponseText(),
+		)
+	}
+	w.callback(event)
+}
+
+// request sends a request to the stream of the run and waits for its response.
+// It returns nil if the request could not be sent or ctx is done first.
+func (r *Run) request(ctx context.Context, request *service.Request) *service.Response {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: request},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	}
+	handle := r.conn.Mbox.Deliver(&record)
+	if err := r.conn.Send(&serverRecord); err != nil {
+		return nil
+	}
+	return handle.waitContext(ctx).GetResponse()
+}
@@ -1,7 +1,9 @@
 package gowandb
 
 import (
+	"context"
 	"strings"
+	"sync"
 
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -13,6 +15,7 @@ type MailboxHandle struct {
 
 type Mailbox struct {
 	handles map[string]*MailboxHandle
+	mutex   sync.Mutex
 }
 
 func NewMailbox() *Mailbox {
@@ -22,7 +25,8 @@ func NewMailbox() *Mailbox {
 }
 
 func NewMailboxHandle() *MailboxHandle {
-	mbh := &MailboxHandle{responseChan: make(chan *service.Result)}
+	// buffered so that a response for an abandoned handle never blocks the receiver
+	mbh := &MailboxHandle{responseChan: make(chan *service.Result, 1)}
 	return mbh
 }
 
@@ -31,10 +35,22 @@ func (mbh *MailboxHandle) wait() *service.Result {
 	return got
 }
 
+// waitContext is like wait but gives up once ctx is done, returning nil.
+func (mbh *MailboxHandle) waitContext(ctx context.Context) *service.Result {
+	select {
+	case got := <-mbh.responseChan:
+		return got
+	case <-ctx.Done():
+		return nil
+	}
+}
+
 func (mb *Mailbox) Deliver(rec *service.Record) *MailboxHandle {
 	uuid := "core:" + shared.ShortID(12)
 	rec.Control = &service.Control{MailboxSlot: uuid}
 	handle := NewMailboxHandle()
+	mb.mutex.Lock()
+	defer mb.mutex.Unlock()
 	mb.handles[uuid] = handle
 	return handle
 }
@@ -44,11 +60,15 @@ func (mb *Mailbox) Respond(result *service.Result) bool {
 	if !strings.HasPrefix(slot, "core:") {
 		return false
 	}
+	mb.mutex.Lock()
 	handle, ok := mb.handles[slot]
 	if ok {
-		handle.responseChan <- result
 		// clean up after thyself?
 		delete(mb.handles, slot)
 	}
+	mb.mutex.Unlock()
+	if ok {
+		handle.responseChan <- result
+	}
 	return ok
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"sort"
 	"sync"
 
 	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
@@ -26,6 +28,12 @@ type Run struct {
 	run            *service.RunRecord
 	params         *runopts.RunParams
 	partialHistory History
+	// watcher delivers the events of the run, nil if there is no callback;
+	// it is guarded by watcherMu since the callback is set from any goroutine
+	watcherMu sync.Mutex
+	watcher   *eventWatcher
+	// checkpointPolicy is sent with each checkpoint, nil if not set
+	checkpointPolicy *service.CheckpointPolicy
 }
 
 // NewRun creates a new run with the given settings and responders.
@@ -84,13 +92,9 @@ func (r *Run) init() {
 			ValueJson: string(data),
 		})
 	}
-	var DisplayName string
-	if r.params.Name != nil {
-		DisplayName = *r.params.Name
-	}
 	runRecord := service.Record_Run{Run: &service.RunRecord{
 		RunId:       r.settings.GetRunId().GetValue(),
-		DisplayName: DisplayName,
+		DisplayName: r.params.Name,
 		Config:      config,
 		Telemetry:   r.params.Telemetry,
 		XInfo:       &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
@@ -204,11 +208,55 @@ func (r *Run) Log(data map[string]interface{}) {
 	r.LogPartial(data, true)
 }
 
-func (r *Run) sendExit() {
+// LogEvent records a discrete event, such as "lr_decayed" or
+// "checkpoint_saved", at the current step of the run. The attributes may be
+// nil.
+func (r *Run) LogEvent(name string, attributes map[string]interface{}) {
+	r.logEvent(&service.EventRecord{Name: name}, attributes)
+}
+
+// LogEventAtStep records a discrete event at the given step of the run.
+func (r *Run) LogEventAtStep(name string, step int64, attributes map[string]interface{}) {
+	r.logEvent(&service.EventRecord{Name: name, Step: &service.HistoryStep{Num: step}}, attributes)
+}
+
+func (r *Run) logEvent(event *service.EventRecord, attributes map[string]interface{}) {
+	event.Timestamp = timestamppb.Now()
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		data, err := json.Marshal(attributes[key])
+		if err != nil {
+			panic(err)
+		}
+		event.Attribute = append(event.Attribute, &service.EventItem{
+			Key:       key,
+			ValueJson: string(data),
+		})
+	}
+	record := service.Record{
+		RecordType: &service.Record_Event{Event: event},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+
+	serverRecord := service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	}
+
+	err := r.conn.Send(&serverRecord)
+	if err != nil {
+		return
+	}
+}
+
+func (r *Run) sendExit(exitCode int32) {
 	record := service.Record{
 		RecordType: &service.Record_Exit{
 			Exit: &service.RunExitRecord{
-				ExitCode: 0, XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}}},
+				ExitCode: exitCode, XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}}},
 		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
 	serverRecord := service.ServerRequest{
@@ -255,8 +303,25 @@ func (r *Run) sendInformFinish() {
 	}
 }
 
+// Finish finishes the run with exit code 0.
 func (r *Run) Finish() {
-	r.sendExit()
+	r.FinishWithExitCode(0)
+}
+
+// FinishWithExitCode finishes the run with the exit code of the program,
+// which is also passed to the EventFinished event.
+func (r *Run) FinishWithExitCode(exitCode int32) {
+	r.watcherMu.Lock()
+	watcher := r.watcher
+	if watcher != nil {
+		watcher.stop()
+		r.watcher = nil
+	}
+	r.watcherMu.Unlock()
+	r.sendExit(exitCode)
+	if watcher != nil {
+		watcher.finish(exitCode)
+	}
 	r.sendShutdown()
 	r.sendInformFinish()
 
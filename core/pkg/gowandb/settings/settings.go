@@ -3,6 +3,7 @@ package settings
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -95,6 +96,11 @@ func NewSettings(args ...any) *SettingsWrap {
 		settings.ApiKey = &wrapperspb.StringValue{Value: apiKey}
 	}
 
+	stopPollingInterval, err := strconv.ParseFloat(os.Getenv("WANDB__STOP_POLLING_INTERVAL_SECONDS"), 64)
+	if err == nil {
+		settings.XStopPollingIntervalSeconds = &wrapperspb.DoubleValue{Value: stopPollingInterval}
+	}
+
 	return &SettingsWrap{settings}
 }
 
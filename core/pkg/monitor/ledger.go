@@ -0,0 +1,254 @@
+package monitor
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/shirou/gopsutil/v3/cpu"
+)
+
+// ResourceUsage are the resources used by a run, integrated over the time
+// it was monitored. Devices are keyed by index, such as "0" for gpu.0.
+type ResourceUsage struct {
+	// GPUHours are the hours each GPU was monitored
+	GPUHours map[string]float64 `json:"gpu_hours,omitempty"`
+	// GPUUtilizedHours are the hours each GPU was monitored, weighted by its
+	// utilization
+	GPUUtilizedHours map[string]float64 `json:"gpu_utilized_hours,omitempty"`
+	// CPUCoreHours are the hours of the CPU cores used by the process
+	CPUCoreHours float64 `json:"cpu_core_hours"`
+	// PeakMemoryMB is the peak resident memory of the process
+	PeakMemoryMB float64 `json:"peak_memory_mb"`
+	// PeakGPUMemoryBytes is the peak allocated memory of each GPU
+	PeakGPUMemoryBytes map[string]float64 `json:"peak_gpu_memory_bytes,omitempty"`
+	// NetworkSentBytes and NetworkRecvBytes are the bytes sent and received by
+	// the system since the run started
+	NetworkSentBytes float64 `json:"network_sent_bytes"`
+	NetworkRecvBytes float64 `json:"network_recv_bytes"`
+	// EnergyWh are the watt-hours used by each device with power readings,
+	// keyed by gpu.<index>, or system for the whole system
+	EnergyWh map[string]float64 `json:"energy_wh,omitempty"`
+	// Cost is the estimated cost of the resources, if prices were given
+	Cost *CostEstimate `json:"cost,omitempty"`
+}
+
+// TotalEnergyWh returns the watt-hours used by the system, or by the GPUs if
+// the power of the system is not known.
+func (u *ResourceUsage) TotalEnergyWh() float64 {
+	if wh, ok := u.EnergyWh["system"]; ok {
+		return wh
+	}
+	total := 0.0
+	for _, wh := range u.EnergyWh {
+		total += wh
+	}
+	return total
+}
+
+// PriceTable are the prices of resources, read from the file of the
+// _resource_price_table setting, such as
+//
+//	{"currency": "USD", "gpu_hour": 2.5, "cpu_core_hour": 0.04, "kwh": 0.15}
+type PriceTable struct {
+	Currency    string  `json:"currency"`
+	GPUHour     float64 `json:"gpu_hour"`
+	CPUCoreHour float64 `json:"cpu_core_hour"`
+	KWh         float64 `json:"kwh"`
+	// NetworkGB is the price of a gigabyte sent
+	NetworkGB float64 `json:"network_gb"`
+}
+
+// CostEstimate is the estimated cost of the resources used by a run.
+type CostEstimate struct {
+	Currency string  `json:"currency,omitempty"`
+	Total    float64 `json:"total"`
+	GPU      float64 `json:"gpu"`
+	CPU      float64 `json:"cpu"`
+	Energy   float64 `json:"energy"`
+	Network  float64 `json:"network"`
+}
+
+// LoadPriceTable reads a price table file.
+func LoadPriceTable(path string) (*PriceTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var prices PriceTable
+	if err := json.Unmarshal(data, &prices); err != nil {
+		return nil, err
+	}
+	return &prices, nil
+}
+
+// Estimate returns the cost of the resources of usage at these prices.
+func (p *PriceTable) Estimate(usage *ResourceUsage) *CostEstimate {
+	estimate := &CostEstimate{
+		Currency: p.Currency,
+		CPU:      usage.CPUCoreHours * p.CPUCoreHour,
+		Energy:   usage.TotalEnergyWh() / 1000 * p.KWh,
+		Network:  usage.NetworkSentBytes / 1e9 * p.NetworkGB,
+	}
+	for _, hours := range usage.GPUHours {
+		estimate.GPU += hours * p.GPUHour
+	}
+	estimate.Total = estimate.GPU + estimate.CPU + estimate.Energy + estimate.Network
+	return estimate
+}
+
+type ResourceLedgerOption func(*ResourceLedger)
+
+// WithResourceLedgerCPUCount sets the number of CPU cores, used in tests.
+func WithResourceLedgerCPUCount(count int) ResourceLedgerOption {
+	return func(l *ResourceLedger) {
+		l.cpuCount = count
+	}
+}
+
+// ResourceLedger integrates the metrics sampled by the system monitor into
+// the resources used by a run. It is safe for concurrent use.
+//
+// Each aggregated metric is the mean over the time since the previous one of
+// its asset, so rates such as the power of a device are multiplied by that
+// time. Time while the monitor is stopped, such as while the run is paused,
+// is not counted.
+type ResourceLedger struct {
+	mu       sync.Mutex
+	cpuCount int
+	// start is when the monitor last started
+	start time.Time
+	// last is when each metric was last observed since then
+	last  map[string]time.Time
+	usage ResourceUsage
+}
+
+func NewResourceLedger(opts ...ResourceLedgerOption) *ResourceLedger {
+	l := &ResourceLedger{last: make(map[string]time.Time)}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.cpuCount == 0 {
+		count, err := cpu.Counts(true)
+		if err != nil || count == 0 {
+			count = 1
+		}
+		l.cpuCount = count
+	}
+	return l
+}
+
+// Start starts or resumes the ledger: the next metrics are integrated from
+// now.
+func (l *ResourceLedger) Start(now time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.start = now
+	l.last = make(map[string]time.Time)
+}
+
+// Observe integrates metrics aggregated at now.
+func (l *ResourceLedger) Observe(metrics map[string]float64, now time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, value := range metrics {
+		since, ok := l.last[key]
+		if !ok {
+			since = l.start
+		}
+		l.last[key] = now
+		hours := max(now.Sub(since).Hours(), 0)
+
+		switch key {
+		case "cpu":
+			// the CPU usage of the process is a percentage of all the cores
+			l.usage.CPUCoreHours += value / 100 * float64(l.cpuCount) * hours
+			continue
+		case "proc.memory.rssMB":
+			l.usage.PeakMemoryMB = max(l.usage.PeakMemoryMB, value)
+			continue
+		case "network.sent":
+			l.usage.NetworkSentBytes = value
+			continue
+		case "network.recv":
+			l.usage.NetworkRecvBytes = value
+			continue
+		case "system.powerWatts":
+			l.usage.EnergyWh = addTo(l.usage.EnergyWh, "system", value*hours)
+			continue
+		}
+
+		device, metric, ok := gpuMetric(key)
+		if !ok {
+			continue
+		}
+		switch metric {
+		case "gpu":
+			l.usage.GPUHours = addTo(l.usage.GPUHours, device, hours)
+			l.usage.GPUUtilizedHours = addTo(l.usage.GPUUtilizedHours, device, value/100*hours)
+		case "powerWatts":
+			l.usage.EnergyWh = addTo(l.usage.EnergyWh, "gpu."+device, value*hours)
+		case "memoryAllocatedBytes":
+			if l.usage.PeakGPUMemoryBytes == nil {
+				l.usage.PeakGPUMemoryBytes = make(map[string]float64)
+			}
+			l.usage.PeakGPUMemoryBytes[device] = max(l.usage.PeakGPUMemoryBytes[device], value)
+		}
+	}
+}
+
+// Usage returns the resources used so far.
+func (l *ResourceLedger) Usage() *ResourceUsage {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	usage := l.usage
+	usage.GPUHours = copyMap(l.usage.GPUHours)
+	usage.GPUUtilizedHours = copyMap(l.usage.GPUUtilizedHours)
+	usage.PeakGPUMemoryBytes = copyMap(l.usage.PeakGPUMemoryBytes)
+	usage.EnergyWh = copyMap(l.usage.EnergyWh)
+	return &usage
+}
+
+// gpuMetric splits a metric of a GPU, such as gpu.0.powerWatts, into the
+// index of the device and the name of the metric
+func gpuMetric(key string) (string, string, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0] != "gpu" {
+		return "", "", false
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+func addTo(m map[string]float64, key string, value float64) map[string]float64 {
+	if m == nil {
+		m = make(map[string]float64)
+	}
+	m[key] += value
+	return m
+}
+
+func copyMap(m map[string]float64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]float64, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,182 @@
+package monitor_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/monitor"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+// fakeAsset yields its samples in order, each after interval has passed on
+// the clock, and no metrics once they run out
+type fakeAsset struct {
+	clock    *fakeClock
+	interval time.Duration
+	samples  []map[string]float64
+}
+
+func (a *fakeAsset) Name() string   { return "fake" }
+func (a *fakeAsset) SampleMetrics() {}
+func (a *fakeAsset) ClearMetrics()  {}
+func (a *fakeAsset) IsAvailable() bool {
+	return true
+}
+func (a *fakeAsset) Probe() *service.MetadataRequest {
+	return nil
+}
+
+func (a *fakeAsset) AggregateMetrics() map[string]float64 {
+	if len(a.samples) == 0 {
+		return nil
+	}
+	a.clock.Advance(a.interval)
+	sample := a.samples[0]
+	a.samples = a.samples[1:]
+	return sample
+}
+
+func TestResourceLedger(t *testing.T) {
+	start := time.Unix(1000, 0)
+	ledger := monitor.NewResourceLedger(monitor.WithResourceLedgerCPUCount(8))
+	ledger.Start(start)
+
+	ledger.Observe(map[string]float64{
+		"cpu":                           50,
+		"proc.memory.rssMB":             100,
+		"gpu.0.gpu":                     100,
+		"gpu.0.powerWatts":              300,
+		"gpu.0.memoryAllocatedBytes":    1e9,
+		"gpu.process.0.gpu":             100,
+		"gpu.1.gpu":                     50,
+		"network.sent":                  1000,
+		"network.recv":                  2000,
+		"cpu.0.cpu_percent":             100,
+		"proc.memory.availableMB":       1000,
+		"gpu.0.enforcedPowerLimitWatts": 400,
+	}, start.Add(30*time.Minute))
+	ledger.Observe(map[string]float64{
+		"cpu":                        25,
+		"proc.memory.rssMB":          50,
+		"gpu.0.gpu":                  0,
+		"gpu.0.powerWatts":           100,
+		"gpu.0.memoryAllocatedBytes": 2e9,
+		"network.sent":               3000,
+	}, start.Add(90*time.Minute))
+
+	usage := ledger.Usage()
+	// 4 cores for half an hour, then 2 cores for an hour
+	assert.InDelta(t, 4, usage.CPUCoreHours, 1e-9)
+	assert.Equal(t, 100.0, usage.PeakMemoryMB)
+	assert.InDelta(t, 1.5, usage.GPUHours["0"], 1e-9)
+	assert.InDelta(t, 0.5, usage.GPUHours["1"], 1e-9)
+	assert.InDelta(t, 0.5, usage.GPUUtilizedHours["0"], 1e-9)
+	assert.InDelta(t, 0.25, usage.GPUUtilizedHours["1"], 1e-9)
+	assert.InDelta(t, 250, usage.EnergyWh["gpu.0"], 1e-9)
+	assert.InDelta(t, 250, usage.TotalEnergyWh(), 1e-9)
+	assert.Equal(t, 2e9, usage.PeakGPUMemoryBytes["0"])
+	assert.Equal(t, 3000.0, usage.NetworkSentBytes)
+	assert.Equal(t, 2000.0, usage.NetworkRecvBytes)
+	assert.Len(t, usage.GPUHours, 2)
+
+	// the time while stopped is not counted
+	ledger.Start(start.Add(10 * time.Hour))
+	ledger.Observe(map[string]float64{"gpu.0.gpu": 100}, start.Add(11*time.Hour))
+	assert.InDelta(t, 2.5, ledger.Usage().GPUHours["0"], 1e-9)
+}
+
+func TestPriceTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"currency": "USD", "gpu_hour": 2, "cpu_core_hour": 0.5, "kwh": 0.1, "network_gb": 0.05}`,
+	), 0644))
+	prices, err := monitor.LoadPriceTable(path)
+	require.NoError(t, err)
+
+	estimate := prices.Estimate(&monitor.ResourceUsage{
+		GPUHours:         map[string]float64{"0": 1.5, "1": 0.5},
+		CPUCoreHours:     4,
+		EnergyWh:         map[string]float64{"gpu.0": 100, "system": 2000},
+		NetworkSentBytes: 20e9,
+	})
+	assert.Equal(t, "USD", estimate.Currency)
+	assert.InDelta(t, 4, estimate.GPU, 1e-9)
+	assert.InDelta(t, 2, estimate.CPU, 1e-9)
+	// the power of the system includes that of the GPUs
+	assert.InDelta(t, 0.2, estimate.Energy, 1e-9)
+	assert.InDelta(t, 1, estimate.Network, 1e-9)
+	assert.InDelta(t, 7.2, estimate.Total, 1e-9)
+
+	_, err = monitor.LoadPriceTable(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestSystemMonitorUsage(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	asset := &fakeAsset{
+		clock:    clock,
+		interval: 20 * time.Minute,
+		samples: []map[string]float64{
+			{"gpu.0.gpu": 100, "gpu.0.powerWatts": 300},
+			{"gpu.0.gpu": 50, "gpu.0.powerWatts": 150},
+			{"gpu.0.gpu": 0, "gpu.0.powerWatts": 60},
+		},
+	}
+	outChan := make(chan *service.Record, 10)
+	sm := monitor.NewSystemMonitor(
+		&service.Settings{
+			XStatsSampleRateSeconds: wrapperspb.Double(0.001),
+			XStatsSamplesToAverage:  wrapperspb.Int32(1),
+		},
+		observability.NewNoOpLogger(),
+		outChan,
+		monitor.WithAssets(asset),
+		monitor.WithResourceLedger(monitor.NewResourceLedger(monitor.WithResourceLedgerCPUCount(1))),
+		monitor.WithClock(clock.Now),
+	)
+
+	sm.Do()
+	for i := 0; i < 3; i++ {
+		<-outChan
+	}
+	sm.Stop()
+
+	usage := sm.Usage()
+	assert.InDelta(t, 1, usage.GPUHours["0"], 1e-9)
+	assert.InDelta(t, 0.5, usage.GPUUtilizedHours["0"], 1e-9)
+	assert.InDelta(t, 170, usage.EnergyWh["gpu.0"], 1e-9)
+}
+
+func TestSystemMonitorUsageDisabled(t *testing.T) {
+	sm := monitor.NewSystemMonitor(
+		&service.Settings{XDisableStats: wrapperspb.Bool(true)},
+		observability.NewNoOpLogger(),
+		make(chan *service.Record, 1),
+	)
+	assert.Nil(t, sm.Usage())
+}
@@ -140,6 +140,36 @@ type SystemMonitor struct {
 
 	// logger is the logger for the system monitor
 	logger *observability.CoreLogger
+
+	// ledger integrates the sampled metrics into the resources used
+	ledger *ResourceLedger
+
+	// now is the clock of the ledger
+	now func() time.Time
+}
+
+type SystemMonitorOption func(*SystemMonitor)
+
+// WithAssets sets the assets to monitor instead of the available ones, used
+// in tests.
+func WithAssets(assets ...Asset) SystemMonitorOption {
+	return func(sm *SystemMonitor) {
+		sm.assets = assets
+	}
+}
+
+// WithResourceLedger sets the ledger of the resources used.
+func WithResourceLedger(ledger *ResourceLedger) SystemMonitorOption {
+	return func(sm *SystemMonitor) {
+		sm.ledger = ledger
+	}
+}
+
+// WithClock sets the clock of the resource ledger, used in tests.
+func WithClock(now func() time.Time) SystemMonitorOption {
+	return func(sm *SystemMonitor) {
+		sm.now = now
+	}
 }
 
 // NewSystemMonitor creates a new SystemMonitor with the given settings
@@ -147,6 +177,7 @@ func NewSystemMonitor(
 	settings *service.Settings,
 	logger *observability.CoreLogger,
 	outChan chan *service.Record,
+	opts ...SystemMonitorOption,
 ) *SystemMonitor {
 	sbs := settings.XStatsBufferSize.GetValue()
 	var buffer *Buffer
@@ -163,10 +194,23 @@ func NewSystemMonitor(
 		logger:   logger,
 		outChan:  outChan,
 		buffer:   buffer,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(systemMonitor)
 	}
 
 	// if stats are disabled, return early
 	if settings.XDisableStats.GetValue() {
+		systemMonitor.assets = nil
+		systemMonitor.ledger = nil
+		return systemMonitor
+	}
+
+	if systemMonitor.ledger == nil {
+		systemMonitor.ledger = NewResourceLedger()
+	}
+	if systemMonitor.assets != nil {
 		return systemMonitor
 	}
 
@@ -197,6 +241,8 @@ func (sm *SystemMonitor) Do() {
 	// reset context:
 	sm.ctx, sm.cancel = context.WithCancel(context.Background())
 
+	sm.ledger.Start(sm.now())
+
 	sm.logger.Info("Starting system monitor")
 	// start monitoring the assets
 	for _, asset := range sm.assets {
@@ -272,10 +318,20 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 	tickChan := make(chan time.Time, 1)
 	tickChan <- time.Now()
 
-	// Forward signals from the ticker to tickChan
+	// Forward signals from the ticker to tickChan, until monitoring stops
+	ctx := sm.ctx
 	go func() {
-		for t := range ticker.C {
-			tickChan <- t
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case t := <-ticker.C:
+				select {
+				case tickChan <- t:
+				case <-ctx.Done():
+					return
+				}
+			}
 		}
 	}()
 
@@ -292,6 +348,7 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 				aggregatedMetrics := asset.AggregateMetrics()
 				if len(aggregatedMetrics) > 0 {
 					ts := timestamppb.Now()
+					sm.ledger.Observe(aggregatedMetrics, sm.now())
 					// store in buffer
 					for k, v := range aggregatedMetrics {
 						if sm.buffer != nil {
@@ -328,6 +385,15 @@ func (sm *SystemMonitor) GetBuffer() map[string]List {
 	return sm.buffer.elements
 }
 
+// Usage returns the resources used by the run while it was monitored, or nil
+// if stats are disabled.
+func (sm *SystemMonitor) Usage() *ResourceUsage {
+	if sm == nil {
+		return nil
+	}
+	return sm.ledger.Usage()
+}
+
 func (sm *SystemMonitor) Stop() {
 	if sm == nil || sm.cancel == nil {
 		return
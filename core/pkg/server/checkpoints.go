@@ -0,0 +1,374 @@
+package server
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// CheckpointsDirName is the directory of the sync directory the
+	// checkpoints of a run are copied to until they are uploaded
+	CheckpointsDirName = "checkpoints"
+	// CheckpointArtifactType is the type of the checkpoint artifacts
+	CheckpointArtifactType = "model"
+
+	// checkpointQueueSize bounds the checkpoints waiting to be copied
+	checkpointQueueSize = 64
+)
+
+// checkpoint is a checkpoint retained by the policy of its artifact
+type checkpoint struct {
+	step    int64
+	metrics map[string]float64
+	// dir is the copy of the checkpoint
+	dir string
+}
+
+// checkpointSeries are the retained checkpoints of an artifact, by step
+type checkpointSeries struct {
+	policy      *service.CheckpointPolicy
+	checkpoints []*checkpoint
+	// sequenceClientID is the client ID of the sequence of the versions
+	sequenceClientID string
+}
+
+// better returns whether a is a better checkpoint than b by the metric of
+// the policy. Checkpoints without the metric are the worst, and later ones
+// win ties.
+func (s *checkpointSeries) better(a, b *checkpoint) bool {
+	metric := s.policy.GetMetric()
+	va, oka := a.metrics[metric]
+	vb, okb := b.metrics[metric]
+	switch {
+	case oka != okb:
+		return oka
+	case !oka || va == vb:
+		return a.step > b.step
+	case s.policy.GetMinimize():
+		return va < vb
+	default:
+		return va > vb
+	}
+}
+
+// retain applies the policy to checkpoints, ordered by step, and returns
+// those it retains and those it evicts
+func (s *checkpointSeries) retain(checkpoints []*checkpoint) (retained, evicted []*checkpoint) {
+	keepLast := int(s.policy.GetKeepLast())
+	keepBest := int(s.policy.GetKeepBest())
+	if keepLast <= 0 && keepBest <= 0 {
+		return checkpoints, nil
+	}
+
+	kept := make(map[*checkpoint]bool)
+	for i := max(len(checkpoints)-keepLast, 0); keepLast > 0 && i < len(checkpoints); i++ {
+		kept[checkpoints[i]] = true
+	}
+	best := append([]*checkpoint(nil), checkpoints...)
+	sort.SliceStable(best, func(i, j int) bool { return s.better(best[i], best[j]) })
+	for _, c := range best[:min(keepBest, len(best))] {
+		kept[c] = true
+	}
+
+	for _, c := range checkpoints {
+		if kept[c] {
+			retained = append(retained, c)
+		} else {
+			evicted = append(evicted, c)
+		}
+	}
+	return retained, evicted
+}
+
+// aliases returns the aliases of the version of a retained checkpoint: its
+// step, "latest" if it is the last one, and "best" if the policy keeps the
+// best checkpoints and it is the best one
+func (s *checkpointSeries) aliases(c *checkpoint) []string {
+	aliases := []string{fmt.Sprintf("step-%d", c.step)}
+	if s.policy.GetKeepBest() > 0 {
+		best := c
+		for _, other := range s.checkpoints {
+			if s.better(other, best) {
+				best = other
+			}
+		}
+		if best == c {
+			aliases = append(aliases, "best")
+		}
+	}
+	if s.checkpoints[len(s.checkpoints)-1] == c {
+		aliases = append(aliases, "latest")
+	}
+	return aliases
+}
+
+// Checkpoints tracks the model checkpoints saved during a run, and applies
+// the retention policy of each checkpoint artifact.
+//
+// A checkpoint the policy retains is copied when it is added, since
+// checkpoints are usually saved to the same path at every step, and its copy
+// is uploaded as a version of the artifact right away, aliased with its step.
+// The copies stay in the sync directory with the rest of the run, so that an
+// offline run can be synced.
+//
+// A checkpoint no longer retained is never retained again, since later
+// checkpoints only add to the last and to the best ones. Its version stays on
+// the server, and the "latest" and "best" aliases move to the versions that
+// replace it. A new policy only applies to the retained checkpoints.
+type Checkpoints struct {
+	logger *observability.CoreLogger
+	dir    string
+	series map[string]*checkpointSeries
+
+	// mu guards the queue of the checkpoints to add and whether it is closed
+	mu     sync.Mutex
+	queue  chan func()
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewCheckpoints(logger *observability.CoreLogger, settings *service.Settings) *Checkpoints {
+	return &Checkpoints{
+		logger: logger,
+		dir:    filepath.Join(settings.GetSyncDir().GetValue(), CheckpointsDirName),
+		series: make(map[string]*checkpointSeries),
+	}
+}
+
+// Queue adds a checkpoint as Add does, on a goroutine of its own, since a
+// large checkpoint takes a while to copy. The checkpoints are added one at a
+// time in the order they are queued, and done is called with the result of
+// each. A checkpoint queued after Close is refused.
+func (c *Checkpoints) Queue(
+	name string,
+	step int64,
+	request *service.CheckpointRequest,
+	done func(*service.CheckpointResponse, *service.ArtifactRecord),
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		done(&service.CheckpointResponse{
+			Error: &service.ErrorInfo{
+				Code:    service.ErrorInfo_USAGE,
+				Message: "checkpoints: the run has exited",
+			},
+		}, nil)
+		return
+	}
+	if c.queue == nil {
+		c.queue = make(chan func(), checkpointQueueSize)
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			for add := range c.queue {
+				add()
+			}
+		}()
+	}
+	c.queue <- func() {
+		done(c.Add(name, step, request))
+	}
+}
+
+// Close waits for the queued checkpoints to be added. It is safe to call
+// more than once.
+func (c *Checkpoints) Close() {
+	c.mu.Lock()
+	if !c.closed && c.queue != nil {
+		close(c.queue)
+	}
+	c.closed = true
+	c.mu.Unlock()
+	c.wg.Wait()
+}
+
+// Add adds the checkpoint of a request saved at the given step to the
+// artifact with the given name, and applies the policy of the artifact. If
+// the policy retains the checkpoint, it is copied, and the artifact of its
+// version is returned to be uploaded.
+//
+// Add is not safe for concurrent use, nor while checkpoints are queued.
+func (c *Checkpoints) Add(
+	name string,
+	step int64,
+	request *service.CheckpointRequest,
+) (*service.CheckpointResponse, *service.ArtifactRecord) {
+	if err := c.validate(name, request); err != nil {
+		return &service.CheckpointResponse{
+			Error: &service.ErrorInfo{Code: service.ErrorInfo_USAGE, Message: err.Error()},
+		}, nil
+	}
+
+	series, ok := c.series[name]
+	if !ok {
+		series = &checkpointSeries{sequenceClientID: shared.ShortID(32)}
+	}
+	policy := series.policy
+	if request.GetPolicy() != nil {
+		policy = request.GetPolicy()
+	}
+
+	dir := filepath.Join(c.dir, name, fmt.Sprintf("step-%d", step))
+	added := &checkpoint{step: step, metrics: request.GetMetrics(), dir: dir}
+	// a checkpoint saved again at the same step replaces the first one
+	var checkpoints []*checkpoint
+	for _, other := range series.checkpoints {
+		if other.step != step {
+			checkpoints = append(checkpoints, other)
+		}
+	}
+	checkpoints = append(checkpoints, added)
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		return checkpoints[i].step < checkpoints[j].step
+	})
+	retained, evicted := (&checkpointSeries{policy: policy}).retain(checkpoints)
+
+	response := &service.CheckpointResponse{Retained: true}
+	for _, checkpoint := range evicted {
+		if checkpoint == added {
+			response.Retained = false
+		} else {
+			response.EvictedSteps = append(response.EvictedSteps, checkpoint.step)
+		}
+	}
+	if !response.Retained {
+		// the copy is only made for a checkpoint that is uploaded
+		series.policy = policy
+		series.checkpoints = retained
+		c.series[name] = series
+		return response, nil
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		c.logger.CaptureError("checkpoints: error removing old copy", err, "dir", dir)
+	}
+	if err := copyCheckpoint(request.GetPath(), dir); err != nil {
+		c.logger.CaptureError("checkpoints: error copying checkpoint", err, "path", request.GetPath())
+		return &service.CheckpointResponse{
+			Error: &service.ErrorInfo{Code: service.ErrorInfo_UNKNOWN, Message: err.Error()},
+		}, nil
+	}
+	series.policy = policy
+	series.checkpoints = retained
+	c.series[name] = series
+
+	artifact, err := c.artifact(name, added, series.aliases(added), series.sequenceClientID)
+	if err != nil {
+		c.logger.CaptureError("checkpoints: error creating artifact", err, "name", name, "step", step)
+		return &service.CheckpointResponse{
+			Error: &service.ErrorInfo{Code: service.ErrorInfo_UNKNOWN, Message: err.Error()},
+		}, nil
+	}
+	return response, artifact
+}
+
+func (c *Checkpoints) validate(name string, request *service.CheckpointRequest) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\:`) {
+		return fmt.Errorf("checkpoints: invalid artifact name %q", name)
+	}
+	if _, err := os.Stat(request.GetPath()); err != nil {
+		return fmt.Errorf("checkpoints: %w", err)
+	}
+
+	policy := request.GetPolicy()
+	if policy == nil {
+		if series, ok := c.series[name]; ok {
+			policy = series.policy
+		}
+	}
+	if policy.GetKeepBest() <= 0 {
+		return nil
+	}
+	if policy.GetMetric() == "" {
+		return fmt.Errorf("checkpoints: keep_best needs a metric")
+	}
+	if _, ok := request.GetMetrics()[policy.GetMetric()]; !ok {
+		return fmt.Errorf("checkpoints: no value for the metric %q", policy.GetMetric())
+	}
+	return nil
+}
+
+func (c *Checkpoints) artifact(
+	name string,
+	checkpoint *checkpoint,
+	aliases []string,
+	sequenceClientID string,
+) (*service.ArtifactRecord, error) {
+	metadata, err := json.Marshal(map[string]interface{}{
+		"step":    checkpoint.step,
+		"metrics": checkpoint.metrics,
+	})
+	if err != nil {
+		return nil, err
+	}
+	builder := artifacts.NewArtifactBuilder(&service.ArtifactRecord{
+		Type:             CheckpointArtifactType,
+		Name:             name,
+		Metadata:         string(metadata),
+		Aliases:          aliases,
+		Finalize:         true,
+		ClientId:         shared.ShortID(32),
+		SequenceClientId: sequenceClientID,
+	})
+	err = filepath.WalkDir(checkpoint.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(checkpoint.dir, path)
+		if err != nil {
+			return err
+		}
+		return builder.AddFile(filepath.ToSlash(rel), path)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return builder.GetArtifact(), nil
+}
+
+// copyCheckpoint copies a checkpoint file into dir, or the regular files of
+// a checkpoint directory
+func copyCheckpoint(path string, dir string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		return copyFile(path, filepath.Join(dir, filepath.Base(path)))
+	}
+
+	return filepath.WalkDir(path, func(src string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(path, src)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, rel)
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(dst, 0755)
+		case d.Type().IsRegular():
+			return copyFile(src, dst)
+		default:
+			return nil
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writeCheckpoint writes a checkpoint file, as training code does at every
+// step
+func writeCheckpoint(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func makeCheckpoints(t *testing.T) (*server.Checkpoints, string) {
+	syncDir := t.TempDir()
+	checkpoints := server.NewCheckpoints(
+		observability.NewNoOpLogger(),
+		&service.Settings{SyncDir: wrapperspb.String(syncDir)},
+	)
+	return checkpoints, syncDir
+}
+
+func addCheckpoint(
+	checkpoints *server.Checkpoints,
+	path string,
+	step int64,
+	loss float64,
+	policy *service.CheckpointPolicy,
+) (*service.CheckpointResponse, *service.ArtifactRecord) {
+	return checkpoints.Add("model", step, &service.CheckpointRequest{
+		Path:    path,
+		Metrics: map[string]float64{"loss": loss},
+		Policy:  policy,
+	})
+}
+
+func artifactStep(t *testing.T, artifact *service.ArtifactRecord) int64 {
+	var metadata struct {
+		Step int64 `json:"step"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(artifact.GetMetadata()), &metadata))
+	return metadata.Step
+}
+
+func TestCheckpointsKeepLast(t *testing.T) {
+	checkpoints, syncDir := makeCheckpoints(t)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	policy := &service.CheckpointPolicy{KeepLast: 2}
+
+	// each retained checkpoint is uploaded as it is added
+	var artifacts []*service.ArtifactRecord
+	for step := int64(0); step < 4; step++ {
+		writeCheckpoint(t, path, "weights")
+		response, artifact := addCheckpoint(checkpoints, path, step, 1, policy)
+		assert.Nil(t, response.GetError())
+		assert.True(t, response.GetRetained())
+		if step >= 2 {
+			assert.Equal(t, []int64{step - 2}, response.GetEvictedSteps())
+		}
+		require.NotNil(t, artifact)
+		assert.Equal(t, step, artifactStep(t, artifact))
+		assert.Equal(t, []string{fmt.Sprintf("step-%d", step), "latest"}, artifact.GetAliases())
+		artifacts = append(artifacts, artifact)
+	}
+	assert.DirExists(t, filepath.Join(syncDir, server.CheckpointsDirName, "model", "step-3"))
+
+	assert.Equal(t, server.CheckpointArtifactType, artifacts[0].GetType())
+	assert.Equal(t, artifacts[0].GetSequenceClientId(), artifacts[3].GetSequenceClientId())
+	assert.NotEqual(t, artifacts[0].GetClientId(), artifacts[3].GetClientId())
+	require.Len(t, artifacts[3].GetManifest().GetContents(), 1)
+	entry := artifacts[3].GetManifest().GetContents()[0]
+	assert.Equal(t, "model.pt", entry.GetPath())
+	// the saver computes the digests, with the digest cache
+	assert.Empty(t, entry.GetDigest())
+	assert.Empty(t, artifacts[3].GetDigest())
+}
+
+func TestCheckpointsKeepBest(t *testing.T) {
+	checkpoints, _ := makeCheckpoints(t)
+	dir := filepath.Join(t.TempDir(), "ckpt")
+	policy := &service.CheckpointPolicy{KeepLast: 1, KeepBest: 2, Metric: "loss", Minimize: true}
+
+	var responses []*service.CheckpointResponse
+	var artifacts []*service.ArtifactRecord
+	for step, loss := range []float64{0.9, 0.3, 0.5, 0.4, 0.8} {
+		writeCheckpoint(t, filepath.Join(dir, "weights.bin"), "weights")
+		writeCheckpoint(t, filepath.Join(dir, "optimizer", "state.bin"), "state")
+		response, artifact := addCheckpoint(checkpoints, dir, int64(step), loss, policy)
+		responses = append(responses, response)
+		artifacts = append(artifacts, artifact)
+	}
+	assert.Equal(t, []int64{0}, responses[2].GetEvictedSteps())
+	assert.Equal(t, []int64{2}, responses[3].GetEvictedSteps())
+	// the last checkpoint is retained, though it is not among the best
+	assert.True(t, responses[4].GetRetained())
+
+	assert.Equal(t, []string{"step-0", "best", "latest"}, artifacts[0].GetAliases())
+	assert.Equal(t, []string{"step-1", "best", "latest"}, artifacts[1].GetAliases())
+	assert.Equal(t, []string{"step-2", "latest"}, artifacts[2].GetAliases())
+	assert.Equal(t, []string{"step-4", "latest"}, artifacts[4].GetAliases())
+
+	var paths []string
+	for _, entry := range artifacts[1].GetManifest().GetContents() {
+		paths = append(paths, entry.GetPath())
+	}
+	assert.ElementsMatch(t, []string{"weights.bin", "optimizer/state.bin"}, paths)
+}
+
+func TestCheckpointsNotRetained(t *testing.T) {
+	checkpoints, syncDir := makeCheckpoints(t)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	writeCheckpoint(t, path, "weights")
+	policy := &service.CheckpointPolicy{KeepBest: 1, Metric: "accuracy"}
+
+	response, artifact := checkpoints.Add("model", 0, &service.CheckpointRequest{
+		Path:    path,
+		Metrics: map[string]float64{"accuracy": 0.9},
+		Policy:  policy,
+	})
+	assert.True(t, response.GetRetained())
+	assert.NotNil(t, artifact)
+	response, artifact = checkpoints.Add("model", 1, &service.CheckpointRequest{
+		Path:    path,
+		Metrics: map[string]float64{"accuracy": 0.7},
+	})
+	assert.False(t, response.GetRetained())
+	assert.Empty(t, response.GetEvictedSteps())
+	assert.Nil(t, artifact)
+	// a checkpoint that is not uploaded is not copied
+	assert.NoDirExists(t, filepath.Join(syncDir, server.CheckpointsDirName, "model", "step-1"))
+}
+
+func TestCheckpointsErrors(t *testing.T) {
+	checkpoints, _ := makeCheckpoints(t)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	writeCheckpoint(t, path, "weights")
+
+	response, _ := addCheckpoint(checkpoints, filepath.Join(t.TempDir(), "missing.pt"), 0, 1, nil)
+	assert.Equal(t, service.ErrorInfo_USAGE, response.GetError().GetCode())
+
+	response, _ = checkpoints.Add("../model", 0, &service.CheckpointRequest{Path: path})
+	assert.Equal(t, service.ErrorInfo_USAGE, response.GetError().GetCode())
+
+	response, artifact := checkpoints.Add("model", 0, &service.CheckpointRequest{
+		Path:   path,
+		Policy: &service.CheckpointPolicy{KeepBest: 1, Metric: "loss"},
+	})
+	assert.Contains(t, response.GetError().GetMessage(), `no value for the metric "loss"`)
+	assert.Nil(t, artifact)
+}
+
+func TestHandleCheckpoint(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{
+			RunId:   wrapperspb.String("run1"),
+			SyncDir: wrapperspb.String(t.TempDir()),
+			XSync:   wrapperspb.Bool(true),
+		}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+	)
+	go h.Do(inChan)
+	defer close(inChan)
+
+	path := filepath.Join(t.TempDir(), "model.pt")
+	writeCheckpoint(t, path, "weights")
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"loss": "0.5"}, step: 7})
+	inChan <- makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Checkpoint{
+			Checkpoint: &service.CheckpointRequest{Path: path},
+		},
+	})
+	response := (<-outChan).GetResponse().GetCheckpointResponse()
+	assert.True(t, response.GetRetained())
+
+	// the version is uploaded before the client is answered
+	artifact := (<-fwdChan).GetArtifact()
+	assert.Equal(t, "checkpoint-run1", artifact.GetName())
+	assert.Equal(t, []string{"step-7", "latest"}, artifact.GetAliases())
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+	}
+	assert.NotNil(t, (<-fwdChan).GetExit())
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	ConfigTimelineFileName = "wandb-config-timeline.jsonl"
+	// configTimelineHistoryPrefix prefixes the history keys of changed config values
+	configTimelineHistoryPrefix = "config/"
+)
+
+// ConfigChange is a change of a config value during a run. New is null if
+// the key was removed.
+type ConfigChange struct {
+	Key       string          `json:"key"`
+	Old       json.RawMessage `json:"old"`
+	New       json.RawMessage `json:"new"`
+	Step      int64           `json:"step"`
+	Timestamp float64         `json:"timestamp"`
+}
+
+type ConfigTimelineOption func(*ConfigTimeline)
+
+// WithConfigTimelineClock sets the clock of the timeline, used in tests.
+func WithConfigTimelineClock(now func() time.Time) ConfigTimelineOption {
+	return func(ct *ConfigTimeline) {
+		ct.now = now
+	}
+}
+
+// ConfigTimeline keeps the changes of the config values during a run in the
+// wandb-config-timeline.jsonl run file, one change per line.
+//
+// Setting a key for the first time is not a change. If the
+// _config_timeline_history setting is set, new values are also logged in the
+// next history row, under the key prefixed with "config/", so that charts can
+// mark when they changed.
+//
+// The timeline of a resumed run is seeded with the config the run had, see
+// Resume. It is safe for concurrent use, since the sender seeds it.
+type ConfigTimeline struct {
+	mu sync.Mutex
+
+	logger  *observability.CoreLogger
+	path    string
+	now     func() time.Time
+	history bool
+
+	// values are the current values of the config keys, nested keys are
+	// joined with dots
+	values map[string]string
+
+	// pending are the history items of the changes not logged yet
+	pending map[string]*service.HistoryItem
+}
+
+func NewConfigTimeline(
+	logger *observability.CoreLogger,
+	settings *service.Settings,
+	opts ...ConfigTimelineOption,
+) *ConfigTimeline {
+	ct := &ConfigTimeline{
+		logger:  logger,
+		path:    filepath.Join(settings.GetFilesDir().GetValue(), ConfigTimelineFileName),
+		now:     time.Now,
+		history: settings.GetXConfigTimelineHistory().GetValue(),
+		values:  make(map[string]string),
+		pending: make(map[string]*service.HistoryItem),
+	}
+	for _, opt := range opts {
+		opt(ct)
+	}
+	return ct
+}
+
+// Update applies a config update made at the given step, and returns the
+// changes it made, which are appended to the timeline file.
+func (ct *ConfigTimeline) Update(config *service.ConfigRecord, step int64) []*ConfigChange {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	timestamp := float64(ct.now().UnixMicro()) / 1e6
+
+	var changes []*ConfigChange
+	for _, item := range config.GetUpdate() {
+		key := strings.Join(configItemKeys(item), ".")
+		old, ok := ct.values[key]
+		ct.values[key] = item.GetValueJson()
+		if !ok || strings.HasPrefix(key, "_") || sameJson(old, item.GetValueJson()) {
+			continue
+		}
+		changes = append(changes, &ConfigChange{
+			Key:       key,
+			Old:       json.RawMessage(old),
+			New:       json.RawMessage(item.GetValueJson()),
+			Step:      step,
+			Timestamp: timestamp,
+		})
+	}
+	for _, item := range config.GetRemove() {
+		key := strings.Join(configItemKeys(item), ".")
+		old, ok := ct.values[key]
+		if !ok {
+			continue
+		}
+		delete(ct.values, key)
+		changes = append(changes, &ConfigChange{
+			Key:       key,
+			Old:       json.RawMessage(old),
+			New:       json.RawMessage("null"),
+			Step:      step,
+			Timestamp: timestamp,
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	ct.record(changes)
+	return changes
+}
+
+// Resume seeds the timeline with the config of a resumed run, as it was
+// before the run was resumed. The keys that the run is resumed with at
+// another value are changes at the given step, which are returned and
+// appended to the timeline file. A nil timeline does nothing.
+func (ct *ConfigTimeline) Resume(config map[string]interface{}, step int64) []*ConfigChange {
+	if ct == nil {
+		return nil
+	}
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	timestamp := float64(ct.now().UnixMicro()) / 1e6
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []*ConfigChange
+	for _, key := range keys {
+		old, err := json.Marshal(config[key])
+		if err != nil {
+			ct.logger.CaptureError("config timeline: failed to encode resumed value", err)
+			continue
+		}
+		current, ok := ct.values[key]
+		if !ok {
+			ct.values[key] = string(old)
+			continue
+		}
+		if strings.HasPrefix(key, "_") || sameJson(string(old), current) {
+			continue
+		}
+		changes = append(changes, &ConfigChange{
+			Key:       key,
+			Old:       json.RawMessage(old),
+			New:       json.RawMessage(current),
+			Step:      step,
+			Timestamp: timestamp,
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	ct.record(changes)
+	return changes
+}
+
+// HistoryItems returns the history items of the changes since the last call.
+func (ct *ConfigTimeline) HistoryItems() []*service.HistoryItem {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if len(ct.pending) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ct.pending))
+	for key := range ct.pending {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]*service.HistoryItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, ct.pending[key])
+	}
+	clear(ct.pending)
+	return items
+}
+
+// record writes the changes and keeps their history items
+func (ct *ConfigTimeline) record(changes []*ConfigChange) {
+	ct.write(changes)
+	if ct.history {
+		for _, change := range changes {
+			key := configTimelineHistoryPrefix + change.Key
+			ct.pending[key] = &service.HistoryItem{Key: key, ValueJson: string(change.New)}
+		}
+	}
+}
+
+// write appends the changes to the timeline file; a resumed run continues
+// the existing timeline
+func (ct *ConfigTimeline) write(changes []*ConfigChange) {
+	var data []byte
+	for _, change := range changes {
+		line, err := json.Marshal(change)
+		if err != nil {
+			ct.logger.CaptureError("config timeline: failed to encode change", err)
+			continue
+		}
+		data = append(data, line...)
+		data = append(data, '\n')
+	}
+	f, err := os.OpenFile(ct.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		ct.logger.CaptureError("config timeline: failed to open file", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		ct.logger.CaptureError("config timeline: failed to write file", err)
+	}
+}
+
+// sameJson returns whether two JSON documents hold the same value
+func sameJson(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,119 @@
+package server_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func makeConfigTimeline(filesDir string, history bool) (*server.ConfigTimeline, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	timeline := server.NewConfigTimeline(
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			FilesDir:               &wrapperspb.StringValue{Value: filesDir},
+			XConfigTimelineHistory: &wrapperspb.BoolValue{Value: history},
+		},
+		server.WithConfigTimelineClock(clock.Now),
+	)
+	return timeline, clock
+}
+
+func makeConfigUpdate(key, valueJson string) *service.ConfigRecord {
+	return &service.ConfigRecord{
+		Update: []*service.ConfigItem{{Key: key, ValueJson: valueJson}},
+	}
+}
+
+func TestConfigTimelineRecordsChanges(t *testing.T) {
+	dir := t.TempDir()
+	timeline, clock := makeConfigTimeline(dir, false)
+
+	// initial values are not changes
+	assert.Empty(t, timeline.Update(makeConfigUpdate("lr", "0.1"), 0))
+	assert.Empty(t, timeline.Update(makeConfigUpdate("lr", "0.10"), 5))
+
+	clock.Advance(10 * time.Second)
+	changes := timeline.Update(makeConfigUpdate("lr", "0.01"), 100)
+	assert.Len(t, changes, 1)
+
+	clock.Advance(time.Second)
+	timeline.Update(&service.ConfigRecord{
+		Remove: []*service.ConfigItem{{Key: "lr"}},
+	}, 200)
+
+	lines := readLines(t, filepath.Join(dir, server.ConfigTimelineFileName))
+	assert.Equal(t, []string{
+		`{"key":"lr","old":0.10,"new":0.01,"step":100,"timestamp":1010}`,
+		`{"key":"lr","old":0.01,"new":null,"step":200,"timestamp":1011}`,
+	}, lines)
+
+	// no history items unless enabled
+	assert.Empty(t, timeline.HistoryItems())
+}
+
+func TestConfigTimelineHistoryItems(t *testing.T) {
+	timeline, _ := makeConfigTimeline(t.TempDir(), true)
+
+	timeline.Update(&service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "lr", ValueJson: "0.1"},
+			{NestedKey: []string{"data", "curriculum"}, ValueJson: `"easy"`},
+		},
+	}, 0)
+	timeline.Update(makeConfigUpdate("lr", "0.05"), 10)
+	timeline.Update(makeConfigUpdate("lr", "0.01"), 20)
+	timeline.Update(&service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{NestedKey: []string{"data", "curriculum"}, ValueJson: `"hard"`},
+		},
+	}, 20)
+
+	// the latest value of each changed key goes in the next row
+	assert.Equal(t, []*service.HistoryItem{
+		{Key: "config/data.curriculum", ValueJson: `"hard"`},
+		{Key: "config/lr", ValueJson: "0.01"},
+	}, timeline.HistoryItems())
+	assert.Empty(t, timeline.HistoryItems())
+}
+
+func TestConfigTimelineResume(t *testing.T) {
+	dir := t.TempDir()
+	timeline, _ := makeConfigTimeline(dir, true)
+
+	// the run is resumed with a new learning rate and a new key
+	timeline.Update(&service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "lr", ValueJson: "0.01"},
+			{Key: "epochs", ValueJson: "10"},
+			{Key: "layers", ValueJson: "4"},
+		},
+	}, 0)
+	changes := timeline.Resume(map[string]interface{}{
+		"lr":      0.1,
+		"epochs":  10,
+		"_wandb":  map[string]interface{}{"t": 1},
+		"dropout": 0.5,
+	}, 42)
+	assert.Len(t, changes, 1)
+
+	// resumed keys that the run did not set are changes later on
+	assert.Len(t, timeline.Update(makeConfigUpdate("dropout", "0.2"), 50), 1)
+
+	lines := readLines(t, filepath.Join(dir, server.ConfigTimelineFileName))
+	assert.Equal(t, []string{
+		`{"key":"lr","old":0.1,"new":0.01,"step":42,"timestamp":1000}`,
+		`{"key":"dropout","old":0.5,"new":0.2,"step":50,"timestamp":1000}`,
+	}, lines)
+	assert.Equal(t, []*service.HistoryItem{
+		{Key: "config/dropout", ValueJson: "0.2"},
+		{Key: "config/lr", ValueJson: "0.01"},
+	}, timeline.HistoryItems())
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ConfigTracker keeps the current value of each config key, so that changes
+// to it can be detected.
+//
+// As in the Python SDK, a config value may only change when allow_val_change
+// is set. Internal keys, starting with an underscore, may always change.
+// Clients that allow a change themselves, such as the Python SDK, set
+// allow_val_change on the config record of the change.
+//
+// It is safe for concurrent use, since the sender loads the config of a
+// resumed run into it.
+type ConfigTracker struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+func NewConfigTracker() *ConfigTracker {
+	return &ConfigTracker{values: make(map[string]interface{})}
+}
+
+// Load tracks the values of a whole config, such as the config of a resumed
+// run. Keys that are already tracked keep their value, which is newer.
+func (ct *ConfigTracker) Load(config map[string]interface{}) {
+	if ct == nil {
+		return
+	}
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	for key, value := range config {
+		if _, ok := ct.values[key]; !ok {
+			ct.values[key] = value
+		}
+	}
+}
+
+// Conflicts returns the sorted keys of the items that change a tracked value.
+// Nested keys are joined with dots.
+func (ct *ConfigTracker) Conflicts(items []*service.ConfigItem) []string {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	var conflicts []string
+	for _, item := range items {
+		keys := configItemKeys(item)
+		if strings.HasPrefix(keys[0], "_") {
+			continue
+		}
+		current, ok := ct.lookup(keys)
+		if !ok {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(current, value) {
+			conflicts = append(conflicts, strings.Join(keys, "."))
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+// Update tracks the values of the items, which replace the current values.
+func (ct *ConfigTracker) Update(items []*service.ConfigItem) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	for _, item := range items {
+		keys := configItemKeys(item)
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			continue
+		}
+		target := ct.values
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := target[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				target[key] = next
+			}
+			target = next
+		}
+		target[keys[len(keys)-1]] = value
+	}
+}
+
+// Remove stops tracking the keys of the items, which can then be set again.
+func (ct *ConfigTracker) Remove(items []*service.ConfigItem) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	for _, item := range items {
+		keys := configItemKeys(item)
+		target := ct.values
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := target[key].(map[string]interface{})
+			if !ok {
+				break
+			}
+			target = next
+		}
+		delete(target, keys[len(keys)-1])
+	}
+}
+
+// withoutConfigKeys returns the items whose dot-joined keys are not in keys
+func withoutConfigKeys(items []*service.ConfigItem, keys []string) []*service.ConfigItem {
+	var kept []*service.ConfigItem
+	for _, item := range items {
+		key := strings.Join(configItemKeys(item), ".")
+		if i := sort.SearchStrings(keys, key); i < len(keys) && keys[i] == key {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
+
+// lookup returns the tracked value at the given key path
+func (ct *ConfigTracker) lookup(keys []string) (interface{}, bool) {
+	var value interface{} = ct.values
+	for _, key := range keys {
+		node, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = node[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// configItemKeys returns the key path of a config item
+func configItemKeys(item *service.ConfigItem) []string {
+	if len(item.GetNestedKey()) > 0 {
+		return item.GetNestedKey()
+	}
+	return []string{item.GetKey()}
+}
@@ -0,0 +1,64 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestConfigTrackerConflicts(t *testing.T) {
+	tracker := server.NewConfigTracker()
+	tracker.Update([]*service.ConfigItem{
+		{Key: "lr", ValueJson: "0.1"},
+		{Key: "epochs", ValueJson: "10"},
+		{NestedKey: []string{"model", "layers"}, ValueJson: "3"},
+		{Key: "_wandb", ValueJson: `{"t": 1}`},
+	})
+
+	conflicts := tracker.Conflicts([]*service.ConfigItem{
+		// same values, in another encoding
+		{Key: "epochs", ValueJson: "10.0"},
+		// new keys
+		{Key: "batch_size", ValueJson: "32"},
+		// changed values
+		{Key: "lr", ValueJson: "0.2"},
+		{NestedKey: []string{"model", "layers"}, ValueJson: "4"},
+		// internal keys may change
+		{Key: "_wandb", ValueJson: `{"t": 2}`},
+	})
+	assert.Equal(t, []string{"lr", "model.layers"}, conflicts)
+}
+
+func TestConfigTrackerKeepsLatestValue(t *testing.T) {
+	tracker := server.NewConfigTracker()
+	tracker.Update([]*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}})
+	tracker.Update([]*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}})
+
+	assert.Empty(t, tracker.Conflicts([]*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}}))
+	assert.Equal(t, []string{"lr"}, tracker.Conflicts([]*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}}))
+
+	// a removed key can be set again
+	tracker.Remove([]*service.ConfigItem{{Key: "lr"}})
+	assert.Empty(t, tracker.Conflicts([]*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}}))
+}
+
+func TestConfigTrackerLoad(t *testing.T) {
+	tracker := server.NewConfigTracker()
+	tracker.Load(map[string]interface{}{
+		"lr":    0.1,
+		"model": map[string]interface{}{"name": "resnet"},
+	})
+
+	conflicts := tracker.Conflicts([]*service.ConfigItem{
+		{Key: "lr", ValueJson: "0.1"},
+		{NestedKey: []string{"model", "name"}, ValueJson: `"vit"`},
+	})
+	assert.Equal(t, []string{"model.name"}, conflicts)
+
+	// loaded values do not replace newer ones
+	tracker.Update([]*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}})
+	tracker.Load(map[string]interface{}{"lr": 0.1})
+	assert.Empty(t, tracker.Conflicts([]*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}}))
+}
@@ -8,14 +8,10 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
-	"net/url"
 	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 
-	"github.com/wandb/wandb/core/pkg/auth"
-	"google.golang.org/protobuf/types/known/wrapperspb"
-
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/proto"
 )
@@ -51,6 +47,10 @@ type Connection struct {
 	// stream is the stream for the connection, each connection has a single stream
 	// however, a stream can have multiple connections
 	stream *Stream
+
+	// initErr is the error that prevented the stream from starting, which
+	// is returned to the client in the responses to its records
+	initErr error
 }
 
 // NewConnection creates a new connection
@@ -233,27 +233,11 @@ func (nc *Connection) handleServerRequest() {
 // to the server, to start a new stream
 func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 	settings := msg.GetSettings()
-	func(s *service.Settings) {
-		if s.GetApiKey().GetValue() != "" {
-			return
-		}
-		if s.GetXOffline().GetValue() {
-			return
-		}
-		baseUrl := s.GetBaseUrl().GetValue()
-		u, err := url.Parse(baseUrl)
-		if err != nil {
-			slog.Error("error parsing url", "err", err, "url", baseUrl)
-			panic(err)
-		}
-		host := u.Hostname()
-		_, password, err := auth.GetNetrcLogin(host)
-		if err != nil {
-			slog.Error("error getting password from netrc", "err", err, "id", nc.id)
-			panic(err)
-		}
-		s.ApiKey = &wrapperspb.StringValue{Value: password}
-	}(settings)
+	if err := ResolveAPIKey(nc.ctx, settings); err != nil {
+		slog.Error("error getting the API key", "err", err, "id", nc.id)
+		nc.initErr = fmt.Errorf("failed to get the API key: %v", err)
+		return
+	}
 
 	streamId := msg.GetXInfo().GetStreamId()
 	slog.Info("connection init received", "streamId", streamId, "id", nc.id)
@@ -276,6 +260,10 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 func (nc *Connection) handleInformStart(msg *service.ServerInformStartRequest) {
 	// todo: if we keep this and end up updating the settings here
 	//       we should update the stream logger to use the new settings as well
+	if nc.stream == nil {
+		slog.Error("handleInformStart: stream not found", "id", nc.id)
+		return
+	}
 	nc.stream.settings = msg.GetSettings()
 	// update sentry tags
 	// add attrs from settings:
@@ -323,6 +311,7 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 	slog.Debug("handle record received", "streamId", streamId, "id", nc.id)
 	if nc.stream == nil {
 		slog.Error("handleInformRecord: stream not found", "streamId", streamId, "id", nc.id)
+		nc.respondInitError(msg)
 	} else {
 		// add connection id to control message
 		// so that the stream can send back a response
@@ -336,6 +325,38 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 	}
 }
 
+// respondInitError responds to a record that expects a result with the error
+// that prevented the stream from starting, so that the client does not wait
+// for a stream that does not exist; the result of a run record carries the
+// error itself
+func (nc *Connection) respondInitError(record *service.Record) {
+	if nc.initErr == nil {
+		return
+	}
+	if !record.GetControl().GetReqResp() && record.GetControl().GetMailboxSlot() == "" {
+		return
+	}
+	result := &service.Result{
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+	if _, ok := record.RecordType.(*service.Record_Run); ok {
+		result.ResultType = &service.Result_RunResult{
+			RunResult: &service.RunUpdateResult{
+				Error: &service.ErrorInfo{
+					Message: nc.initErr.Error(),
+					Code:    service.ErrorInfo_AUTHENTICATION,
+				},
+			},
+		}
+	}
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_ResultCommunicate{
+			ResultCommunicate: result,
+		},
+	})
+}
+
 // handleInformFinish is called when the client sends a finish message
 // this should happen when the client want to close a specific stream
 func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest) {
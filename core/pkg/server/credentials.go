@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/auth"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// CredentialHelperEnv names the credential helper when the settings do not
+const CredentialHelperEnv = "WANDB_CREDENTIAL_HELPER"
+
+// ResolveAPIKey sets the API key of online settings that have none, from the
+// credential helper if one is configured, or from the netrc file otherwise.
+func ResolveAPIKey(ctx context.Context, settings *service.Settings) error {
+	if settings.GetApiKey().GetValue() != "" || settings.GetXOffline().GetValue() {
+		return nil
+	}
+	u, err := url.Parse(settings.GetBaseUrl().GetValue())
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+
+	var key string
+	command := settings.GetCredentialHelper().GetValue()
+	if command == "" {
+		command = os.Getenv(CredentialHelperEnv)
+	}
+	if command != "" {
+		if timeout := settings.GetXCredentialHelperTimeoutSeconds().GetValue(); timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+			defer cancel()
+		}
+		key, err = auth.CredentialHelperFor(command).APIKey(ctx, host)
+	} else {
+		_, key, err = auth.GetNetrcLogin(host)
+	}
+	if err != nil {
+		return err
+	}
+	settings.ApiKey = wrapperspb.String(key)
+	return nil
+}
@@ -0,0 +1,88 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestResolveAPIKey(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper scripts are shell scripts")
+	}
+	helper := filepath.Join(t.TempDir(), "helper.sh")
+	require.NoError(t, os.WriteFile(helper, []byte("#!/bin/sh\nread line\necho \"key-${line#host=}\"\n"), 0755))
+	failing := filepath.Join(t.TempDir(), "failing.sh")
+	require.NoError(t, os.WriteFile(failing, []byte("#!/bin/sh\necho locked >&2\nexit 1\n"), 0755))
+
+	testCases := []struct {
+		name     string
+		settings *service.Settings
+		env      string
+		key      string
+		err      string
+	}{
+		{
+			name: "from the helper",
+			settings: &service.Settings{
+				BaseUrl:          wrapperspb.String("https://wandb.example.com"),
+				CredentialHelper: wrapperspb.String(helper),
+			},
+			key: "key-wandb.example.com",
+		},
+		{
+			name: "from the helper in the environment",
+			settings: &service.Settings{
+				BaseUrl: wrapperspb.String("https://api.wandb.ai"),
+			},
+			env: helper,
+			key: "key-api.wandb.ai",
+		},
+		{
+			name: "API key set",
+			settings: &service.Settings{
+				ApiKey:           wrapperspb.String("set"),
+				BaseUrl:          wrapperspb.String("https://api.wandb.ai"),
+				CredentialHelper: wrapperspb.String(helper),
+			},
+			key: "set",
+		},
+		{
+			name: "offline",
+			settings: &service.Settings{
+				XOffline:         wrapperspb.Bool(true),
+				CredentialHelper: wrapperspb.String(helper),
+			},
+			key: "",
+		},
+		{
+			name: "helper failure",
+			settings: &service.Settings{
+				BaseUrl:          wrapperspb.String("https://api.wandb.ai"),
+				CredentialHelper: wrapperspb.String(failing),
+			},
+			err: "locked",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(server.CredentialHelperEnv, tc.env)
+			err := server.ResolveAPIKey(context.Background(), tc.settings)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.key, tc.settings.GetApiKey().GetValue())
+		})
+	}
+}
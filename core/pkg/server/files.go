@@ -29,10 +29,9 @@ func NewFileHandler(logger *observability.CoreLogger, settings *service.Settings
 	}
 }
 
-// Start starts the file handler and the watcher
+// Start starts the file handler, the watcher starts with the first watched file
 func (fh *FileHandler) Start() {
 	fh.logger.Debug("starting file handler")
-	fh.watcher.Start()
 }
 
 // Close closes the file handler and the watcher
@@ -45,6 +44,10 @@ func (fh *FileHandler) Close() {
 }
 
 func (fh *FileHandler) filterFile(file *service.FilesItem) bool {
+	if isRunSnapshotFile(fh.settings.GetFilesDir().GetValue(), file.Path) {
+		fh.logger.Info("ignoring snapshot file", "path", file.Path)
+		return true
+	}
 	for _, pattern := range fh.settings.GetIgnoreGlobs().GetValue() {
 		if matches, err := filepath.Match(pattern, file.Path); err != nil {
 			fh.logger.CaptureError("error matching glob", err, "path", file.Path, "glob", pattern)
@@ -61,6 +64,9 @@ func (fh *FileHandler) filterFile(file *service.FilesItem) bool {
 // - NOW: upload immediately
 // - END: upload at the end of the run
 // - LIVE: upload immediately, on changes, and at the end of the run
+//
+// Symlinks and special files are checked when they are uploaded, according
+// to the file policy of the sender, see filetransfer.FilePolicy.
 func (fh *FileHandler) Handle(record *service.Record) *service.Record {
 	if fh.final == nil {
 		fh.final = &service.Record{
@@ -101,7 +107,7 @@ func (fh *FileHandler) Handle(record *service.Record) *service.Record {
 			}
 			newItem := proto.Clone(item).(*service.FilesItem)
 			newItem.Path = match
-			if !fh.filterFile(item) {
+			if !fh.filterFile(newItem) {
 				items = append(items, newItem)
 			}
 		}
@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/segmentio/encoding/json"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/ingest"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -22,6 +27,8 @@ const (
 	requirementsFileName      = "requirements.txt"
 	summaryDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	summaryDebouncerBurstSize = 1        // todo: audit burst size
+	// ResourceUsageSummaryKey is the summary key of the resources used by the run
+	ResourceUsageSummaryKey = "resource_usage"
 )
 
 type HandlerOption func(*Handler)
@@ -50,6 +57,12 @@ func WithHandlerSystemMonitor(monitor *monitor.SystemMonitor) HandlerOption {
 	}
 }
 
+func WithHandlerIngestListener(listener *ingest.Listener) HandlerOption {
+	return func(h *Handler) {
+		h.ingestListener = listener
+	}
+}
+
 func WithHandlerFileHandler(handler *FileHandler) HandlerOption {
 	return func(h *Handler) {
 		h.fileHandler = handler
@@ -62,6 +75,30 @@ func WithHandlerFileTransferHandler(handler *FileTransferHandler) HandlerOption
 	}
 }
 
+// WithHandlerFileTransferProgress sets the tracker of the progress of the
+// file transfers, shared with the sender.
+func WithHandlerFileTransferProgress(progress *filetransfer.ProgressTracker) HandlerOption {
+	return func(h *Handler) {
+		h.fileTransferProgress = progress
+	}
+}
+
+// WithHandlerConfigTimeline sets the timeline of the config changes, shared
+// with the sender, which seeds it when the run is resumed.
+func WithHandlerConfigTimeline(timeline *ConfigTimeline) HandlerOption {
+	return func(h *Handler) {
+		h.configTimeline = timeline
+	}
+}
+
+// WithHandlerConfigTracker sets the tracker of the config values, shared with
+// the sender, which loads the config of a resumed run into it.
+func WithHandlerConfigTracker(tracker *ConfigTracker) HandlerOption {
+	return func(h *Handler) {
+		h.configTracker = tracker
+	}
+}
+
 func WithHandlerMetricHandler(handler *MetricHandler) HandlerOption {
 	return func(h *Handler) {
 		h.metricHandler = handler
@@ -74,6 +111,12 @@ func WithHandlerSummaryHandler(handler *SummaryHandler) HandlerOption {
 	}
 }
 
+func WithHandlerRunSnapshot(snapshot *RunSnapshot) HandlerOption {
+	return func(h *Handler) {
+		h.runSnapshot = snapshot
+	}
+}
+
 // Handler is the handler for a stream it handles the incoming messages, processes them
 // and passes them to the writer
 type Handler struct {
@@ -115,11 +158,39 @@ type Handler struct {
 	// systemMonitor is the system monitor for the stream
 	systemMonitor *monitor.SystemMonitor
 
+	// ingestListener receives the metrics of the other processes of the
+	// job, nil if it is not enabled
+	ingestListener *ingest.Listener
+
 	// fileHandler is the file handler for the stream
 	fileHandler *FileHandler
 
 	// fileTransferHandler is the file transfer info for the stream
 	fileTransferHandler *FileTransferHandler
+
+	// fileTransferProgress tracks the progress of each file transfer
+	fileTransferProgress *filetransfer.ProgressTracker
+
+	// runSnapshot keeps the history and summary files of the run, if enabled
+	runSnapshot *RunSnapshot
+
+	// pauseSubsystems are the subsystems that stop while the run is paused
+	pauseSubsystems PauseSubsystems
+
+	// metadata is the last metadata written, kept to record the pauses of the run
+	metadata *service.MetadataRequest
+
+	// configTracker keeps the current config values, see allow_val_change
+	configTracker *ConfigTracker
+
+	// configTimeline keeps the changes of the config values
+	configTimeline *ConfigTimeline
+
+	// historyTypes checks that the history keys keep their type
+	historyTypes *HistoryTypes
+
+	// checkpoints tracks the model checkpoints of the run
+	checkpoints *Checkpoints
 }
 
 // NewHandler creates a new handler
@@ -129,12 +200,27 @@ func NewHandler(
 	opts ...HandlerOption,
 ) *Handler {
 	h := &Handler{
-		ctx:    ctx,
-		logger: logger,
+		ctx:           ctx,
+		logger:        logger,
+		configTracker: NewConfigTracker(),
 	}
 	for _, opt := range opts {
 		opt(h)
 	}
+	subsystems, err := ParsePauseSubsystems(h.settings.GetXPauseSubsystems().GetValue())
+	if err != nil {
+		logger.CaptureError("handler: invalid pause subsystems", err)
+	}
+	h.pauseSubsystems = subsystems
+	if h.configTimeline == nil {
+		h.configTimeline = NewConfigTimeline(logger, h.settings)
+	}
+	policy, err := ParseHistoryTypePolicy(h.settings.GetXHistoryTypePolicy().GetValue())
+	if err != nil {
+		logger.CaptureError("handler: invalid history type policy, warning", err)
+	}
+	h.historyTypes = NewHistoryTypes(policy)
+	h.checkpoints = NewCheckpoints(logger, h.settings)
 	return h
 }
 
@@ -150,6 +236,7 @@ func (h *Handler) Do(inChan <-chan *service.Record) {
 }
 
 func (h *Handler) Close() {
+	h.checkpoints.Close()
 	close(h.outChan)
 	close(h.fwdChan)
 	h.logger.Debug("handler: closed", "stream_id", h.settings.RunId)
@@ -198,6 +285,8 @@ func (h *Handler) handleRecord(record *service.Record) {
 	case *service.Record_Artifact:
 	case *service.Record_Config:
 		h.handleConfig(record)
+	case *service.Record_Event:
+		h.handleEvent(record, x.Event)
 	case *service.Record_Exit:
 		h.handleExit(record, x.Exit)
 	case *service.Record_Files:
@@ -254,6 +343,8 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleGetSummary(record, response)
 	case *service.Request_Keepalive:
 	case *service.Request_NetworkStatus:
+		h.handleNetworkStatus(record)
+		response = nil
 	case *service.Request_PartialHistory:
 		h.handlePartialHistory(record, x.PartialHistory)
 		return
@@ -272,6 +363,14 @@ func (h *Handler) handleRequest(record *service.Record) {
 		response = nil
 	case *service.Request_Shutdown:
 	case *service.Request_StopStatus:
+		h.handleStopStatus(record)
+		response = nil
+	case *service.Request_RunEdits:
+		h.handleRunEdits(record)
+		response = nil
+	case *service.Request_Checkpoint:
+		h.handleCheckpoint(record, x.Checkpoint)
+		response = nil
 	case *service.Request_LogArtifact:
 		h.handleLogArtifact(record)
 		response = nil
@@ -282,15 +381,17 @@ func (h *Handler) handleRequest(record *service.Record) {
 	case *service.Request_Attach:
 		h.handleAttach(record, response)
 	case *service.Request_Pause:
-		h.handlePause()
+		h.handlePause(record)
 	case *service.Request_Resume:
-		h.handleResume()
+		h.handleResume(record)
 	case *service.Request_Cancel:
 		h.handleCancel(record)
 	case *service.Request_GetSystemMetrics:
 		h.handleGetSystemMetrics(record, response)
 	case *service.Request_FileTransferInfo:
 		h.handleFileTransferInfo(record)
+	case *service.Request_FileTransferProgress:
+		h.handleFileTransferProgress(record, response)
 	case *service.Request_InternalMessages:
 	case *service.Request_Sync:
 		h.handleSync(record)
@@ -314,10 +415,13 @@ func (h *Handler) handleDefer(record *service.Record, request *service.DeferRequ
 	case service.DeferRequest_FLUSH_STATS:
 	case service.DeferRequest_FLUSH_PARTIAL_HISTORY:
 		h.activeHistory.Flush()
+		// the last row may log a key with another type
+		h.writeHistoryTypesReport()
 	case service.DeferRequest_FLUSH_TB:
 	case service.DeferRequest_FLUSH_SUM:
 		h.handleSummary(nil, &service.SummaryRecord{})
 		h.summaryHandler.Flush(h.sendSummary)
+		h.runSnapshot.Close(h.summaryHandler.consolidatedSummary)
 	case service.DeferRequest_FLUSH_DEBOUNCER:
 	case service.DeferRequest_FLUSH_OUTPUT:
 		h.flushOutput()
@@ -353,6 +457,31 @@ func (h *Handler) handleLogArtifact(record *service.Record) {
 	h.sendRecord(record)
 }
 
+// handleCheckpoint adds a checkpoint of the run, which is uploaded if its
+// policy retains it. The checkpoint is copied while the handler goes on with
+// the next records, and the client is answered once it has been copied.
+func (h *Handler) handleCheckpoint(record *service.Record, request *service.CheckpointRequest) {
+	name := request.GetName()
+	if name == "" {
+		name = "checkpoint-" + h.settings.GetRunId().GetValue()
+	}
+	step := h.currentStep()
+	if request.GetStep() != nil {
+		step = request.GetStep().GetNum()
+	}
+	h.checkpoints.Queue(name, step, request,
+		func(response *service.CheckpointResponse, artifact *service.ArtifactRecord) {
+			if artifact != nil {
+				h.sendRecord(&service.Record{
+					RecordType: &service.Record_Artifact{Artifact: artifact},
+				})
+			}
+			h.sendResponse(record, &service.Response{
+				ResponseType: &service.Response_CheckpointResponse{CheckpointResponse: response},
+			})
+		})
+}
+
 func (h *Handler) handleDownloadArtifact(record *service.Record) {
 	h.sendRecord(record)
 }
@@ -372,8 +501,9 @@ func (h *Handler) handlePollExit(record *service.Record) {
 							TotalBytes:    h.fileTransferHandler.GetTotalBytes(),
 							DedupedBytes:  h.fileTransferHandler.GetDedupedBytes(),
 						},
-						FileCounts: h.fileTransferHandler.GetFileCounts(),
-						Done:       h.fileTransferHandler.IsDone(),
+						FileCounts:           h.fileTransferHandler.GetFileCounts(),
+						Done:                 h.fileTransferHandler.IsDone(),
+						FileTransferProgress: h.fileTransferProgress.Progress(),
 					},
 				},
 			},
@@ -437,6 +567,32 @@ func (h *Handler) handleServerInfo(record *service.Record) {
 	)
 }
 
+func (h *Handler) handleNetworkStatus(record *service.Record) {
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
+func (h *Handler) handleStopStatus(record *service.Record) {
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
+// handleRunEdits forwards the request for the edits made to the run on the
+// server to the sender, which pulls them
+func (h *Handler) handleRunEdits(record *service.Record) {
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
 func (h *Handler) handleRunStart(record *service.Record, request *service.RunStartRequest) {
 	var ok bool
 	run := request.Run
@@ -459,6 +615,11 @@ func (h *Handler) handleRunStart(record *service.Record, request *service.RunSta
 		h.systemMonitor.Do()
 	}
 
+	if err := h.ingestListener.Start(); err != nil {
+		h.logger.CaptureError("handler: not ingesting metrics", err)
+		h.ingestListener = nil
+	}
+
 	// save code and patch
 	if h.settings.GetSaveCode().GetValue() {
 		h.handleCodeSave()
@@ -625,6 +786,7 @@ func (h *Handler) handleMetadata(request *service.MetadataRequest) {
 	if h.settings.GetXDisableMeta().GetValue() {
 		return
 	}
+	h.metadata = request
 
 	mo := protojson.MarshalOptions{
 		Indent: "  ",
@@ -672,14 +834,44 @@ func (h *Handler) handleCancel(record *service.Record) {
 	h.sendRecord(record)
 }
 
-func (h *Handler) handlePause() {
+// handlePause stops the run timer and the paused subsystems; the sender
+// pauses the network activity of the run
+func (h *Handler) handlePause(record *service.Record) {
+	if h.timer.IsPaused() {
+		return
+	}
 	h.timer.Pause()
-	h.systemMonitor.Stop()
+	if h.pauseSubsystems.Has(PauseSystemMonitor) {
+		h.systemMonitor.Stop()
+	}
+	// the client expects a single response, which is sent by the handler
+	h.sendRecord(&service.Record{RecordType: record.RecordType})
 }
 
-func (h *Handler) handleResume() {
+// handleResume restarts what handlePause stopped and records the pause in the
+// run metadata
+func (h *Handler) handleResume(record *service.Record) {
+	if !h.timer.IsPaused() {
+		return
+	}
 	h.timer.Resume()
-	h.systemMonitor.Do()
+	if h.pauseSubsystems.Has(PauseSystemMonitor) && !h.settings.GetXDisableStats().GetValue() {
+		h.systemMonitor.Do()
+	}
+	h.sendRecord(&service.Record{RecordType: record.RecordType})
+
+	if h.metadata == nil {
+		return
+	}
+	pauses := make([]*service.PauseInfo, 0, len(h.timer.Pauses()))
+	for _, pause := range h.timer.Pauses() {
+		pauses = append(pauses, &service.PauseInfo{
+			PausedAt: timestamppb.New(pause.Start),
+			Seconds:  pause.Duration.Seconds(),
+		})
+	}
+	h.metadata.Pauses = pauses
+	h.handleMetadata(h.metadata)
 }
 
 func (h *Handler) flushOutput() {
@@ -713,6 +905,8 @@ func (h *Handler) handlePreempting(record *service.Record) {
 }
 
 func (h *Handler) handleRun(record *service.Record) {
+	h.configTracker.Update(record.GetRun().GetConfig().GetUpdate())
+	h.configTimeline.Update(record.GetRun().GetConfig(), 0)
 	h.sendRecordWithControl(record,
 		func(control *service.Control) {
 			control.AlwaysSend = true
@@ -720,31 +914,133 @@ func (h *Handler) handleRun(record *service.Record) {
 	)
 }
 
+// handleConfig forwards config updates. Changes to config values are only
+// allowed with allow_val_change, set on the record by clients that check
+// them or in the settings. Otherwise the changed values are rejected if the
+// client waits for the result, and kept with a warning if it does not, since
+// it would never hear about the rejection.
 func (h *Handler) handleConfig(record *service.Record) {
-	h.sendRecord(record)
+	// a synced run already went through these checks
+	if h.settings.GetXSync().GetValue() {
+		h.sendRecord(record)
+		return
+	}
+
+	config := record.GetConfig()
+	allowValChange := config.GetAllowValChange() || h.settings.GetAllowValChange().GetValue()
+	var rejected *service.ErrorInfo
+	if conflicts := h.configTracker.Conflicts(config.GetUpdate()); len(conflicts) > 0 {
+		switch {
+		case allowValChange:
+			h.logger.Warn("handler: config values changed", "keys", conflicts)
+		case !expectsResult(record):
+			h.logger.Warn("handler: config values changed without allow_val_change", "keys", conflicts)
+		default:
+			err := fmt.Errorf(
+				"attempted to change value of config keys %s, use allow_val_change to allow it",
+				strings.Join(conflicts, ", "),
+			)
+			h.logger.Warn("handler: rejected config values", "error", err)
+			rejected = &service.ErrorInfo{
+				Message: err.Error(),
+				Code:    service.ErrorInfo_USAGE,
+			}
+			config.Update = withoutConfigKeys(config.GetUpdate(), conflicts)
+		}
+	}
+	h.configTracker.Remove(config.GetRemove())
+	h.configTracker.Update(config.GetUpdate())
+	if len(config.GetUpdate()) > 0 || len(config.GetRemove()) > 0 {
+		h.sendRecord(record)
+	}
+	h.respondConfig(record, rejected)
+
+	if changes := h.configTimeline.Update(config, h.currentStep()); len(changes) > 0 {
+		h.handleFiles(&service.Record{
+			RecordType: &service.Record_Files{
+				Files: &service.FilesRecord{
+					Files: []*service.FilesItem{
+						{Path: ConfigTimelineFileName, Policy: service.FilesItem_END},
+					},
+				},
+			},
+		})
+	}
+}
+
+// respondConfig responds to a config record, if a response was requested
+func (h *Handler) respondConfig(record *service.Record, err *service.ErrorInfo) {
+	if !expectsResult(record) {
+		return
+	}
+	h.outChan <- &service.Result{
+		ResultType: &service.Result_ConfigResult{
+			ConfigResult: &service.ConfigResult{Error: err},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+}
+
+// expectsResult returns whether the client waits for the result of a record
+func expectsResult(record *service.Record) bool {
+	return record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != ""
 }
 
 func (h *Handler) handleAlert(record *service.Record) {
 	h.sendRecord(record)
 }
 
+// handleEvent sets the step and the time of an event that does not have them
+// to the current ones
+func (h *Handler) handleEvent(record *service.Record, event *service.EventRecord) {
+	if event.Step == nil {
+		event.Step = &service.HistoryStep{Num: h.currentStep()}
+	}
+	if event.Timestamp == nil {
+		event.Timestamp = timestamppb.Now()
+	}
+	h.sendRecord(record)
+}
+
+// currentStep returns the step of the history row being logged
+func (h *Handler) currentStep() int64 {
+	if h.activeHistory == nil {
+		return 0
+	}
+	return h.activeHistory.GetStep().GetNum()
+}
+
 func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord) {
 	// stop the system monitor to ensure that we don't send any more system metrics
 	// after the run has exited
 	h.systemMonitor.Stop()
 
+	// the last ingested metrics are handled here, since the listener sends
+	// to the loopback channel, which this handler reads
+	for _, ingested := range h.ingestListener.Stop() {
+		h.handleRecord(ingested)
+	}
+
 	// stop the run timer and set the runtime
 	h.timer.Pause()
 	runtime := int32(h.timer.Elapsed().Seconds())
 	exit.Runtime = runtime
 
+	// the artifacts of the queued checkpoints are sent before the exit record
+	h.checkpoints.Close()
+
 	// update summary with runtime
 	if !h.settings.GetXSync().GetValue() {
-		summaryRecord := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, []*service.SummaryItem{
+		items := []*service.SummaryItem{
 			{
 				Key: "_wandb", ValueJson: fmt.Sprintf(`{"runtime": %d}`, runtime),
 			},
-		})
+		}
+		if item := h.resourceUsageItem(); item != nil {
+			items = append(items, item)
+		}
+		summaryRecord := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, items)
 		h.summaryHandler.updateSummaryDelta(summaryRecord)
 	}
 
@@ -760,6 +1056,64 @@ func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord
 	)
 }
 
+// resourceUsageItem returns the summary item of the resources used by the
+// run, with their estimated cost if the _resource_price_table setting is set,
+// or nil if stats are disabled
+func (h *Handler) resourceUsageItem() *service.SummaryItem {
+	usage := h.systemMonitor.Usage()
+	if usage == nil {
+		return nil
+	}
+	if path := h.settings.GetXResourcePriceTable().GetValue(); path != "" {
+		prices, err := monitor.LoadPriceTable(path)
+		if err != nil {
+			h.logger.CaptureError("handler: error reading resource price table", err, "path", path)
+		} else {
+			usage.Cost = prices.Estimate(usage)
+		}
+	}
+	data, err := json.Marshal(usage)
+	if err != nil {
+		h.logger.CaptureError("handler: error encoding resource usage", err)
+		return nil
+	}
+	return &service.SummaryItem{Key: ResourceUsageSummaryKey, ValueJson: string(data)}
+}
+
+// writeHistoryTypesReport saves the report of the history keys logged with
+// more than one type with the run files, if there are any
+func (h *Handler) writeHistoryTypesReport() {
+	report := h.historyTypes.Report()
+	if report == nil {
+		return
+	}
+	keys := make([]string, 0, len(report.Keys))
+	for _, keyTypes := range report.Keys {
+		keys = append(keys, keyTypes.Key)
+	}
+	h.logger.Warn("handler: history keys logged with more than one type",
+		"keys", keys, "policy", report.Policy)
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		h.logger.CaptureError("handler: error encoding history types report", err)
+		return
+	}
+	path := filepath.Join(h.settings.GetFilesDir().GetValue(), HistoryTypesFileName)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		h.logger.CaptureError("handler: error writing history types report", err)
+		return
+	}
+	h.handleFiles(&service.Record{
+		RecordType: &service.Record_Files{
+			Files: &service.FilesRecord{
+				Files: []*service.FilesItem{{Path: HistoryTypesFileName}},
+			},
+		},
+		Control: &service.Control{AlwaysSend: true},
+	})
+}
+
 func (h *Handler) handleFiles(record *service.Record) {
 	if record.GetFiles() == nil {
 		return
@@ -782,6 +1136,14 @@ func (h *Handler) handleGetSummary(_ *service.Record, response *service.Response
 	}
 }
 
+// handleFileTransferProgress responds with the progress of each file
+// transfer of the run
+func (h *Handler) handleFileTransferProgress(_ *service.Record, response *service.Response) {
+	response.ResponseType = &service.Response_FileTransferProgressResponse{
+		FileTransferProgressResponse: h.fileTransferProgress.Progress(),
+	}
+}
+
 func (h *Handler) handleGetSystemMetrics(_ *service.Record, response *service.Response) {
 	sm := h.systemMonitor.GetBuffer()
 
@@ -847,6 +1209,7 @@ func (h *Handler) sendSummary() {
 	h.sendRecord(record)
 	// reset delta summary
 	clear(h.summaryHandler.summaryDelta)
+	h.runSnapshot.WriteSummary(h.summaryHandler.consolidatedSummary)
 }
 
 func (h *Handler) handleSummary(_ *service.Record, summary *service.SummaryRecord) {
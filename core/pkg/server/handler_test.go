@@ -2,7 +2,15 @@ package server_test
 
 import (
 	"context"
+	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/pkg/observability"
 	server "github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -36,3 +44,176 @@ func makeHandler(
 
 	return h
 }
+
+func makeConfigRecord(key, valueJson string) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Config{
+			Config: &service.ConfigRecord{
+				Update: []*service.ConfigItem{{Key: key, ValueJson: valueJson}},
+			},
+		},
+		Control: &service.Control{MailboxSlot: "config"},
+	}
+}
+
+func TestHandleConfigValueChange(t *testing.T) {
+	for _, allowValChange := range []bool{false, true} {
+		inChan, _ := makeInboundChannels()
+		fwdChan, outChan := makeOutboundChannels()
+		settings := &service.Settings{
+			AllowValChange: &wrapperspb.BoolValue{Value: allowValChange},
+			FilesDir:       &wrapperspb.StringValue{Value: t.TempDir()},
+		}
+		h := server.NewHandler(context.Background(),
+			observability.NewNoOpLogger(),
+			server.WithHandlerSettings(settings),
+			server.WithHandlerFwdChannel(fwdChan),
+			server.WithHandlerOutChannel(outChan),
+			server.WithHandlerFileHandler(server.NewFileHandler(observability.NewNoOpLogger(), settings, nil)),
+		)
+		go h.Do(inChan)
+
+		inChan <- makeConfigRecord("lr", "0.1")
+		<-fwdChan
+		assert.Nil(t, (<-outChan).GetConfigResult().GetError())
+
+		// setting the same value again is fine
+		inChan <- makeConfigRecord("lr", "0.1")
+		<-fwdChan
+		assert.Nil(t, (<-outChan).GetConfigResult().GetError())
+
+		inChan <- makeConfigRecord("lr", "0.2")
+		result := <-outChan
+		if allowValChange {
+			assert.Equal(t, "0.2", (<-fwdChan).GetConfig().GetUpdate()[0].GetValueJson())
+			assert.Nil(t, result.GetConfigResult().GetError())
+		} else {
+			assert.Empty(t, fwdChan, "rejected update was forwarded")
+			configError := result.GetConfigResult().GetError()
+			assert.Equal(t, service.ErrorInfo_USAGE, configError.GetCode())
+			assert.Contains(t, configError.GetMessage(), "lr")
+		}
+		close(inChan)
+	}
+}
+
+func TestHandleConfigValueChangeOfRecord(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{FilesDir: &wrapperspb.StringValue{Value: t.TempDir()}}
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerFileHandler(server.NewFileHandler(observability.NewNoOpLogger(), settings, nil)),
+	)
+	go h.Do(inChan)
+	defer close(inChan)
+
+	inChan <- makeConfigRecord("lr", "0.1")
+	<-fwdChan
+	<-outChan
+
+	// the record allows the change
+	record := makeConfigRecord("lr", "0.2")
+	record.GetConfig().AllowValChange = true
+	inChan <- record
+	assert.Equal(t, "0.2", (<-fwdChan).GetConfig().GetUpdate()[0].GetValueJson())
+	assert.Nil(t, (<-outChan).GetConfigResult().GetError())
+
+	// only the changed value is rejected
+	record = makeConfigRecord("lr", "0.3")
+	record.GetConfig().Update = append(record.GetConfig().Update,
+		&service.ConfigItem{Key: "epochs", ValueJson: "10"})
+	inChan <- record
+	update := (<-fwdChan).GetConfig().GetUpdate()
+	assert.Len(t, update, 1)
+	assert.Equal(t, "epochs", update[0].GetKey())
+	assert.Contains(t, (<-outChan).GetConfigResult().GetError().GetMessage(), "lr")
+
+	// the change is kept if the client does not wait for the result
+	record = makeConfigRecord("lr", "0.4")
+	record.Control = nil
+	inChan <- record
+	assert.Equal(t, "0.4", (<-fwdChan).GetConfig().GetUpdate()[0].GetValueJson())
+	assert.Empty(t, outChan)
+}
+
+func makeEventRecord(event *service.EventRecord) *service.Record {
+	return &service.Record{RecordType: &service.Record_Event{Event: event}}
+}
+
+func TestHandleEvent(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, nil, fwdChan, outChan, false)
+	defer close(inChan)
+
+	// an event before any history is at step 0, at the time it is handled
+	before := time.Now()
+	inChan <- makeEventRecord(&service.EventRecord{Name: "start"})
+	event := (<-fwdChan).GetEvent()
+	assert.Equal(t, int64(0), event.GetStep().GetNum())
+	assert.WithinRange(t, event.GetTimestamp().AsTime(), before, time.Now())
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_PartialHistory{
+					PartialHistory: &service.PartialHistoryRequest{
+						Step: &service.HistoryStep{Num: 5},
+						Item: []*service.HistoryItem{{Key: "loss", ValueJson: "0.5"}},
+					},
+				},
+			},
+		},
+	}
+	inChan <- makeEventRecord(&service.EventRecord{Name: "lr_decayed"})
+	assert.Equal(t, int64(5), (<-fwdChan).GetEvent().GetStep().GetNum())
+
+	// the step and time of the event are kept if set
+	timestamp := timestamppb.New(time.Unix(1700000000, 0))
+	inChan <- makeEventRecord(&service.EventRecord{
+		Name:      "checkpoint",
+		Step:      &service.HistoryStep{Num: 3},
+		Timestamp: timestamp,
+	})
+	event = (<-fwdChan).GetEvent()
+	assert.Equal(t, int64(3), event.GetStep().GetNum())
+	assert.True(t, proto.Equal(timestamp, event.GetTimestamp()))
+}
+
+func TestHandleFileTransferProgress(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	progress := filetransfer.NewProgressTracker()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerFileTransferHandler(server.NewFileTransferHandler()),
+		server.WithHandlerFileTransferProgress(progress),
+	)
+	go h.Do(inChan)
+	defer close(inChan)
+
+	progress.Add(&filetransfer.Task{Path: "model.pt", Name: "model.pt", Size: 10})
+
+	inChan <- makeRequestRecord(&service.Request{
+		RequestType: &service.Request_FileTransferProgress{
+			FileTransferProgress: &service.FileTransferProgressRequest{},
+		},
+	})
+	response := (<-outChan).GetResponse().GetFileTransferProgressResponse()
+	assert.Len(t, response.GetFiles(), 1)
+	assert.Equal(t, "model.pt", response.GetFiles()[0].GetName())
+	assert.Equal(t, int64(10), response.GetTotalBytes())
+
+	inChan <- makeRequestRecord(&service.Request{
+		RequestType: &service.Request_PollExit{PollExit: &service.PollExitRequest{}},
+	})
+	pollExit := (<-outChan).GetResponse().GetPollExitResponse()
+	assert.Len(t, pollExit.GetFileTransferProgress().GetFiles(), 1)
+}
@@ -189,6 +189,15 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	if history.GetItem() == nil {
 		return
 	}
+	// the metrics ingested while the row was active join it
+	history.Item = append(history.Item, h.ingestListener.HistoryItems()...)
+
+	items, changes := h.historyTypes.Check(history.GetStep().GetNum(), history.GetItem(), h.metricHandler.numeric)
+	history.Item = items
+	for _, change := range changes {
+		h.logger.Warn("handler: history key logged with another type",
+			"key", change.Key, "step", change.Step, "type", change.Type, "expected", change.Previous)
+	}
 
 	// adds internal history items to the history record
 	// these items are used for internal bookkeeping and are not sent by the user
@@ -203,6 +212,8 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 			runTime = val - h.timer.GetStartTimeMicro()
 		}
 	}
+	// marks the config values changed since the previous row
+	history.Item = append(history.Item, h.configTimeline.HistoryItems()...)
 	history.Item = append(history.Item,
 		&service.HistoryItem{Key: "_runtime", ValueJson: fmt.Sprintf("%f", runTime)},
 		&service.HistoryItem{Key: "_step", ValueJson: fmt.Sprintf("%d", history.GetStep().GetNum())},
@@ -224,6 +235,7 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	}
 
 	h.sampleHistory(history)
+	h.runSnapshot.WriteHistory(history.GetItem())
 
 	record := &service.Record{
 		RecordType: &service.Record_History{History: history},
@@ -235,7 +247,8 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	if h.summaryHandler == nil {
 		return
 	}
-	summary := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, history.GetItem())
+	summary := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary,
+		h.historyTypes.SummaryItems(history.GetItem()))
 	h.summaryHandler.updateSummaryDelta(summary)
 }
 
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// historyLimiterWindow is the period over which the history rate limit applies
+const historyLimiterWindow = time.Second
+
+// HistoryLimitPolicy decides what happens to history rows over the rate limit.
+type HistoryLimitPolicy int
+
+const (
+	// HistoryLimitDrop drops the rows over the limit.
+	HistoryLimitDrop HistoryLimitPolicy = iota
+	// HistoryLimitStride only keeps rows whose step is a multiple of a stride,
+	// the stride is the smallest power of two that keeps the rate under the limit.
+	HistoryLimitStride
+	// HistoryLimitMean aggregates the rows over the limit into one row holding
+	// the mean of the numeric values and the last of the other values.
+	HistoryLimitMean
+	// HistoryLimitLast aggregates the rows over the limit into one row holding
+	// the last values.
+	HistoryLimitLast
+)
+
+// ParseHistoryLimitPolicy returns the policy for the given setting value; an
+// empty value is the default, HistoryLimitDrop.
+func ParseHistoryLimitPolicy(name string) (HistoryLimitPolicy, error) {
+	switch name {
+	case "", "drop":
+		return HistoryLimitDrop, nil
+	case "stride":
+		return HistoryLimitStride, nil
+	case "mean":
+		return HistoryLimitMean, nil
+	case "last":
+		return HistoryLimitLast, nil
+	default:
+		return HistoryLimitDrop, fmt.Errorf("unknown history rate limit policy %q", name)
+	}
+}
+
+// HistoryLimiter thins out the history rows streamed to the server, so that
+// at most limit rows per second get through. Rows over the limit are handled
+// according to the policy; aggregated rows are emitted when the next window
+// starts, or on Flush.
+//
+// It only affects what is streamed: the transaction log keeps every row.
+type HistoryLimiter struct {
+	limit  int
+	policy HistoryLimitPolicy
+	now    func() time.Time
+
+	windowStart time.Time
+	received    int
+	sent        int
+	stride      int64
+	aggregate   *historyAggregate
+
+	dropped    int
+	aggregated int
+}
+
+type HistoryLimiterOption func(*HistoryLimiter)
+
+// WithHistoryLimiterClock sets the clock of the limiter, used in tests.
+func WithHistoryLimiterClock(now func() time.Time) HistoryLimiterOption {
+	return func(l *HistoryLimiter) {
+		l.now = now
+	}
+}
+
+func NewHistoryLimiter(limit int, policy HistoryLimitPolicy, opts ...HistoryLimiterOption) *HistoryLimiter {
+	l := &HistoryLimiter{
+		limit:  max(limit, 1),
+		policy: policy,
+		now:    time.Now,
+		stride: 1,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Process takes a history row and returns the rows to stream in its place.
+func (l *HistoryLimiter) Process(history *service.HistoryRecord) []*service.HistoryRecord {
+	var rows []*service.HistoryRecord
+
+	now := l.now()
+	if now.Sub(l.windowStart) >= historyLimiterWindow {
+		rows = append(rows, l.Flush()...)
+		l.updateStride()
+		l.windowStart = now
+		l.received = 0
+		l.sent = 0
+	}
+	l.received++
+
+	if l.policy == HistoryLimitStride && history.GetStep().GetNum()%l.stride != 0 {
+		l.dropped++
+		return rows
+	}
+	if l.sent < l.limit {
+		l.sent++
+		return append(rows, history)
+	}
+
+	switch l.policy {
+	case HistoryLimitMean, HistoryLimitLast:
+		if l.aggregate == nil {
+			l.aggregate = newHistoryAggregate()
+		}
+		l.aggregate.add(history)
+		l.aggregated++
+	default:
+		l.dropped++
+	}
+	return rows
+}
+
+// Flush returns the pending aggregated row, if there is one.
+func (l *HistoryLimiter) Flush() []*service.HistoryRecord {
+	if l.aggregate == nil {
+		return nil
+	}
+	row := l.aggregate.row(l.policy == HistoryLimitMean)
+	l.aggregate = nil
+	return []*service.HistoryRecord{row}
+}
+
+// Dropped returns the number of rows that were not streamed.
+func (l *HistoryLimiter) Dropped() int {
+	return l.dropped
+}
+
+// Aggregated returns the number of rows that were merged into aggregated rows.
+func (l *HistoryLimiter) Aggregated() int {
+	return l.aggregated
+}
+
+// updateStride picks the stride for the next window from the rate of the
+// window that just ended
+func (l *HistoryLimiter) updateStride() {
+	l.stride = 1
+	for int64(l.limit)*l.stride < int64(l.received) {
+		l.stride *= 2
+	}
+}
+
+// historyAggregate merges several history rows into one
+type historyAggregate struct {
+	step   *service.HistoryStep
+	values map[string]*aggregateValue
+}
+
+type aggregateValue struct {
+	last  string
+	sum   float64
+	count int
+	// numeric is true while all the values of the key are numbers
+	numeric bool
+}
+
+func newHistoryAggregate() *historyAggregate {
+	return &historyAggregate{values: make(map[string]*aggregateValue)}
+}
+
+func (a *historyAggregate) add(history *service.HistoryRecord) {
+	a.step = history.GetStep()
+	for _, item := range history.GetItem() {
+		value, ok := a.values[item.GetKey()]
+		if !ok {
+			value = &aggregateValue{numeric: true}
+			a.values[item.GetKey()] = value
+		}
+		value.last = item.GetValueJson()
+		number, err := strconv.ParseFloat(item.GetValueJson(), 64)
+		if err != nil {
+			value.numeric = false
+			continue
+		}
+		value.sum += number
+		value.count++
+	}
+}
+
+// row returns the aggregated row. Internal keys, such as _step and
+// _timestamp, always hold their last value.
+func (a *historyAggregate) row(mean bool) *service.HistoryRecord {
+	keys := make([]string, 0, len(a.values))
+	for key := range a.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]*service.HistoryItem, 0, len(keys))
+	for _, key := range keys {
+		value := a.values[key]
+		valueJson := value.last
+		if mean && value.numeric && !strings.HasPrefix(key, "_") {
+			valueJson = strconv.FormatFloat(value.sum/float64(value.count), 'g', -1, 64)
+		}
+		items = append(items, &service.HistoryItem{Key: key, ValueJson: valueJson})
+	}
+	return &service.HistoryRecord{Step: a.step, Item: items}
+}
@@ -0,0 +1,152 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func makeHistoryRow(step int64, value string) *service.HistoryRecord {
+	return &service.HistoryRecord{
+		Step: &service.HistoryStep{Num: step},
+		Item: []*service.HistoryItem{
+			{Key: "_step", ValueJson: fmt.Sprintf("%d", step)},
+			{Key: "loss", ValueJson: value},
+		},
+	}
+}
+
+// logRows logs rowsPerSecond rows per second for the given number of seconds,
+// and returns the rows that went through
+func logRows(limiter *server.HistoryLimiter, clock *fakeClock, rowsPerSecond int, seconds int) []*service.HistoryRecord {
+	var out []*service.HistoryRecord
+	interval := time.Second / time.Duration(rowsPerSecond)
+	step := int64(0)
+	for i := 0; i < rowsPerSecond*seconds; i++ {
+		out = append(out, limiter.Process(makeHistoryRow(step, fmt.Sprintf("%d", step)))...)
+		step++
+		clock.Advance(interval)
+	}
+	return append(out, limiter.Flush()...)
+}
+
+func getValue(row *service.HistoryRecord, key string) string {
+	for _, item := range row.GetItem() {
+		if item.GetKey() == key {
+			return item.GetValueJson()
+		}
+	}
+	return ""
+}
+
+func TestHistoryLimiterUnderLimit(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(100, server.HistoryLimitDrop,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	out := logRows(limiter, clock, 50, 3)
+	assert.Len(t, out, 150)
+	assert.Equal(t, 0, limiter.Dropped())
+}
+
+func TestHistoryLimiterDrop(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(10, server.HistoryLimitDrop,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	out := logRows(limiter, clock, 10000, 3)
+	assert.Len(t, out, 30)
+	assert.Equal(t, 30000-30, limiter.Dropped())
+	// the first rows of each second go through
+	assert.Equal(t, int64(0), out[0].GetStep().GetNum())
+	assert.Equal(t, int64(10000), out[10].GetStep().GetNum())
+}
+
+func TestHistoryLimiterStride(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(100, server.HistoryLimitStride,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	out := logRows(limiter, clock, 1000, 3)
+	// the stride adapts after the first second: 1000 rows/s needs a stride of 16
+	assert.LessOrEqual(t, len(out), 300)
+	for _, row := range out[100:] {
+		assert.Zero(t, row.GetStep().GetNum()%16, "step %d", row.GetStep().GetNum())
+	}
+	// the rows are spread over the whole second rather than bunched at its start
+	last := out[len(out)-1].GetStep().GetNum()
+	assert.Greater(t, last, int64(2900))
+}
+
+func TestHistoryLimiterMean(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(10, server.HistoryLimitMean,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	out := logRows(limiter, clock, 100, 2)
+	// each second: 10 rows, then one row aggregating the other 90
+	assert.Len(t, out, 22)
+	assert.Equal(t, 180, limiter.Aggregated())
+
+	aggregated := out[10]
+	assert.Equal(t, int64(99), aggregated.GetStep().GetNum())
+	assert.Equal(t, "99", getValue(aggregated, "_step"))
+	// mean of 10..99
+	assert.Equal(t, "54.5", getValue(aggregated, "loss"))
+	assert.Equal(t, int64(100), out[11].GetStep().GetNum())
+}
+
+func TestHistoryLimiterLast(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(10, server.HistoryLimitLast,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	out := logRows(limiter, clock, 100, 2)
+	assert.Len(t, out, 22)
+	assert.Equal(t, "99", getValue(out[10], "loss"))
+	// the last aggregated row is emitted by Flush
+	assert.Equal(t, int64(199), out[21].GetStep().GetNum())
+	assert.Equal(t, "199", getValue(out[21], "loss"))
+}
+
+func TestHistoryLimiterMeanNonNumeric(t *testing.T) {
+	clock := &fakeClock{}
+	limiter := server.NewHistoryLimiter(1, server.HistoryLimitMean,
+		server.WithHistoryLimiterClock(clock.Now))
+
+	limiter.Process(makeHistoryRow(0, "1"))
+	limiter.Process(makeHistoryRow(1, "2"))
+	limiter.Process(makeHistoryRow(2, `"text"`))
+	out := limiter.Flush()
+	assert.Len(t, out, 1)
+	assert.Equal(t, `"text"`, getValue(out[0], "loss"))
+}
+
+func TestParseHistoryLimitPolicy(t *testing.T) {
+	policy, err := server.ParseHistoryLimitPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, server.HistoryLimitDrop, policy)
+
+	policy, err = server.ParseHistoryLimitPolicy("mean")
+	assert.NoError(t, err)
+	assert.Equal(t, server.HistoryLimitMean, policy)
+
+	_, err = server.ParseHistoryLimitPolicy("median")
+	assert.Error(t, err)
+}
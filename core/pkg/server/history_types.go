@@ -0,0 +1,328 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// HistoryTypesFileName is the file of the report of the history keys logged
+// with more than one type
+const HistoryTypesFileName = "wandb-history-types.json"
+
+// The types of history values. A media value, such as an image or a
+// histogram, has the type named by its "_type".
+const (
+	historyNumber = "number"
+	historyString = "string"
+	historyBool   = "bool"
+	historyList   = "list"
+	historyDict   = "dict"
+)
+
+// HistoryTypePolicy decides what happens to a history value whose type
+// differs from the type of its key.
+type HistoryTypePolicy int
+
+const (
+	// HistoryTypeWarn keeps the value and logs a warning.
+	HistoryTypeWarn HistoryTypePolicy = iota
+	// HistoryTypeReject drops the value from its row.
+	HistoryTypeReject
+	// HistoryTypeCoerce converts the value to the type of its key, and
+	// drops it if it cannot be converted.
+	HistoryTypeCoerce
+)
+
+// ParseHistoryTypePolicy returns the policy for the given setting value; an
+// empty value is the default, HistoryTypeWarn.
+func ParseHistoryTypePolicy(name string) (HistoryTypePolicy, error) {
+	switch name {
+	case "", "warn":
+		return HistoryTypeWarn, nil
+	case "reject":
+		return HistoryTypeReject, nil
+	case "coerce":
+		return HistoryTypeCoerce, nil
+	default:
+		return HistoryTypeWarn, fmt.Errorf("unknown history type policy %q", name)
+	}
+}
+
+func (p HistoryTypePolicy) String() string {
+	switch p {
+	case HistoryTypeReject:
+		return "reject"
+	case HistoryTypeCoerce:
+		return "coerce"
+	default:
+		return "warn"
+	}
+}
+
+// HistoryTypes tracks the type of each history key, which is the type of
+// its first value, or a number for the keys whose metric is defined with a
+// numeric summary or used as a step. Values of another type are handled
+// according to the policy, and reported at the end of the run.
+type HistoryTypes struct {
+	policy HistoryTypePolicy
+	types  map[string]string
+	keys   map[string]*HistoryKeyTypes
+
+	// numeric are the keys that must have numbers by their metric
+	numeric map[string]bool
+}
+
+// HistoryKeyTypes are the types logged under a history key.
+type HistoryKeyTypes struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+	// Others are the other types logged, in the order they were first seen
+	Others   []*HistoryTypeSeen `json:"others"`
+	Rejected int                `json:"rejected,omitempty"`
+	Coerced  int                `json:"coerced,omitempty"`
+}
+
+// HistoryTypeSeen is a type logged under a key of another type.
+type HistoryTypeSeen struct {
+	Type      string `json:"type"`
+	FirstStep int64  `json:"first_step"`
+	Count     int    `json:"count"`
+}
+
+// HistoryTypesReport lists the history keys logged with more than one type.
+type HistoryTypesReport struct {
+	Policy string             `json:"policy"`
+	Keys   []*HistoryKeyTypes `json:"keys"`
+}
+
+func NewHistoryTypes(policy HistoryTypePolicy) *HistoryTypes {
+	return &HistoryTypes{
+		policy:  policy,
+		types:   make(map[string]string),
+		keys:    make(map[string]*HistoryKeyTypes),
+		numeric: make(map[string]bool),
+	}
+}
+
+// Check checks the types of the items of the row at step, and returns the
+// items to keep, converted if the policy coerces them. numeric tells whether
+// a key must have numbers; it is called for the keys that have no type yet.
+// The changes of type are returned, one for each key and type the first time
+// they are seen, so that they can be warned about once.
+func (ht *HistoryTypes) Check(
+	step int64,
+	items []*service.HistoryItem,
+	numeric func(key string) bool,
+) ([]*service.HistoryItem, []*HistoryTypeChange) {
+	if ht == nil {
+		return items, nil
+	}
+
+	kept := items[:0]
+	var changes []*HistoryTypeChange
+	for _, item := range items {
+		key := item.GetKey()
+		if strings.HasPrefix(key, "_") || len(item.GetNestedKey()) > 0 {
+			kept = append(kept, item)
+			continue
+		}
+		valueType := historyValueType(item.GetValueJson())
+		if valueType == "" {
+			kept = append(kept, item)
+			continue
+		}
+
+		keyType, ok := ht.types[key]
+		if !ok {
+			keyType = valueType
+			if numeric != nil && numeric(key) {
+				keyType = historyNumber
+				ht.numeric[key] = true
+			}
+			ht.types[key] = keyType
+		}
+		if valueType == keyType {
+			kept = append(kept, item)
+			continue
+		}
+
+		if change := ht.observe(key, keyType, valueType, step); change != nil {
+			changes = append(changes, change)
+		}
+		switch ht.policy {
+		case HistoryTypeWarn:
+			kept = append(kept, item)
+		case HistoryTypeReject:
+			ht.keys[key].Rejected++
+		case HistoryTypeCoerce:
+			if value, ok := coerceHistoryValue(item.GetValueJson(), valueType, keyType); ok {
+				ht.keys[key].Coerced++
+				kept = append(kept, &service.HistoryItem{Key: key, ValueJson: value})
+			} else {
+				ht.keys[key].Rejected++
+			}
+		}
+	}
+	return kept, changes
+}
+
+// SummaryItems returns the items of a row that update the summary. The
+// values that are not numbers are left out for the keys whose metric is
+// defined with a numeric summary, since the warn policy keeps them in the
+// history.
+func (ht *HistoryTypes) SummaryItems(items []*service.HistoryItem) []*service.HistoryItem {
+	if ht == nil || len(ht.numeric) == 0 {
+		return items
+	}
+	summaryItems := make([]*service.HistoryItem, 0, len(items))
+	for _, item := range items {
+		if ht.numeric[item.GetKey()] && historyValueType(item.GetValueJson()) != historyNumber {
+			continue
+		}
+		summaryItems = append(summaryItems, item)
+	}
+	return summaryItems
+}
+
+// HistoryTypeChange is a value logged under a key of another type.
+type HistoryTypeChange struct {
+	Key      string
+	Step     int64
+	Type     string
+	Previous string
+}
+
+// observe records a value of another type than that of its key, and returns
+// the change if the type was not seen before
+func (ht *HistoryTypes) observe(key, keyType, valueType string, step int64) *HistoryTypeChange {
+	keyTypes, ok := ht.keys[key]
+	if !ok {
+		keyTypes = &HistoryKeyTypes{Key: key, Type: keyType}
+		ht.keys[key] = keyTypes
+	}
+	for _, seen := range keyTypes.Others {
+		if seen.Type == valueType {
+			seen.Count++
+			return nil
+		}
+	}
+	keyTypes.Others = append(keyTypes.Others, &HistoryTypeSeen{Type: valueType, FirstStep: step, Count: 1})
+	return &HistoryTypeChange{Key: key, Step: step, Type: valueType, Previous: keyType}
+}
+
+// Report returns the report of the keys logged with more than one type, or
+// nil if there are none.
+func (ht *HistoryTypes) Report() *HistoryTypesReport {
+	if ht == nil || len(ht.keys) == 0 {
+		return nil
+	}
+	report := &HistoryTypesReport{Policy: ht.policy.String()}
+	for _, keyTypes := range ht.keys {
+		report.Keys = append(report.Keys, keyTypes)
+	}
+	sort.Slice(report.Keys, func(i, j int) bool {
+		return report.Keys[i].Key < report.Keys[j].Key
+	})
+	return report
+}
+
+// historyValueType returns the type of a JSON history value, or "" for null
+func historyValueType(valueJSON string) string {
+	value := strings.TrimSpace(valueJSON)
+	if value == "" {
+		return ""
+	}
+	switch value[0] {
+	case 'n':
+		return ""
+	case '"':
+		return historyString
+	case 't', 'f':
+		return historyBool
+	case '[':
+		return historyList
+	case '{':
+		var media struct {
+			Type string `json:"_type"`
+		}
+		if err := json.Unmarshal([]byte(value), &media); err == nil && media.Type != "" {
+			return media.Type
+		}
+		return historyDict
+	default:
+		// including NaN and Infinity, which the clients write as such
+		return historyNumber
+	}
+}
+
+// coerceHistoryValue converts a JSON value of type from to type to, if it
+// has a meaning in that type
+func coerceHistoryValue(valueJSON string, from string, to string) (string, bool) {
+	switch to {
+	case historyNumber:
+		switch from {
+		case historyString:
+			var s string
+			if err := json.Unmarshal([]byte(valueJSON), &s); err != nil {
+				return "", false
+			}
+			number, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return "", false
+			}
+			return formatHistoryNumber(number), true
+		case historyBool:
+			if strings.TrimSpace(valueJSON) == "true" {
+				return "1", true
+			}
+			return "0", true
+		}
+	case historyString:
+		value := strings.TrimSpace(valueJSON)
+		if from == historyNumber || from == historyBool {
+			return strconv.Quote(value), true
+		}
+		if from == historyList || from == historyDict {
+			quoted, err := json.Marshal(value)
+			return string(quoted), err == nil
+		}
+	case historyBool:
+		if from == historyNumber {
+			switch strings.TrimSpace(valueJSON) {
+			case "0":
+				return "false", true
+			case "1":
+				return "true", true
+			}
+		}
+		if from == historyString {
+			var s string
+			if err := json.Unmarshal([]byte(valueJSON), &s); err != nil {
+				return "", false
+			}
+			if b, err := strconv.ParseBool(s); err == nil {
+				return strconv.FormatBool(b), true
+			}
+		}
+	}
+	return "", false
+}
+
+func formatHistoryNumber(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,192 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func makeHistoryItems(values ...string) []*service.HistoryItem {
+	items := make([]*service.HistoryItem, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		items = append(items, &service.HistoryItem{Key: values[i], ValueJson: values[i+1]})
+	}
+	return items
+}
+
+func TestParseHistoryTypePolicy(t *testing.T) {
+	for name, want := range map[string]server.HistoryTypePolicy{
+		"":       server.HistoryTypeWarn,
+		"warn":   server.HistoryTypeWarn,
+		"reject": server.HistoryTypeReject,
+		"coerce": server.HistoryTypeCoerce,
+	} {
+		policy, err := server.ParseHistoryTypePolicy(name)
+		assert.NoError(t, err)
+		assert.Equal(t, want, policy)
+	}
+
+	_, err := server.ParseHistoryTypePolicy("ignore")
+	assert.ErrorContains(t, err, `unknown history type policy "ignore"`)
+}
+
+func TestHistoryTypesWarn(t *testing.T) {
+	types := server.NewHistoryTypes(server.HistoryTypeWarn)
+
+	items, changes := types.Check(0, makeHistoryItems("_step", "0", "loss", "0.5", "tag", `"a"`), nil)
+	assert.Len(t, items, 3)
+	assert.Empty(t, changes)
+
+	// a change of type is reported once, and the value is kept
+	items, changes = types.Check(1, makeHistoryItems("loss", `"nan"`, "tag", "null"), nil)
+	assert.Equal(t, makeHistoryItems("loss", `"nan"`, "tag", "null"), items)
+	assert.Equal(t, []*server.HistoryTypeChange{
+		{Key: "loss", Step: 1, Type: "string", Previous: "number"},
+	}, changes)
+	_, changes = types.Check(2, makeHistoryItems("loss", `"inf"`), nil)
+	assert.Empty(t, changes)
+
+	assert.Equal(t, &server.HistoryTypesReport{
+		Policy: "warn",
+		Keys: []*server.HistoryKeyTypes{{
+			Key:    "loss",
+			Type:   "number",
+			Others: []*server.HistoryTypeSeen{{Type: "string", FirstStep: 1, Count: 2}},
+		}},
+	}, types.Report())
+}
+
+func TestHistoryTypesReject(t *testing.T) {
+	types := server.NewHistoryTypes(server.HistoryTypeReject)
+
+	types.Check(0, makeHistoryItems("loss", "0.5", "image", `{"_type": "image-file", "path": "a.png"}`), nil)
+	items, _ := types.Check(1, makeHistoryItems(
+		"loss", "true",
+		"image", `{"_type": "histogram", "bins": [0, 1]}`,
+		"acc", "0.9",
+	), nil)
+
+	assert.Equal(t, makeHistoryItems("acc", "0.9"), items)
+	report := types.Report()
+	require.Len(t, report.Keys, 2)
+	assert.Equal(t, "image", report.Keys[0].Key)
+	assert.Equal(t, "image-file", report.Keys[0].Type)
+	assert.Equal(t, "histogram", report.Keys[0].Others[0].Type)
+	assert.Equal(t, 1, report.Keys[1].Rejected)
+}
+
+func TestHistoryTypesCoerce(t *testing.T) {
+	types := server.NewHistoryTypes(server.HistoryTypeCoerce)
+
+	types.Check(0, makeHistoryItems("loss", "0.5", "done", "false", "label", `"cat"`), nil)
+	items, _ := types.Check(1, makeHistoryItems(
+		"loss", `" 0.25"`,
+		"done", "1",
+		"label", "[1, 2]",
+	), nil)
+	assert.Equal(t, makeHistoryItems("loss", "0.25", "done", "true", "label", `"[1, 2]"`), items)
+
+	// values that have no meaning in the type of their key are dropped
+	items, _ = types.Check(2, makeHistoryItems("loss", `"high"`, "done", "2"), nil)
+	assert.Empty(t, items)
+
+	report := types.Report()
+	require.Len(t, report.Keys, 3)
+	for _, keyTypes := range report.Keys {
+		switch keyTypes.Key {
+		case "label":
+			assert.Equal(t, 1, keyTypes.Coerced)
+			assert.Equal(t, 0, keyTypes.Rejected)
+		default:
+			assert.Equal(t, 1, keyTypes.Coerced)
+			assert.Equal(t, 1, keyTypes.Rejected)
+		}
+	}
+}
+
+func TestHistoryTypesNumericMetric(t *testing.T) {
+	types := server.NewHistoryTypes(server.HistoryTypeWarn)
+	numeric := func(key string) bool { return key == "loss" }
+
+	// a key whose metric is numeric is a number even if its first value is
+	// not, and the values that are not numbers stay out of the summary
+	items, changes := types.Check(0, makeHistoryItems("loss", `"warmup"`, "tag", `"a"`), numeric)
+	assert.Len(t, items, 2)
+	assert.Equal(t, []*server.HistoryTypeChange{
+		{Key: "loss", Step: 0, Type: "string", Previous: "number"},
+	}, changes)
+	assert.Equal(t, makeHistoryItems("tag", `"a"`), types.SummaryItems(items))
+
+	items, _ = types.Check(1, makeHistoryItems("loss", "0.5"), numeric)
+	assert.Equal(t, items, types.SummaryItems(items))
+}
+
+func TestHistoryTypesNoChanges(t *testing.T) {
+	types := server.NewHistoryTypes(server.HistoryTypeReject)
+	types.Check(0, makeHistoryItems("loss", "0.5", "_runtime", "1"), nil)
+	types.Check(1, makeHistoryItems("loss", "NaN", "_runtime", `"x"`), nil)
+
+	assert.Nil(t, types.Report())
+}
+
+func TestHandleHistoryTypePolicy(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{
+			XHistoryTypePolicy: wrapperspb.String("reject"),
+		}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+	)
+	go h.Do(inChan)
+	defer close(inChan)
+
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"loss": "0.5"}, step: 0, flush: true})
+	assert.Equal(t, "0.5", getValue((<-fwdChan).GetHistory(), "loss"))
+
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"loss": `"diverged"`}, step: 1, flush: true})
+	history := (<-fwdChan).GetHistory()
+	assert.Equal(t, int64(1), history.GetStep().GetNum())
+	assert.Empty(t, getValue(history, "loss"))
+}
+
+func TestHistoryTypesReportIncludesLastRow(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{FilesDir: wrapperspb.String(t.TempDir())}
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerFileHandler(server.NewFileHandler(observability.NewNoOpLogger(), settings, loopbackChan)),
+	)
+	go h.Do(inChan)
+	defer close(inChan)
+
+	// the type changes in the row that is only flushed at exit
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"loss": "0.5"}, step: 0, flush: true})
+	assert.NotNil(t, (<-fwdChan).GetHistory())
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"loss": `"diverged"`}, step: 1})
+	inChan <- makeFlushRecord()
+
+	assert.NotNil(t, (<-fwdChan).GetHistory())
+	files := (<-fwdChan).GetFiles()
+	require.Len(t, files.GetFiles(), 1)
+	assert.Equal(t, server.HistoryTypesFileName, files.GetFiles()[0].GetPath())
+	data, err := os.ReadFile(filepath.Join(settings.GetFilesDir().GetValue(), server.HistoryTypesFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"loss"`)
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetDefer())
+}
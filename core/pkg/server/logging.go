@@ -38,7 +38,9 @@ func SetupDefaultLogger(writers ...io.Writer) *slog.Logger {
 
 // TODO: add a noop logger
 
-func SetupStreamLogger(settings *service.Settings) *observability.CoreLogger {
+// SetupStreamLogger creates the logger of a stream, along with the log file it
+// writes to, which the caller must close. The file is nil if it could not be opened.
+func SetupStreamLogger(settings *service.Settings) (*observability.CoreLogger, *os.File) {
 	// TODO: when we add session concept re-do this to use user provided path
 	targetPath := filepath.Join(settings.GetLogDir().GetValue(), "core-debug.log")
 	if path := defaultLoggerPath.Load(); path != nil {
@@ -58,6 +60,7 @@ func SetupStreamLogger(settings *service.Settings) *observability.CoreLogger {
 	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
 	if err != nil {
 		slog.Error(fmt.Sprintf("error opening log file: %s", err))
+		file = nil
 	} else {
 		writers = append(writers, file)
 	}
@@ -83,5 +86,5 @@ func SetupStreamLogger(settings *service.Settings) *observability.CoreLogger {
 	}
 	logger.SetTags(tags)
 
-	return logger
+	return logger, file
 }
@@ -68,6 +68,34 @@ func (mh *MetricHandler) createMatchingGlobMetric(key string) *service.MetricRec
 	return nil
 }
 
+// numeric returns whether the values of a history key must be numbers: when
+// its metric is defined with a summary that aggregates them, such as min or
+// mean, or when it is the step metric of another metric.
+func (mh *MetricHandler) numeric(key string) bool {
+	if mh == nil {
+		return false
+	}
+	metric, ok := mh.definedMetrics[key]
+	if !ok {
+		for pattern, globMetric := range mh.globMetrics {
+			if match, err := filepath.Match(pattern, key); err == nil && match {
+				metric = globMetric
+				break
+			}
+		}
+	}
+	if summary := metric.GetSummary(); summary.GetMin() || summary.GetMax() ||
+		summary.GetMean() || summary.GetBest() {
+		return true
+	}
+	for _, defined := range mh.definedMetrics {
+		if defined.GetStepMetric() == key {
+			return true
+		}
+	}
+	return false
+}
+
 // handleStepMetric handles the step metric for a given metric key. If the step metric is not
 // defined, it will be added to the defined metrics map.
 func (h *Handler) handleStepMetric(key string) {
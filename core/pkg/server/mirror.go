@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// The keys of the destinations in the _mirrors setting
+const (
+	MirrorBaseURL = "base_url"
+	MirrorAPIKey  = "api_key"
+	MirrorEntity  = "entity"
+	MirrorProject = "project"
+)
+
+// defaultMirrorShutdownTimeout is how long a closing stream waits for its
+// mirrors, unless the settings say otherwise
+const defaultMirrorShutdownTimeout = 60 * time.Second
+
+// Mirror sends the records of a run to an additional W&B server, with a
+// sender of its own, so that it has its own retries, file stream offsets and
+// uploads. The sender of a mirror only sends: it uploads the run files from
+// the run directory, but never writes to it, as the main sender does.
+//
+// The records are queued without bound, so that a slow or unreachable mirror
+// never holds back the stream. A mirror whose sender fails is dropped, and
+// the rest of the run is not sent to it. When the stream closes, it waits for
+// its mirrors to finish sending until the _mirror_shutdown_timeout_seconds
+// deadline, and aborts those that are still sending. The responses of the
+// sender of a mirror are discarded: the client only hears from the main
+// server.
+type Mirror struct {
+	// name is the name of the mirror in the settings
+	name string
+
+	// ctx is the context of the sender, which is not canceled with the
+	// stream so that the mirror can finish its uploads
+	ctx context.Context
+
+	// cancel is called by the sender at the end of the defer state machine
+	cancel context.CancelFunc
+
+	logger *observability.CoreLogger
+
+	sender *Sender
+
+	// entity and project replace those of the run, if set
+	entity  string
+	project string
+
+	// mu guards the queue of records and whether it is closed
+	mu     sync.Mutex
+	queue  []*service.Record
+	closed bool
+	// wake is signaled when records are queued or the queue is closed
+	wake chan struct{}
+
+	// done is closed when Do returns
+	done chan struct{}
+
+	// failed is true once the sender failed
+	failed bool
+}
+
+// NewMirrors returns the mirrors of the _mirrors setting, by name. A mirror
+// that cannot be set up is logged and skipped, since the run can still go to
+// the main server.
+func NewMirrors(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	settings *service.Settings,
+) []*Mirror {
+	if settings.GetXOffline().GetValue() {
+		return nil
+	}
+	destinations := settings.GetXMirrors().GetValue()
+	names := make([]string, 0, len(destinations))
+	for name := range destinations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var mirrors []*Mirror
+	for _, name := range names {
+		mirror, err := NewMirror(ctx, logger, settings, name, destinations[name].GetValue())
+		if err != nil {
+			logger.CaptureError("mirror: not mirroring the run", err, "mirror", name)
+			continue
+		}
+		mirrors = append(mirrors, mirror)
+	}
+	return mirrors
+}
+
+// NewMirror returns the mirror named name, sending to the destination
+// described by the base_url, api_key, entity and project keys. The API key
+// of the destination is resolved as that of the main server if it is not
+// given.
+func NewMirror(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	settings *service.Settings,
+	name string,
+	destination map[string]string,
+) (*Mirror, error) {
+	baseURL := destination[MirrorBaseURL]
+	if baseURL == "" {
+		return nil, fmt.Errorf("mirror: %q has no %s", name, MirrorBaseURL)
+	}
+	if baseURL == settings.GetBaseUrl().GetValue() && destination[MirrorEntity] == "" &&
+		destination[MirrorProject] == "" {
+		return nil, fmt.Errorf("mirror: %q is the main server", name)
+	}
+
+	mirrorSettings, ok := proto.Clone(settings).(*service.Settings)
+	if !ok {
+		return nil, fmt.Errorf("mirror: failed to clone settings")
+	}
+	mirrorSettings.XMirrors = nil
+	mirrorSettings.BaseUrl = wrapperspb.String(baseURL)
+	mirrorSettings.ApiKey = nil
+	if key := destination[MirrorAPIKey]; key != "" {
+		mirrorSettings.ApiKey = wrapperspb.String(key)
+	}
+	if err := ResolveAPIKey(ctx, mirrorSettings); err != nil {
+		return nil, fmt.Errorf("mirror: %q: %w", name, err)
+	}
+	if entity := destination[MirrorEntity]; entity != "" {
+		mirrorSettings.Entity = wrapperspb.String(entity)
+	}
+	if project := destination[MirrorProject]; project != "" {
+		mirrorSettings.Project = wrapperspb.String(project)
+	}
+
+	m := &Mirror{
+		name:    name,
+		logger:  logger,
+		entity:  destination[MirrorEntity],
+		project: destination[MirrorProject],
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	m.ctx, m.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	m.sender = NewSender(m.ctx, m.cancel, logger, mirrorSettings,
+		WithSenderFwdChannel(make(chan *service.Record, BufferSize)),
+		WithSenderOutChannel(make(chan *service.Result, BufferSize)),
+		WithSenderSendOnly(),
+	)
+	return m, nil
+}
+
+// Queue queues a copy of a record for the mirror, without blocking. The
+// requests that only concern the main server, such as those reading the
+// transaction log of a sync, are left out.
+func (m *Mirror) Queue(record *service.Record) {
+	switch record.GetRequest().GetRequestType().(type) {
+	case *service.Request_Sync,
+		*service.Request_SenderRead,
+		*service.Request_DownloadArtifact,
+		*service.Request_ServerInfo,
+		*service.Request_StopStatus,
+		*service.Request_RunEdits,
+		*service.Request_NetworkStatus:
+		return
+	}
+
+	// the sender of the main server changes some records as it sends them
+	record, ok := proto.Clone(record).(*service.Record)
+	if !ok {
+		return
+	}
+	if run := record.GetRun(); run != nil {
+		if m.entity != "" {
+			run.Entity = m.entity
+		}
+		if m.project != "" {
+			run.Project = m.project
+		}
+	}
+
+	m.mu.Lock()
+	m.queue = append(m.queue, record)
+	m.mu.Unlock()
+	m.signal()
+}
+
+// Close tells the mirror that no more records are coming; it returns from
+// Do once it has sent the queued ones.
+func (m *Mirror) Close() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.signal()
+}
+
+// Done returns a channel that is closed once the mirror is done sending.
+func (m *Mirror) Done() <-chan struct{} {
+	return m.done
+}
+
+// Abort stops a mirror that is still sending: the queued records are
+// dropped and the requests in flight are canceled, so the run may be
+// incomplete on the mirror. It does not wait for the mirror to stop.
+func (m *Mirror) Abort() {
+	select {
+	case <-m.done:
+		return
+	default:
+	}
+	m.mu.Lock()
+	dropped := len(m.queue)
+	m.queue = nil
+	m.closed = true
+	m.mu.Unlock()
+	m.signal()
+	m.cancel()
+	m.logger.CaptureWarn("mirror: aborted at shutdown", "mirror", m.name, "dropped", dropped)
+}
+
+func (m *Mirror) signal() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next queued record, waiting for one, or false once the
+// queue is closed and empty
+func (m *Mirror) next() (*service.Record, bool) {
+	for {
+		m.mu.Lock()
+		if len(m.queue) > 0 {
+			record := m.queue[0]
+			m.queue[0] = nil
+			m.queue = m.queue[1:]
+			m.mu.Unlock()
+			return record, true
+		}
+		closed := m.closed
+		m.mu.Unlock()
+		if closed {
+			return nil, false
+		}
+		<-m.wake
+	}
+}
+
+// Do sends the queued records to the mirror until the queue is closed.
+func (m *Mirror) Do() {
+	m.logger.Info("mirror: started", "mirror", m.name)
+
+	done := make(chan struct{})
+	go m.discard(done)
+
+	for {
+		record, ok := m.next()
+		if !ok {
+			break
+		}
+		if !m.failed {
+			m.send(record)
+		}
+	}
+	close(done)
+	m.cancel()
+	m.sender.logGraphqlStats()
+	m.logger.Info("mirror: closed", "mirror", m.name)
+	close(m.done)
+}
+
+// send sends a record with the sender of the mirror, dropping the mirror if
+// the sender fails
+func (m *Mirror) send(record *service.Record) {
+	defer func() {
+		if err := recover(); err != nil {
+			m.failed = true
+			m.logger.CaptureError("mirror: sender failed, dropping the mirror",
+				fmt.Errorf("%v", err), "mirror", m.name)
+		}
+	}()
+	m.sender.sendRecord(record)
+	m.sender.runUpserter.Debounce()
+}
+
+// discard drops the records and results of the sender of the mirror: its
+// defer state machine is driven by the records of the main sender, and the
+// client only hears from the main server
+func (m *Mirror) discard(done <-chan struct{}) {
+	for {
+		select {
+		case <-m.sender.fwdChan:
+		case <-m.sender.outChan:
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// fakeServer is a W&B server that upserts the runs it is given and accepts
+// their file streams and uploads, recording the bodies of the requests by
+// path. A failing server answers every request with an error.
+type fakeServer struct {
+	*httptest.Server
+	failing  bool
+	mu       sync.Mutex
+	requests map[string][]string
+}
+
+func newFakeServer(t *testing.T, failing bool) *fakeServer {
+	fs := &fakeServer{failing: failing, requests: make(map[string][]string)}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fs.mu.Lock()
+		fs.requests[r.URL.Path] = append(fs.requests[r.URL.Path], string(body))
+		fs.mu.Unlock()
+		switch {
+		case fs.failing:
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.URL.Path == "/graphql":
+			_, _ = w.Write([]byte(fs.graphql(body)))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+// newStalledServer returns a server that never answers, until the test ends
+func newStalledServer(t *testing.T) *httptest.Server {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+	return srv
+}
+
+func (fs *fakeServer) graphql(body []byte) string {
+	var request struct {
+		OperationName string `json:"operationName"`
+		Variables     struct {
+			Name    string   `json:"name"`
+			Entity  string   `json:"entity"`
+			Project string   `json:"project"`
+			Files   []string `json:"files"`
+		} `json:"variables"`
+	}
+	_ = json.Unmarshal(body, &request)
+	switch request.OperationName {
+	case "UpsertBucket":
+		return fmt.Sprintf(`{"data": {"upsertBucket": {"bucket": {`+
+			`"id": "id", "name": %q, "displayName": %q,`+
+			`"project": {"name": %q, "entity": {"name": %q}}}}}}`,
+			request.Variables.Name, request.Variables.Name,
+			request.Variables.Project, request.Variables.Entity)
+	case "CreateRunFiles":
+		var files []string
+		for _, name := range request.Variables.Files {
+			files = append(files, fmt.Sprintf(`{"name": %q, "uploadUrl": "%s/upload/%s"}`, name, fs.URL, name))
+		}
+		return `{"data": {"createRunFiles": {"runID": "id", "files": [` + strings.Join(files, ",") + `]}}}`
+	default:
+		return `{"data": {}}`
+	}
+}
+
+func (fs *fakeServer) bodies(path string) string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return strings.Join(fs.requests[path], "\n")
+}
+
+func (fs *fakeServer) count() int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return len(fs.requests)
+}
+
+func makeOnlineSettings(t *testing.T, runId string, baseURL string) *service.Settings {
+	settings := makeOfflineSettings(t, runId)
+	settings.XOffline = wrapperspb.Bool(false)
+	settings.BaseUrl = wrapperspb.String(baseURL)
+	settings.ApiKey = wrapperspb.String("main-key")
+	settings.XDisableStats = wrapperspb.Bool(true)
+	settings.XGraphqlRetryMax = wrapperspb.Int32(0)
+	settings.XFileStreamRetryMax = wrapperspb.Int32(0)
+	settings.XFileTransferRetryMax = wrapperspb.Int32(0)
+	return settings
+}
+
+// runMirroredStream logs a run with a history row, a line of output and a
+// file through a stream, and finishes it
+func runMirroredStream(t *testing.T, settings *service.Settings) {
+	require.NoError(t, os.WriteFile(
+		filepath.Join(settings.GetFilesDir().GetValue(), "notes.txt"), []byte("notes"), 0644))
+
+	stream := server.NewStream(context.Background(), settings, settings.GetRunId().GetValue())
+	stream.Start()
+
+	run := &service.RunRecord{
+		RunId:     settings.GetRunId().GetValue(),
+		Entity:    "entity",
+		Project:   "project",
+		StartTime: timestamppb.Now(),
+	}
+	stream.HandleRecord(&service.Record{RecordType: &service.Record_Run{Run: run}})
+	stream.HandleRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{Run: run}},
+	}))
+	stream.HandleRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_PartialHistory{
+			PartialHistory: &service.PartialHistoryRequest{
+				Item: []*service.HistoryItem{{Key: "loss", ValueJson: "0.25"}},
+			},
+		},
+	}))
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{Line: "hello"}},
+	})
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Files{Files: &service.FilesRecord{
+			Files: []*service.FilesItem{{Path: "notes.txt"}},
+		}},
+	})
+	stream.FinishAndClose(0)
+}
+
+func TestMirror(t *testing.T) {
+	main := newFakeServer(t, false)
+	mirror := newFakeServer(t, false)
+
+	settings := makeOnlineSettings(t, "mirrored", main.URL)
+	settings.XMirrors = &service.MapStringKeyMapStringKeyStringValue{
+		Value: map[string]*service.MapStringKeyStringValue{
+			"central": {Value: map[string]string{
+				server.MirrorBaseURL: mirror.URL,
+				server.MirrorAPIKey:  "mirror-key",
+				server.MirrorEntity:  "central-entity",
+				server.MirrorProject: "central-project",
+			}},
+		},
+	}
+	runMirroredStream(t, settings)
+
+	stream := main.bodies("/files/entity/project/mirrored/file_stream")
+	assert.Contains(t, stream, `loss\":0.25`)
+	assert.Contains(t, stream, `"complete":true`)
+	assert.Equal(t, "notes", main.bodies("/upload/notes.txt"))
+
+	mirrorStream := mirror.bodies("/files/central-entity/central-project/mirrored/file_stream")
+	assert.Contains(t, mirrorStream, `loss\":0.25`)
+	assert.Contains(t, mirrorStream, `"complete":true`)
+	assert.Equal(t, "notes", mirror.bodies("/upload/notes.txt"))
+	assert.Contains(t, mirror.bodies("/graphql"), `"entity":"central-entity"`)
+	assert.NotContains(t, mirror.bodies("/graphql"), `"entity":"entity"`)
+
+	// only the main sender writes to the run directory
+	output, err := os.ReadFile(filepath.Join(settings.GetFilesDir().GetValue(), server.OutputFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", string(output))
+}
+
+func TestMirrorFailureDoesNotBlockMain(t *testing.T) {
+	main := newFakeServer(t, false)
+	failing := newFakeServer(t, true)
+	mirror := newFakeServer(t, false)
+
+	stalled := newStalledServer(t)
+
+	// the stalled mirror would wait for minutes with the default timeouts
+	// and retries, it is aborted at the shutdown deadline
+	settings := makeOnlineSettings(t, "failing", main.URL)
+	settings.XGraphqlTimeoutSeconds = wrapperspb.Int32(30)
+	settings.XFileStreamTimeoutSeconds = wrapperspb.Int32(180)
+	settings.XGraphqlRetryMax = wrapperspb.Int32(20)
+	settings.XGraphqlRetryWaitMinSeconds = wrapperspb.Int32(2)
+	settings.XGraphqlRetryWaitMaxSeconds = wrapperspb.Int32(60)
+	settings.XFileStreamRetryMax = wrapperspb.Int32(125)
+	settings.XFileStreamRetryWaitMinSeconds = wrapperspb.Int32(2)
+	settings.XFileStreamRetryWaitMaxSeconds = wrapperspb.Int32(60)
+	settings.XFileTransferRetryMax = wrapperspb.Int32(20)
+	settings.XFileTransferRetryWaitMinSeconds = wrapperspb.Int32(2)
+	settings.XFileTransferRetryWaitMaxSeconds = wrapperspb.Int32(60)
+	settings.XMirrorShutdownTimeoutSeconds = wrapperspb.Double(2)
+	settings.XMirrors = &service.MapStringKeyMapStringKeyStringValue{
+		Value: map[string]*service.MapStringKeyStringValue{
+			"down": {Value: map[string]string{
+				server.MirrorBaseURL: failing.URL,
+				server.MirrorAPIKey:  "failing-key",
+			}},
+			"stalled": {Value: map[string]string{
+				server.MirrorBaseURL: stalled.URL,
+				server.MirrorAPIKey:  "stalled-key",
+			}},
+			"up": {Value: map[string]string{
+				server.MirrorBaseURL: mirror.URL,
+				server.MirrorAPIKey:  "mirror-key",
+			}},
+		},
+	}
+	start := time.Now()
+	runMirroredStream(t, settings)
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	assert.Positive(t, failing.count())
+	for _, srv := range []*fakeServer{main, mirror} {
+		stream := srv.bodies("/files/entity/project/failing/file_stream")
+		assert.Contains(t, stream, `loss\":0.25`)
+		assert.Contains(t, stream, `"complete":true`)
+		assert.Equal(t, "notes", srv.bodies("/upload/notes.txt"))
+	}
+}
+
+func TestMirrorWithoutBaseURL(t *testing.T) {
+	main := newFakeServer(t, false)
+	settings := makeOnlineSettings(t, "nobase", main.URL)
+
+	_, err := server.NewMirror(context.Background(), nil, settings, "broken",
+		map[string]string{server.MirrorAPIKey: "key"})
+	assert.ErrorContains(t, err, `mirror: "broken" has no base_url`)
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxNetworkResponses is the number of failed responses kept between two
+// network status requests; older responses are dropped first.
+const maxNetworkResponses = 100
+
+// NetworkStatus keeps track of the failed HTTP responses seen by the sender's
+// clients so that they can be reported through a NetworkStatusRequest.
+//
+// It is safe for concurrent use, as responses are observed from the goroutines
+// of the http clients.
+type NetworkStatus struct {
+	mutex     sync.Mutex
+	responses []*service.HttpResponse
+}
+
+func NewNetworkStatus() *NetworkStatus {
+	return &NetworkStatus{}
+}
+
+// Observe records the response if it is an error response. It returns true if
+// the response was recorded, so that it can be used as the filter of
+// clients.WithRetryClientResponseLogger.
+func (ns *NetworkStatus) Observe(resp *http.Response) bool {
+	if resp.StatusCode < 400 {
+		return false
+	}
+
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	if len(ns.responses) >= maxNetworkResponses {
+		ns.responses = ns.responses[1:]
+	}
+	ns.responses = append(ns.responses, &service.HttpResponse{
+		HttpStatusCode:   int32(resp.StatusCode),
+		HttpResponseText: resp.Status,
+	})
+	return true
+}
+
+// Drain returns the responses recorded since the last call and forgets them.
+func (ns *NetworkStatus) Drain() []*service.HttpResponse {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	responses := ns.responses
+	ns.responses = nil
+	return responses
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PauseSubsystem is a part of the stream that stops while the run is paused.
+type PauseSubsystem string
+
+const (
+	// PauseSystemMonitor stops sampling system metrics.
+	PauseSystemMonitor PauseSubsystem = "system"
+	// PauseFileStream holds back filestream requests, including heartbeats.
+	PauseFileStream PauseSubsystem = "filestream"
+	// PauseUploads defers file uploads, such as those of LIVE files, until
+	// the run resumes.
+	PauseUploads PauseSubsystem = "uploads"
+	// PauseConsole holds back the console output streamed to the server; it is
+	// still written to the output file.
+	PauseConsole PauseSubsystem = "console"
+)
+
+var allPauseSubsystems = []PauseSubsystem{
+	PauseSystemMonitor,
+	PauseFileStream,
+	PauseUploads,
+	PauseConsole,
+}
+
+// PauseSubsystems is the set of subsystems that stop while the run is paused.
+type PauseSubsystems map[PauseSubsystem]bool
+
+// ParsePauseSubsystems returns the subsystems named by the _pause_subsystems
+// setting. All subsystems pause if none is named. Unknown names are reported
+// in the error and otherwise ignored.
+func ParsePauseSubsystems(names []string) (PauseSubsystems, error) {
+	subsystems := make(PauseSubsystems)
+	if len(names) == 0 {
+		for _, subsystem := range allPauseSubsystems {
+			subsystems[subsystem] = true
+		}
+		return subsystems, nil
+	}
+
+	var unknown []string
+	for _, name := range names {
+		subsystem := PauseSubsystem(strings.ToLower(strings.TrimSpace(name)))
+		switch subsystem {
+		case PauseSystemMonitor, PauseFileStream, PauseUploads, PauseConsole:
+			subsystems[subsystem] = true
+		default:
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return subsystems, fmt.Errorf("unknown pause subsystems: %s", strings.Join(unknown, ", "))
+	}
+	return subsystems, nil
+}
+
+// Has returns whether the subsystem pauses with the run.
+func (p PauseSubsystems) Has(subsystem PauseSubsystem) bool {
+	return p[subsystem]
+}
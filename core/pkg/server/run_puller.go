@@ -0,0 +1,339 @@
+package server
+
+import (
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// The fields of a run that can be edited both by the client and on the server
+const (
+	RunFieldDisplayName = "display_name"
+	RunFieldNotes       = "notes"
+	RunFieldGroup       = "group"
+	RunFieldTags        = "tags"
+)
+
+var runFields = []string{RunFieldDisplayName, RunFieldNotes, RunFieldGroup, RunFieldTags}
+
+// RunMetadata is the part of a run that can be edited on the server.
+type RunMetadata struct {
+	DisplayName string
+	Notes       string
+	Group       string
+	Tags        []string
+
+	// UpdatedAt is when the run was last changed on the server, if known
+	UpdatedAt time.Time
+}
+
+// NewRunMetadata returns the metadata of a run record.
+func NewRunMetadata(run *service.RunRecord) *RunMetadata {
+	return &RunMetadata{
+		DisplayName: run.GetDisplayName(),
+		Notes:       run.GetNotes(),
+		Group:       run.GetRunGroup(),
+		Tags:        slices.Clone(run.GetTags()),
+	}
+}
+
+func (m *RunMetadata) equal(other *RunMetadata, field string) bool {
+	switch field {
+	case RunFieldDisplayName:
+		return m.DisplayName == other.DisplayName
+	case RunFieldNotes:
+		return m.Notes == other.Notes
+	case RunFieldGroup:
+		return m.Group == other.Group
+	case RunFieldTags:
+		return slices.Equal(m.Tags, other.Tags)
+	}
+	return true
+}
+
+// edit returns the edit that sets field to its value in m
+func (m *RunMetadata) edit(field string) *service.RunEdit {
+	edit := &service.RunEdit{Field: field}
+	switch field {
+	case RunFieldDisplayName:
+		edit.Value = m.DisplayName
+	case RunFieldNotes:
+		edit.Value = m.Notes
+	case RunFieldGroup:
+		edit.Value = m.Group
+	case RunFieldTags:
+		edit.Tags = slices.Clone(m.Tags)
+	}
+	return edit
+}
+
+// apply sets the field of an edit
+func (m *RunMetadata) apply(edit *service.RunEdit) {
+	switch edit.GetField() {
+	case RunFieldDisplayName:
+		m.DisplayName = edit.GetValue()
+	case RunFieldNotes:
+		m.Notes = edit.GetValue()
+	case RunFieldGroup:
+		m.Group = edit.GetValue()
+	case RunFieldTags:
+		m.Tags = slices.Clone(edit.GetTags())
+	}
+}
+
+// applyUpdate sets the fields changed by an upsert
+func (m *RunMetadata) applyUpdate(update *RunUpdate) {
+	if update.DisplayName != nil {
+		m.DisplayName = *update.DisplayName
+	}
+	if update.Notes != nil {
+		m.Notes = *update.Notes
+	}
+	if update.Group != nil {
+		m.Group = *update.Group
+	}
+	if update.Tags != nil {
+		m.Tags = slices.Clone(update.Tags)
+	}
+}
+
+type RunPullerOption func(*RunPuller)
+
+// WithRunPullerInterval sets the time between two pulls; pulls only happen
+// on request if it is not positive.
+func WithRunPullerInterval(interval time.Duration) RunPullerOption {
+	return func(p *RunPuller) {
+		p.interval = interval
+	}
+}
+
+// WithRunPullerClock sets the clock of the puller, used in tests.
+func WithRunPullerClock(now func() time.Time) RunPullerOption {
+	return func(p *RunPuller) {
+		p.now = now
+	}
+}
+
+// RunPull is the result of reading the run from the server.
+type RunPull struct {
+	metadata *RunMetadata
+	err      error
+}
+
+// RunPuller reads the run from the server at an interval, to learn about the
+// changes made there while the run is running, such as a new name or new tags
+// given in the UI.
+//
+// The run is read off the loop of its owner, which would otherwise wait for
+// the server to answer: a pull is started by Debounce or Pull, and its result
+// is sent on the Pulled channel, to be given back to Merge.
+//
+// A field changed on the server since the last pull is merged with the
+// changes of the client with the last writer winning: the server's value
+// replaces the client's unless the client changed the field after the
+// server, in which case the client's change is upserted over it. The edits
+// taken from the server are kept until the client asks for them.
+//
+// A nil puller never pulls, see NewSender.
+type RunPuller struct {
+	logger *observability.CoreLogger
+	pull   func() (*RunMetadata, error)
+	apply  func(*service.RunEdit)
+	now    func() time.Time
+
+	interval time.Duration
+	nextPull time.Time
+
+	// pulled receives the result of the pull in flight
+	pulled chan *RunPull
+
+	// pulling is whether a pull is in flight
+	pulling bool
+
+	// waiting are called once the pull in flight is merged
+	waiting []func()
+
+	// remote is the run as last read from or written to the server, nil
+	// until the run is created
+	remote *RunMetadata
+
+	// changed are the times of the changes of the client that were not
+	// upserted yet, by field
+	changed map[string]time.Time
+
+	// edits are the edits taken from the server that the client was not
+	// told about yet
+	edits []*service.RunEdit
+}
+
+// NewRunPuller returns a puller that reads the run with pull, and applies
+// the edits taken from the server with apply. Pull is called on its own
+// goroutine, apply on the goroutine that calls Merge.
+func NewRunPuller(
+	logger *observability.CoreLogger,
+	pull func() (*RunMetadata, error),
+	apply func(*service.RunEdit),
+	opts ...RunPullerOption,
+) *RunPuller {
+	p := &RunPuller{
+		logger:  logger,
+		pull:    pull,
+		apply:   apply,
+		now:     time.Now,
+		pulled:  make(chan *RunPull, 1),
+		changed: make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Start starts pulling the run, which was created on the server as remote.
+func (p *RunPuller) Start(remote *RunMetadata) {
+	if p == nil {
+		return
+	}
+	p.remote = remote
+	p.nextPull = p.now().Add(p.interval)
+}
+
+// Changed records that the client changed a field.
+func (p *RunPuller) Changed(field string) {
+	if p == nil {
+		return
+	}
+	p.changed[field] = p.now()
+}
+
+// Upserted records the changes that were written to the server.
+func (p *RunPuller) Upserted(update *RunUpdate) {
+	if p == nil || p.remote == nil {
+		return
+	}
+	p.remote.applyUpdate(update)
+	if update.DisplayName != nil {
+		delete(p.changed, RunFieldDisplayName)
+	}
+	if update.Notes != nil {
+		delete(p.changed, RunFieldNotes)
+	}
+	if update.Group != nil {
+		delete(p.changed, RunFieldGroup)
+	}
+	if update.Tags != nil {
+		delete(p.changed, RunFieldTags)
+	}
+}
+
+// Debounce starts a pull if the interval has passed since the last one.
+func (p *RunPuller) Debounce() {
+	if p == nil || p.remote == nil || p.interval <= 0 || p.now().Before(p.nextPull) {
+		return
+	}
+	p.start()
+}
+
+// Pull starts reading the run from the server now, unless a pull is already
+// in flight, and calls done once the edits made there are merged. Done is
+// called right away if the run cannot be pulled.
+func (p *RunPuller) Pull(done func()) {
+	if p == nil || p.remote == nil {
+		if done != nil {
+			done()
+		}
+		return
+	}
+	if done != nil {
+		p.waiting = append(p.waiting, done)
+	}
+	p.start()
+}
+
+// Pulled returns the channel on which the results of the pulls are sent; it
+// is nil for a nil puller, so that receiving from it blocks forever.
+func (p *RunPuller) Pulled() <-chan *RunPull {
+	if p == nil {
+		return nil
+	}
+	return p.pulled
+}
+
+// Wait merges the pull in flight, if any, once it is done.
+func (p *RunPuller) Wait() {
+	if p == nil || !p.pulling {
+		return
+	}
+	p.Merge(<-p.pulled)
+}
+
+// start reads the run off the caller's goroutine
+func (p *RunPuller) start() {
+	if p.pulling {
+		return
+	}
+	p.pulling = true
+	p.nextPull = p.now().Add(p.interval)
+	go func() {
+		metadata, err := p.pull()
+		p.pulled <- &RunPull{metadata: metadata, err: err}
+	}()
+}
+
+// Merge applies the edits made on the server that a pull found.
+func (p *RunPuller) Merge(result *RunPull) {
+	if p == nil || result == nil {
+		return
+	}
+	p.pulling = false
+	defer func() {
+		waiting := p.waiting
+		p.waiting = nil
+		for _, done := range waiting {
+			done()
+		}
+	}()
+
+	if result.err != nil {
+		p.logger.Error("run puller: pull failed", "error", result.err)
+		return
+	}
+	metadata := result.metadata
+	if metadata == nil {
+		return
+	}
+	editedAt := metadata.UpdatedAt
+	if editedAt.IsZero() {
+		editedAt = p.now()
+	}
+
+	for _, field := range runFields {
+		if metadata.equal(p.remote, field) {
+			continue
+		}
+		edit := metadata.edit(field)
+		p.remote.apply(edit)
+		if changedAt, ok := p.changed[field]; ok && changedAt.After(editedAt) {
+			p.logger.Info("run puller: keeping the client's later change", "field", field)
+			continue
+		}
+		delete(p.changed, field)
+		edit.EditedAt = timestamppb.New(editedAt)
+		p.apply(edit)
+		p.edits = append(p.edits, edit)
+	}
+}
+
+// Edits returns the edits taken from the server since the last call.
+func (p *RunPuller) Edits() []*service.RunEdit {
+	if p == nil {
+		return nil
+	}
+	edits := p.edits
+	p.edits = nil
+	return edits
+}
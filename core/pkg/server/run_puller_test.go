@@ -0,0 +1,452 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+type pullRecorder struct {
+	metadata *server.RunMetadata
+	err      error
+	pulls    int
+	applied  []*service.RunEdit
+}
+
+func (r *pullRecorder) pull() (*server.RunMetadata, error) {
+	r.pulls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.metadata, nil
+}
+
+func (r *pullRecorder) apply(edit *service.RunEdit) {
+	r.applied = append(r.applied, edit)
+}
+
+func makeRunPuller(recorder *pullRecorder, clock *fakeClock) *server.RunPuller {
+	return server.NewRunPuller(
+		observability.NewNoOpLogger(),
+		recorder.pull,
+		recorder.apply,
+		server.WithRunPullerInterval(10*time.Second),
+		server.WithRunPullerClock(clock.Now),
+	)
+}
+
+// pullNow pulls the run and merges the result, as the sender loop does
+func pullNow(puller *server.RunPuller) {
+	puller.Pull(nil)
+	puller.Wait()
+}
+
+func TestRunPullerInterval(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &pullRecorder{err: errors.New("server error")}
+	puller := makeRunPuller(recorder, clock)
+
+	// nothing is pulled before the run is created
+	done := false
+	puller.Debounce()
+	puller.Pull(func() { done = true })
+	puller.Wait()
+	assert.Zero(t, recorder.pulls)
+	assert.True(t, done)
+
+	puller.Start(&server.RunMetadata{DisplayName: "run"})
+	puller.Debounce()
+	puller.Wait()
+	assert.Zero(t, recorder.pulls)
+
+	// a pull in flight is not started again
+	clock.Advance(10 * time.Second)
+	puller.Debounce()
+	puller.Debounce()
+	puller.Wait()
+	puller.Debounce()
+	puller.Wait()
+	assert.Equal(t, 1, recorder.pulls)
+
+	// a failed pull waits for the next interval
+	clock.Advance(10 * time.Second)
+	puller.Debounce()
+	puller.Wait()
+	assert.Equal(t, 2, recorder.pulls)
+	assert.Empty(t, recorder.applied)
+}
+
+func TestRunPullerPullsOffTheCaller(t *testing.T) {
+	release := make(chan struct{})
+	puller := server.NewRunPuller(
+		observability.NewNoOpLogger(),
+		func() (*server.RunMetadata, error) {
+			<-release
+			return &server.RunMetadata{DisplayName: "from the UI"}, nil
+		},
+		func(*service.RunEdit) {},
+	)
+	puller.Start(&server.RunMetadata{DisplayName: "run"})
+
+	done := 0
+	puller.Pull(func() { done++ })
+	puller.Pull(func() { done++ })
+	assert.Zero(t, done)
+	assert.Empty(t, puller.Edits())
+
+	close(release)
+	puller.Merge(<-puller.Pulled())
+	assert.Equal(t, 2, done)
+	require.Len(t, puller.Edits(), 1)
+}
+
+func TestRunPullerNil(t *testing.T) {
+	var puller *server.RunPuller
+
+	done := false
+	puller.Start(&server.RunMetadata{})
+	puller.Debounce()
+	puller.Pull(func() { done = true })
+	puller.Wait()
+	assert.True(t, done)
+	assert.Nil(t, puller.Pulled())
+	assert.Empty(t, puller.Edits())
+}
+
+func TestRunPullerTakesServerEdits(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &pullRecorder{}
+	puller := makeRunPuller(recorder, clock)
+	puller.Start(&server.RunMetadata{DisplayName: "run", Tags: []string{"a"}})
+
+	// the client renamed the run before it was renamed on the server
+	puller.Changed(server.RunFieldDisplayName)
+	clock.Advance(time.Second)
+	recorder.metadata = &server.RunMetadata{
+		DisplayName: "renamed",
+		Notes:       "from the UI",
+		Tags:        []string{"a"},
+		UpdatedAt:   time.Unix(1001, 0),
+	}
+	pullNow(puller)
+
+	assert.Len(t, recorder.applied, 2)
+	edits := puller.Edits()
+	assert.Equal(t, recorder.applied, edits)
+	assert.Equal(t, server.RunFieldDisplayName, edits[0].GetField())
+	assert.Equal(t, "renamed", edits[0].GetValue())
+	assert.Equal(t, time.Unix(1001, 0), edits[0].GetEditedAt().AsTime().Local())
+	assert.Equal(t, server.RunFieldNotes, edits[1].GetField())
+	assert.Empty(t, puller.Edits())
+
+	// the same values are not edits again
+	pullNow(puller)
+	assert.Len(t, recorder.applied, 2)
+}
+
+func TestRunPullerKeepsLaterClientChanges(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &pullRecorder{}
+	puller := makeRunPuller(recorder, clock)
+	puller.Start(&server.RunMetadata{Group: "group"})
+
+	clock.Advance(5 * time.Second)
+	puller.Changed(server.RunFieldGroup)
+	puller.Changed(server.RunFieldTags)
+	recorder.metadata = &server.RunMetadata{
+		Group:     "from the UI",
+		Tags:      []string{"ui"},
+		UpdatedAt: time.Unix(1002, 0),
+	}
+	pullNow(puller)
+	assert.Empty(t, recorder.applied)
+
+	// once upserted, the client's changes are what the server has, and a
+	// later edit on the server is taken
+	group := "client"
+	puller.Upserted(&server.RunUpdate{Group: &group, Tags: []string{"client"}})
+	recorder.metadata = &server.RunMetadata{
+		Group:     "client",
+		Tags:      []string{"ui", "again"},
+		UpdatedAt: time.Unix(1010, 0),
+	}
+	pullNow(puller)
+	require.Len(t, recorder.applied, 1)
+	assert.Equal(t, server.RunFieldTags, recorder.applied[0].GetField())
+	assert.Equal(t, []string{"ui", "again"}, recorder.applied[0].GetTags())
+}
+
+// fakeRunServer is a GraphQL server with one run, which can be edited as
+// it would be in the UI
+type fakeRunServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	run       map[string]interface{}
+	updatedAt time.Time
+	upserts   []map[string]interface{}
+	pulls     int
+
+	// release holds the pulls of the run until it is closed, if set
+	release chan struct{}
+}
+
+func newFakeRunServer(t *testing.T) *fakeRunServer {
+	fs := &fakeRunServer{run: map[string]interface{}{"tags": []interface{}{}}}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			OperationName string                 `json:"operationName"`
+			Variables     map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if request.OperationName == "RunMetadata" && fs.release != nil {
+			<-fs.release
+		}
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+		var data interface{}
+		switch request.OperationName {
+		case "UpsertBucket":
+			fs.upserts = append(fs.upserts, request.Variables)
+			for _, key := range []string{"displayName", "notes", "groupName", "tags"} {
+				if value, ok := request.Variables[key]; ok && value != nil {
+					fs.run[key] = value
+				}
+			}
+			data = map[string]interface{}{"upsertBucket": map[string]interface{}{
+				"bucket": map[string]interface{}{
+					"id":          "id",
+					"name":        request.Variables["name"],
+					"displayName": fs.run["displayName"],
+					"project": map[string]interface{}{
+						"name":   request.Variables["project"],
+						"entity": map[string]interface{}{"name": request.Variables["entity"]},
+					},
+				},
+			}}
+		case "RunMetadata":
+			fs.pulls++
+			data = map[string]interface{}{"project": map[string]interface{}{
+				"run": map[string]interface{}{
+					"displayName": fs.run["displayName"],
+					"notes":       fs.run["notes"],
+					"group":       fs.run["groupName"],
+					"tags":        fs.run["tags"],
+					"updatedAt":   fs.updatedAt.Format(time.RFC3339Nano),
+				},
+			}}
+		default:
+			data = map[string]interface{}{}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+// edit changes a field of the run at the given time, as the UI does
+func (fs *fakeRunServer) edit(key string, value interface{}, at time.Time) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.run[key] = value
+	fs.updatedAt = at
+}
+
+func (fs *fakeRunServer) get(key string) interface{} {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.run[key]
+}
+
+func makeRunRecord(run *service.RunRecord) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Run{Run: run},
+		Control:    &service.Control{MailboxSlot: "run"},
+	}
+}
+
+func (fs *fakeRunServer) getPulls() int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.pulls
+}
+
+// loopSender is a sender running its loop on the records sent to it
+type loopSender struct {
+	*server.Sender
+	inChan chan *service.Record
+}
+
+func startSender(t *testing.T, fs *fakeRunServer, settings *service.Settings) *loopSender {
+	ctx, cancel := context.WithCancel(context.Background())
+	settings.RunId = wrapperspb.String("run1")
+	sender := server.NewSender(
+		ctx,
+		cancel,
+		observability.NewNoOpLogger(),
+		settings,
+		server.WithSenderFwdChannel(make(chan *service.Record, 1)),
+		server.WithSenderOutChannel(make(chan *service.Result, 1)),
+	)
+	sender.SetGraphqlClient(graphql.NewClient(fs.URL+"/graphql", fs.Client()))
+	inChan := make(chan *service.Record, 1)
+	go sender.Do(inChan)
+	t.Cleanup(func() { close(inChan) })
+	return &loopSender{Sender: sender, inChan: inChan}
+}
+
+// startPullingSender starts a sender that pulls the run on request
+func startPullingSender(t *testing.T, fs *fakeRunServer) *loopSender {
+	return startSender(t, fs, &service.Settings{XRunPullIntervalSeconds: wrapperspb.Double(0)})
+}
+
+func (s *loopSender) send(record *service.Record) {
+	s.inChan <- record
+}
+
+func (s *loopSender) result() *service.Result {
+	return <-s.GetOutboundChannel()
+}
+
+func (s *loopSender) requestRunEdits(pull bool) *service.RunEditsResponse {
+	s.send(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_RunEdits{RunEdits: &service.RunEditsRequest{Pull: pull}},
+	}))
+	return s.result().GetResponse().GetRunEditsResponse()
+}
+
+func flushDebouncer() *service.Record {
+	return makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Defer{
+			Defer: &service.DeferRequest{State: service.DeferRequest_FLUSH_DEBOUNCER},
+		},
+	})
+}
+
+func TestSenderPullsRunEdits(t *testing.T) {
+	fs := newFakeRunServer(t)
+	sender := startPullingSender(t, fs)
+
+	sender.send(makeRunRecord(&service.RunRecord{
+		RunId:       "run1",
+		Project:     "project",
+		Entity:      "entity",
+		DisplayName: proto.String("local"),
+		Tags:        []string{"a"},
+	}))
+	assert.Equal(t, "local", sender.result().GetRunResult().GetRun().GetDisplayName())
+	assert.Empty(t, sender.requestRunEdits(true).GetEdits())
+
+	// the client renames the run, then it is renamed in the UI
+	sender.send(makeRunRecord(&service.RunRecord{RunId: "run1", DisplayName: proto.String("client"), Tags: []string{"a"}}))
+	sender.result()
+	sender.requestRunEdits(false)
+	fs.edit("displayName", "from the UI", time.Now().Add(time.Second))
+	fs.edit("tags", []interface{}{"a", "b"}, time.Now().Add(time.Second))
+
+	response := sender.requestRunEdits(true)
+	require.Len(t, response.GetEdits(), 2)
+	assert.Equal(t, server.RunFieldDisplayName, response.GetEdits()[0].GetField())
+	assert.Equal(t, "from the UI", response.GetEdits()[0].GetValue())
+	assert.Equal(t, []string{"a", "b"}, response.GetEdits()[1].GetTags())
+	assert.Equal(t, "from the UI", response.GetRun().GetDisplayName())
+
+	// the client's copy of the run is out of date, only its new notes are
+	// a change
+	sender.send(makeRunRecord(&service.RunRecord{
+		RunId:       "run1",
+		DisplayName: proto.String("client"),
+		Notes:       proto.String("notes"),
+		Tags:        []string{"a"},
+	}))
+	sender.result()
+	sender.send(flushDebouncer())
+	sender.requestRunEdits(false)
+
+	fs.mu.Lock()
+	upserts := fs.upserts
+	fs.mu.Unlock()
+	// the rename of the client was upserted before the run was renamed in
+	// the UI
+	require.Len(t, upserts, 3)
+	assert.Equal(t, "client", upserts[1]["displayName"])
+	assert.Equal(t, "notes", upserts[2]["notes"])
+	assert.Nil(t, upserts[2]["displayName"])
+	assert.Nil(t, upserts[2]["tags"])
+	assert.Equal(t, "from the UI", fs.get("displayName"))
+	assert.Equal(t, "notes", fs.get("notes"))
+}
+
+func TestSenderKeepsLaterClientChanges(t *testing.T) {
+	fs := newFakeRunServer(t)
+	sender := startPullingSender(t, fs)
+
+	sender.send(makeRunRecord(&service.RunRecord{RunId: "run1", Project: "project", DisplayName: proto.String("run"), RunGroup: "group"}))
+	sender.result()
+
+	// the group was changed in the UI before the client changed it
+	fs.edit("groupName", "from the UI", time.Now().Add(-time.Minute))
+	sender.send(makeRunRecord(&service.RunRecord{RunId: "run1", RunGroup: "client"}))
+	sender.result()
+
+	response := sender.requestRunEdits(true)
+	assert.Empty(t, response.GetEdits())
+	assert.Equal(t, "client", response.GetRun().GetRunGroup())
+
+	sender.send(flushDebouncer())
+	assert.Empty(t, sender.requestRunEdits(true).GetEdits())
+	assert.Equal(t, "client", fs.get("groupName"))
+}
+
+func TestSenderPullsRunOffTheLoop(t *testing.T) {
+	fs := newFakeRunServer(t)
+	fs.release = make(chan struct{})
+	sender := startPullingSender(t, fs)
+
+	sender.send(makeRunRecord(&service.RunRecord{RunId: "run1", Project: "project", DisplayName: proto.String("run")}))
+	sender.result()
+	fs.edit("displayName", "from the UI", time.Now().Add(time.Second))
+
+	// the sender answers other requests while the run is pulled
+	sender.send(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_RunEdits{RunEdits: &service.RunEditsRequest{Pull: true}},
+	}))
+	assert.Empty(t, sender.requestRunEdits(false).GetEdits())
+
+	close(fs.release)
+	response := sender.result().GetResponse().GetRunEditsResponse()
+	require.Len(t, response.GetEdits(), 1)
+	assert.Equal(t, "from the UI", response.GetRun().GetDisplayName())
+}
+
+func TestSenderDoesNotPullByDefault(t *testing.T) {
+	fs := newFakeRunServer(t)
+	sender := startSender(t, fs, &service.Settings{})
+
+	sender.send(makeRunRecord(&service.RunRecord{RunId: "run1", Project: "project", DisplayName: proto.String("run")}))
+	sender.result()
+	fs.edit("displayName", "from the UI", time.Now().Add(time.Second))
+
+	response := sender.requestRunEdits(true)
+	assert.Empty(t, response.GetEdits())
+	assert.Equal(t, "run", response.GetRun().GetDisplayName())
+	assert.Zero(t, fs.getPulls())
+}
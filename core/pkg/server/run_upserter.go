@@ -0,0 +1,200 @@
+package server
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+const (
+	// defaultRunUpsertInterval is the default minimum time between two upserts
+	defaultRunUpsertInterval = 30 * time.Second
+	// defaultRunUpsertBurst is the default number of upserts allowed at once
+	defaultRunUpsertBurst = 1
+	// maxRunUpsertBackoff caps the wait after failed upserts
+	maxRunUpsertBackoff = 5 * time.Minute
+)
+
+// RunUpdate is a change to a run sent with an UpsertBucket mutation. Nil
+// fields are left unchanged; to clear the tags, set them to an empty slice,
+// and to clear the name or the notes, set them to an empty string.
+type RunUpdate struct {
+	// Config is true if the config or the telemetry changed; the current
+	// config is sent
+	Config      bool
+	DisplayName *string
+	Notes       *string
+	Group       *string
+	Tags        []string
+	Commit      *string
+	Repo        *string
+	Host        *string
+}
+
+// merge applies a later update on top of this one
+func (u *RunUpdate) merge(other *RunUpdate) {
+	u.Config = u.Config || other.Config
+	if other.DisplayName != nil {
+		u.DisplayName = other.DisplayName
+	}
+	if other.Notes != nil {
+		u.Notes = other.Notes
+	}
+	if other.Group != nil {
+		u.Group = other.Group
+	}
+	if other.Tags != nil {
+		u.Tags = other.Tags
+	}
+	if other.Commit != nil {
+		u.Commit = other.Commit
+	}
+	if other.Repo != nil {
+		u.Repo = other.Repo
+	}
+	if other.Host != nil {
+		u.Host = other.Host
+	}
+}
+
+// discard drops the change of a field, one of the RunField constants
+func (u *RunUpdate) discard(field string) {
+	switch field {
+	case RunFieldDisplayName:
+		u.DisplayName = nil
+	case RunFieldNotes:
+		u.Notes = nil
+	case RunFieldGroup:
+		u.Group = nil
+	case RunFieldTags:
+		u.Tags = nil
+	}
+}
+
+func (u *RunUpdate) empty() bool {
+	return !u.Config && u.DisplayName == nil && u.Notes == nil && u.Group == nil && u.Tags == nil &&
+		u.Commit == nil && u.Repo == nil && u.Host == nil
+}
+
+type RunUpserterOption func(*RunUpserter)
+
+// WithRunUpserterRate sets the minimum time between two upserts and the
+// number of upserts allowed at once.
+func WithRunUpserterRate(interval time.Duration, burst int) RunUpserterOption {
+	return func(u *RunUpserter) {
+		u.interval = interval
+		u.limiter = rate.NewLimiter(rate.Every(interval), burst)
+	}
+}
+
+// WithRunUpserterClock sets the clock of the upserter, used in tests.
+func WithRunUpserterClock(now func() time.Time) RunUpserterOption {
+	return func(u *RunUpserter) {
+		u.now = now
+	}
+}
+
+// RunUpserter coalesces the changes to a run, such as its config, tags or
+// name, into as few UpsertBucket mutations as the rate limit allows.
+//
+// Changes are merged until the next upsert. A failed upsert keeps its
+// changes, and the next attempt waits for a backoff that doubles with each
+// failure. Flush sends the pending changes regardless of the rate limit and
+// the backoff.
+type RunUpserter struct {
+	logger *observability.CoreLogger
+	upsert func(*RunUpdate) error
+	now    func() time.Time
+
+	interval time.Duration
+	limiter  *rate.Limiter
+
+	pending *RunUpdate
+
+	backoff     time.Duration
+	nextAttempt time.Time
+}
+
+func NewRunUpserter(
+	logger *observability.CoreLogger,
+	upsert func(*RunUpdate) error,
+	opts ...RunUpserterOption,
+) *RunUpserter {
+	u := &RunUpserter{
+		logger: logger,
+		upsert: upsert,
+		now:    time.Now,
+	}
+	WithRunUpserterRate(defaultRunUpsertInterval, defaultRunUpsertBurst)(u)
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// Update adds changes to the next upsert.
+func (u *RunUpserter) Update(update *RunUpdate) {
+	if u == nil {
+		return
+	}
+	if u.pending == nil {
+		u.pending = &RunUpdate{}
+	}
+	u.pending.merge(update)
+}
+
+// Discard drops the pending change of a field, such as one replaced by a
+// later edit on the server.
+func (u *RunUpserter) Discard(field string) {
+	if u == nil || u.pending == nil {
+		return
+	}
+	u.pending.discard(field)
+	if u.pending.empty() {
+		u.pending = nil
+	}
+}
+
+// Debounce sends the pending changes if the rate limit and the backoff allow it.
+func (u *RunUpserter) Debounce() {
+	if u == nil || u.pending == nil {
+		return
+	}
+	now := u.now()
+	if now.Before(u.nextAttempt) || !u.limiter.AllowN(now, 1) {
+		return
+	}
+	_ = u.send()
+}
+
+// Flush sends the pending changes now. It returns the error of a failed
+// upsert, whose changes are kept for the next attempt.
+func (u *RunUpserter) Flush() error {
+	if u == nil || u.pending == nil {
+		return nil
+	}
+	u.logger.Debug("run upserter: flushing")
+	return u.send()
+}
+
+func (u *RunUpserter) send() error {
+	update := u.pending
+	u.pending = nil
+	err := u.upsert(update)
+	if err != nil {
+		// keep the changes, under any made since
+		if u.pending != nil {
+			update.merge(u.pending)
+		}
+		u.pending = update
+		u.backoff = min(max(2*u.backoff, u.interval, time.Second), maxRunUpsertBackoff)
+		u.nextAttempt = u.now().Add(u.backoff)
+		u.logger.Error("run upserter: upsert failed", "error", err, "backoff", u.backoff)
+		return err
+	}
+	u.backoff = 0
+	u.nextAttempt = time.Time{}
+	return nil
+}
@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+type upsertRecorder struct {
+	updates []*server.RunUpdate
+	err     error
+}
+
+func (r *upsertRecorder) upsert(update *server.RunUpdate) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.updates = append(r.updates, update)
+	return nil
+}
+
+func makeRunUpserter(recorder *upsertRecorder, clock *fakeClock) *server.RunUpserter {
+	return server.NewRunUpserter(
+		observability.NewNoOpLogger(),
+		recorder.upsert,
+		server.WithRunUpserterRate(10*time.Second, 1),
+		server.WithRunUpserterClock(clock.Now),
+	)
+}
+
+func TestRunUpserterCoalesces(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &upsertRecorder{}
+	upserter := makeRunUpserter(recorder, clock)
+
+	// nothing to send
+	upserter.Debounce()
+	assert.Empty(t, recorder.updates)
+
+	upserter.Update(&server.RunUpdate{Config: true})
+	upserter.Debounce()
+	assert.Len(t, recorder.updates, 1)
+
+	// changes within the rate limit are merged into the next upsert
+	first, second := "first", "second"
+	upserter.Update(&server.RunUpdate{DisplayName: &first})
+	upserter.Update(&server.RunUpdate{Tags: []string{"a"}})
+	upserter.Update(&server.RunUpdate{Config: true, DisplayName: &second})
+	upserter.Debounce()
+	assert.Len(t, recorder.updates, 1)
+
+	clock.Advance(10 * time.Second)
+	upserter.Debounce()
+	assert.Len(t, recorder.updates, 2)
+	assert.Equal(t, &server.RunUpdate{
+		Config:      true,
+		DisplayName: &second,
+		Tags:        []string{"a"},
+	}, recorder.updates[1])
+}
+
+func TestRunUpserterBacksOff(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &upsertRecorder{err: errors.New("server error")}
+	upserter := makeRunUpserter(recorder, clock)
+
+	notes := "notes"
+	upserter.Update(&server.RunUpdate{Notes: &notes})
+	upserter.Debounce()
+
+	// the first backoff is the rate limit interval, the retry fails again
+	clock.Advance(10 * time.Second)
+	upserter.Debounce()
+	assert.Empty(t, recorder.updates)
+
+	// the backoff doubles after each failure
+	clock.Advance(19 * time.Second)
+	recorder.err = nil
+	upserter.Update(&server.RunUpdate{Config: true})
+	upserter.Debounce()
+	assert.Empty(t, recorder.updates)
+
+	clock.Advance(time.Second)
+	upserter.Debounce()
+	assert.Equal(t, []*server.RunUpdate{{Config: true, Notes: &notes}}, recorder.updates)
+}
+
+func TestRunUpserterFlush(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &upsertRecorder{}
+	upserter := makeRunUpserter(recorder, clock)
+
+	upserter.Update(&server.RunUpdate{Config: true})
+	upserter.Debounce()
+	upserter.Update(&server.RunUpdate{Tags: []string{}})
+
+	// flushing ignores the rate limit
+	upserter.Flush()
+	assert.Len(t, recorder.updates, 2)
+	assert.Equal(t, []string{}, recorder.updates[1].Tags)
+
+	upserter.Flush()
+	assert.Len(t, recorder.updates, 2)
+
+	// a failed flush is reported, and its changes are kept
+	recorder.err = errors.New("server error")
+	upserter.Update(&server.RunUpdate{Config: true})
+	assert.Error(t, upserter.Flush())
+	recorder.err = nil
+	assert.NoError(t, upserter.Flush())
+	assert.Len(t, recorder.updates, 3)
+}
+
+func TestRunUpserterDiscard(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	recorder := &upsertRecorder{}
+	upserter := makeRunUpserter(recorder, clock)
+
+	name, notes := "name", "notes"
+	upserter.Update(&server.RunUpdate{DisplayName: &name, Notes: &notes})
+	upserter.Discard(server.RunFieldDisplayName)
+	upserter.Flush()
+	assert.Equal(t, []*server.RunUpdate{{Notes: &notes}}, recorder.updates)
+
+	// nothing is sent once all the changes are discarded
+	upserter.Update(&server.RunUpdate{Tags: []string{"a"}})
+	upserter.Discard(server.RunFieldTags)
+	upserter.Flush()
+	assert.Len(t, recorder.updates, 1)
+}
@@ -4,23 +4,26 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/segmentio/encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/go-retryablehttp"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/corelib"
-	"github.com/wandb/wandb/core/internal/debounce"
+	"github.com/wandb/wandb/core/internal/digestcache"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/gqlcache"
 	"github.com/wandb/wandb/core/internal/version"
 	"github.com/wandb/wandb/core/pkg/artifacts"
 	fs "github.com/wandb/wandb/core/pkg/filestream"
@@ -31,9 +34,7 @@ import (
 
 const (
 	// RFC3339Micro Modified from time.RFC3339Nano
-	RFC3339Micro             = "2006-01-02T15:04:05.000000Z07:00"
-	configDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
-	configDebouncerBurstSize = 1        // todo: audit burst size
+	RFC3339Micro = "2006-01-02T15:04:05.000000Z07:00"
 )
 
 type SenderOption func(*Sender)
@@ -50,6 +51,38 @@ func WithSenderOutChannel(out chan *service.Result) SenderOption {
 	}
 }
 
+// WithSenderFileTransferProgress sets the tracker of the progress of the
+// file transfers, which the handler reports.
+func WithSenderFileTransferProgress(progress *filetransfer.ProgressTracker) SenderOption {
+	return func(s *Sender) {
+		s.fileTransferProgress = progress
+	}
+}
+
+// WithSenderSendOnly makes the sender only send the run, without writing to
+// the run directory, which belongs to the main sender; it is used by mirrors.
+func WithSenderSendOnly() SenderOption {
+	return func(s *Sender) {
+		s.sendOnly = true
+	}
+}
+
+// WithSenderConfigTimeline sets the timeline of the config changes, which
+// the sender seeds with the config of a resumed run.
+func WithSenderConfigTimeline(timeline *ConfigTimeline) SenderOption {
+	return func(s *Sender) {
+		s.configTimeline = timeline
+	}
+}
+
+// WithSenderConfigTracker sets the tracker of the config values, which the
+// sender loads with the config of a resumed run.
+func WithSenderConfigTracker(tracker *ConfigTracker) SenderOption {
+	return func(s *Sender) {
+		s.configTracker = tracker
+	}
+}
+
 // Sender is the sender for a stream it handles the incoming messages and sends to the server
 // or/and to the dispatcher/handler
 type Sender struct {
@@ -74,12 +107,29 @@ type Sender struct {
 	// graphqlClient is the graphql client
 	graphqlClient graphql.Client
 
+	// graphqlStats are the statistics of the graphql operations of the stream
+	graphqlStats *gqlcache.Stats
+
 	// fileStream is the file stream
 	fileStream *fs.FileStream
 
 	// filetransfer is the file uploader/downloader
 	fileTransferManager *filetransfer.FileTransferManager
 
+	// fileTransferProgress tracks the progress of the file transfers
+	fileTransferProgress *filetransfer.ProgressTracker
+
+	// filePolicy decides what happens to the symlinks and special files to
+	// upload
+	filePolicy *filetransfer.FilePolicy
+
+	// digestCache remembers the digests of the files hashed for artifacts
+	digestCache *digestcache.Cache
+
+	// httpClients are the clients of the sender, whose idle connections are
+	// closed with it
+	httpClients []*retryablehttp.Client
+
 	// RunRecord is the run record
 	RunRecord *service.RunRecord
 
@@ -90,7 +140,15 @@ type Sender struct {
 
 	metricSender *MetricSender
 
-	configDebouncer *debounce.Debouncer
+	// runUpserter coalesces the updates of the run, such as config or tags changes
+	runUpserter *RunUpserter
+
+	// runPuller reads the edits made to the run on the server
+	runPuller *RunPuller
+
+	// clientRun is the run as last sent by the client, which may not have
+	// the edits made on the server
+	clientRun *service.RunRecord
 
 	// Keep track of summary which is being updated incrementally
 	summaryMap map[string]*service.SummaryItem
@@ -98,6 +156,20 @@ type Sender struct {
 	// Keep track of config which is being updated incrementally
 	configMap map[string]interface{}
 
+	// configTimeline keeps the changes of the config values, shared with the
+	// handler
+	configTimeline *ConfigTimeline
+
+	// configTracker keeps the current config values, shared with the handler
+	configTracker *ConfigTracker
+
+	// wg waits for the requests running off the sender loop, which respond
+	// on outChan
+	wg sync.WaitGroup
+
+	// sendOnly is true if the sender must not write to the run directory
+	sendOnly bool
+
 	// Info about the (local) server we are talking to
 	serverInfo *gql.ServerInfoServerInfo
 
@@ -107,6 +179,24 @@ type Sender struct {
 	syncService *SyncService
 
 	store *Store
+
+	// networkStatus keeps track of failed http responses
+	networkStatus *NetworkStatus
+
+	// historyLimiter thins out the streamed history, nil if there is no limit
+	historyLimiter *HistoryLimiter
+
+	// pauseSubsystems are the subsystems that stop while the run is paused
+	pauseSubsystems PauseSubsystems
+
+	// paused is true while the run is paused
+	paused bool
+
+	// pausedOutput is the console output held back while paused
+	pausedOutput []*service.Record
+
+	// pausedFiles are the files whose upload is deferred until the run resumes
+	pausedFiles []*service.FilesItem
 }
 
 // NewSender creates a new Sender with the given settings
@@ -126,6 +216,19 @@ func NewSender(
 		summaryMap: make(map[string]*service.SummaryItem),
 		configMap:  make(map[string]interface{}),
 		telemetry:  &service.TelemetryRecord{CoreVersion: version.Version},
+
+		networkStatus: NewNetworkStatus(),
+	}
+	for _, opt := range opts {
+		opt(sender)
+	}
+	symlinkPolicy, err := filetransfer.ParseSymlinkPolicy(settings.GetXSymlinkPolicy().GetValue())
+	if err != nil {
+		logger.CaptureError("sender: invalid symlink policy, following symlinks", err)
+	}
+	sender.filePolicy = &filetransfer.FilePolicy{
+		Symlinks: symlinkPolicy,
+		Root:     settings.GetFilesDir().GetValue(),
 	}
 	if !settings.GetXOffline().GetValue() {
 		baseHeaders := map[string]string{
@@ -140,9 +243,7 @@ func NewSender(
 				settings.GetXExtraHttpHeaders().GetValue(),
 			),
 			clients.WithRetryClientRetryPolicy(clients.CheckRetry),
-			clients.WithRetryClientResponseLogger(logger.Logger, func(resp *http.Response) bool {
-				return resp.StatusCode >= 400
-			}),
+			clients.WithRetryClientResponseLogger(logger.Logger, sender.networkStatus.Observe),
 			clients.WithRetryClientRetryMax(int(settings.GetXGraphqlRetryMax().GetValue())),
 			clients.WithRetryClientRetryWaitMin(time.Duration(settings.GetXGraphqlRetryWaitMinSeconds().GetValue()*int32(time.Second))),
 			clients.WithRetryClientRetryWaitMax(time.Duration(settings.GetXGraphqlRetryWaitMaxSeconds().GetValue()*int32(time.Second))),
@@ -150,13 +251,24 @@ func NewSender(
 			clients.WithRetryClientBackoff(clients.ExponentialBackoffWithJitter),
 		)
 		url := fmt.Sprintf("%s/graphql", settings.GetBaseUrl().GetValue())
-		sender.graphqlClient = graphql.NewClient(url, graphqlRetryClient.StandardClient())
+		ttls, err := gqlcache.ParseTTLs(settings.GetXGraphqlCacheTtls().GetValue())
+		if err != nil {
+			logger.CaptureError("sender: invalid graphql cache TTLs, using the defaults", err)
+		}
+		sender.graphqlStats = gqlcache.NewStats()
+		sender.graphqlClient = gqlcache.NewClient(
+			graphql.NewClient(url, graphqlRetryClient.StandardClient()),
+			gqlcache.WithCache(gqlcache.Default),
+			gqlcache.WithNamespace(url, settings.GetApiKey().GetValue()),
+			gqlcache.WithTTLs(ttls),
+			gqlcache.WithStats(sender.graphqlStats),
+		)
+
+		sender.httpClients = append(sender.httpClients, graphqlRetryClient)
 
 		fileStreamRetryClient := clients.NewRetryClient(
 			clients.WithRetryClientLogger(logger),
-			clients.WithRetryClientResponseLogger(logger.Logger, func(resp *http.Response) bool {
-				return resp.StatusCode >= 400
-			}),
+			clients.WithRetryClientResponseLogger(logger.Logger, sender.networkStatus.Observe),
 			clients.WithRetryClientRetryMax(int(settings.GetXFileStreamRetryMax().GetValue())),
 			clients.WithRetryClientRetryWaitMin(time.Duration(settings.GetXFileStreamRetryWaitMinSeconds().GetValue()*int32(time.Second))),
 			clients.WithRetryClientRetryWaitMax(time.Duration(settings.GetXFileStreamRetryWaitMaxSeconds().GetValue()*int32(time.Second))),
@@ -166,6 +278,7 @@ func NewSender(
 			// TODO(core:beta): add custom retry function
 			// retryClient.CheckRetry = fs.GetCheckRetryFunc()
 		)
+		sender.httpClients = append(sender.httpClients, fileStreamRetryClient)
 		sender.fileStream = fs.NewFileStream(
 			fs.WithSettings(settings),
 			fs.WithLogger(logger),
@@ -180,27 +293,54 @@ func NewSender(
 			clients.WithRetryClientHttpTimeout(time.Duration(settings.GetXFileTransferTimeoutSeconds().GetValue()*int32(time.Second))),
 			clients.WithRetryClientBackoff(clients.ExponentialBackoffWithJitter),
 		)
+		sender.httpClients = append(sender.httpClients, fileTransferRetryClient)
 		defaultFileTransfer := filetransfer.NewDefaultFileTransfer(
 			logger,
 			fileTransferRetryClient,
+			filetransfer.WithFilePolicy(sender.filePolicy),
 		)
 		sender.fileTransferManager = filetransfer.NewFileTransferManager(
 			filetransfer.WithLogger(logger),
 			filetransfer.WithSettings(settings),
 			filetransfer.WithFileTransfer(defaultFileTransfer),
 			filetransfer.WithFSCChan(sender.fileStream.GetInputChan()),
+			filetransfer.WithProgressTracker(sender.fileTransferProgress),
 		)
 
-		sender.getServerInfo()
+		// the server info is only reported to the client, which does not
+		// hear from send-only senders
+		if !sender.sendOnly {
+			sender.getServerInfo()
+		}
 	}
-	sender.configDebouncer = debounce.NewDebouncer(
-		configDebouncerRateLimit,
-		configDebouncerBurstSize,
-		logger,
-	)
-
-	for _, opt := range opts {
-		opt(sender)
+	if path, err := digestcache.DefaultPath(); err == nil {
+		sender.digestCache = digestcache.New(path)
+	} else {
+		logger.Warn("sender: no digest cache, files are hashed every time", "error", err)
+	}
+	// the limit is on the rate the run logs at, a synced run is replayed
+	// much faster than it was logged
+	if limit := settings.GetXHistoryRateLimit().GetValue(); limit > 0 && !settings.GetXSync().GetValue() {
+		policy, err := ParseHistoryLimitPolicy(settings.GetXHistoryRateLimitPolicy().GetValue())
+		if err != nil {
+			logger.CaptureError("sender: invalid history rate limit policy, dropping rows", err)
+		}
+		sender.historyLimiter = NewHistoryLimiter(int(limit), policy)
+	}
+	// the handler reports invalid subsystems
+	sender.pauseSubsystems, _ = ParsePauseSubsystems(settings.GetXPauseSubsystems().GetValue())
+	var upserterOpts []RunUpserterOption
+	if seconds := settings.GetXRunUpsertIntervalSeconds().GetValue(); seconds > 0 {
+		burst := max(int(settings.GetXRunUpsertBurst().GetValue()), defaultRunUpsertBurst)
+		upserterOpts = append(upserterOpts,
+			WithRunUpserterRate(time.Duration(seconds*float64(time.Second)), burst))
+	}
+	sender.runUpserter = NewRunUpserter(logger, sender.upsertRun, upserterOpts...)
+	// runs are only pulled if asked to, since every pull is a query
+	if settings.XRunPullIntervalSeconds != nil {
+		seconds := settings.GetXRunPullIntervalSeconds().GetValue()
+		sender.runPuller = NewRunPuller(logger, sender.pullRun, sender.applyRunEdit,
+			WithRunPullerInterval(time.Duration(seconds*float64(time.Second))))
 	}
 
 	return sender
@@ -211,18 +351,52 @@ func (s *Sender) Do(inChan <-chan *service.Record) {
 	defer s.logger.Reraise()
 	s.logger.Info("sender: started", "stream_id", s.settings.RunId)
 
-	for record := range inChan {
-		s.sendRecord(record)
-		// TODO: reevaluate the logic here
-		s.configDebouncer.Debounce(s.upsertConfig)
+loop:
+	for {
+		select {
+		case record, ok := <-inChan:
+			if !ok {
+				break loop
+			}
+			s.sendRecord(record)
+			// TODO: reevaluate the logic here
+			s.runUpserter.Debounce()
+			s.runPuller.Debounce()
+		case pull := <-s.runPuller.Pulled():
+			s.runPuller.Merge(pull)
+		}
 	}
+	s.runPuller.Wait()
 	s.Close()
 	s.logger.Info("sender: closed", "stream_id", s.settings.RunId)
 }
 
 func (s *Sender) Close() {
-	// sender is done processing data, close our dispatch channel
+	// sender is done processing data, close our dispatch channel once the
+	// requests running off the sender loop have responded
+	s.wg.Wait()
 	close(s.outChan)
+	// the connections kept alive for the next requests would outlive the
+	// stream otherwise
+	for _, client := range s.httpClients {
+		client.HTTPClient.CloseIdleConnections()
+	}
+	s.logGraphqlStats()
+}
+
+// logGraphqlStats logs the latency and errors of each graphql operation of
+// the stream
+func (s *Sender) logGraphqlStats() {
+	for _, op := range s.graphqlStats.Operations() {
+		s.logger.Debug("sender: graphql operation",
+			"op", op.Op,
+			"count", op.Count,
+			"errors", op.Errors,
+			"cache_hits", op.CacheHits,
+			"mean_latency", op.MeanLatency(),
+			"max_latency", op.MaxLatency,
+		)
+	}
 }
 
 func (s *Sender) GetOutboundChannel() chan *service.Result {
@@ -251,6 +425,8 @@ func (s *Sender) sendRecord(record *service.Record) {
 		s.sendExit(record, x.Exit)
 	case *service.Record_Alert:
 		s.sendAlert(record, x.Alert)
+	case *service.Record_Event:
+		s.sendEvent(record, x.Event)
 	case *service.Record_Metric:
 		s.sendMetric(record, x.Metric)
 	case *service.Record_Files:
@@ -275,6 +451,7 @@ func (s *Sender) sendRecord(record *service.Record) {
 		s.sendLinkArtifact(record)
 	case *service.Record_UseArtifact:
 	case *service.Record_Artifact:
+		s.sendArtifact(record, x.Artifact)
 	case nil:
 		err := fmt.Errorf("sender: sendRecord: nil RecordType")
 		s.logger.CaptureFatalAndPanic("sender: sendRecord: nil RecordType", err)
@@ -290,8 +467,16 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 	switch x := request.RequestType.(type) {
 	case *service.Request_RunStart:
 		s.sendRunStart(x.RunStart)
+	case *service.Request_Pause:
+		s.sendPause(x.Pause)
+	case *service.Request_Resume:
+		s.sendResume(x.Resume)
 	case *service.Request_NetworkStatus:
-		s.sendNetworkStatusRequest(x.NetworkStatus)
+		s.sendNetworkStatusRequest(record, x.NetworkStatus)
+	case *service.Request_StopStatus:
+		s.sendStopStatusRequest(record, x.StopStatus)
+	case *service.Request_RunEdits:
+		s.sendRunEditsRequest(record, x.RunEdits)
 	case *service.Request_Defer:
 		s.sendDefer(x.Defer)
 	case *service.Request_LogArtifact:
@@ -340,7 +525,97 @@ func (s *Sender) sendRunStart(_ *service.RunStartRequest) {
 	s.fileTransferManager.Start()
 }
 
-func (s *Sender) sendNetworkStatusRequest(_ *service.NetworkStatusRequest) {
+// sendPause stops the network activity of the paused subsystems
+func (s *Sender) sendPause(_ *service.PauseRequest) {
+	if s.paused {
+		return
+	}
+	s.paused = true
+	if s.pauseSubsystems.Has(PauseFileStream) {
+		s.fileStream.Pause()
+	}
+}
+
+// sendResume resumes the subsystems stopped by sendPause, and sends the data
+// held back in the meantime
+func (s *Sender) sendResume(_ *service.ResumeRequest) {
+	if !s.paused {
+		return
+	}
+	s.paused = false
+	s.fileStream.Resume()
+	for _, record := range s.pausedOutput {
+		s.fileStream.StreamRecord(record)
+	}
+	s.pausedOutput = nil
+	if len(s.pausedFiles) > 0 {
+		files := &service.FilesRecord{Files: s.pausedFiles}
+		s.pausedFiles = nil
+		s.sendFiles(nil, files)
+	}
+}
+
+// sendNetworkStatusRequest responds with the failed http responses seen
+// since the previous network status request
+func (s *Sender) sendNetworkStatusRequest(record *service.Record, _ *service.NetworkStatusRequest) {
+	result := &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_NetworkStatusResponse{
+					NetworkStatusResponse: &service.NetworkStatusResponse{
+						NetworkResponses: s.networkStatus.Drain(),
+					},
+				},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+	s.outChan <- result
+}
+
+// sendStopStatusRequest checks with the server whether the run was stopped
+// from the UI. Offline runs are never stopped. The query runs off the sender
+// loop, which it would otherwise hold up while the server is slow to answer.
+func (s *Sender) sendStopStatusRequest(record *service.Record, _ *service.StopStatusRequest) {
+	if s.graphqlClient == nil || s.RunRecord == nil {
+		s.respondStopStatus(record, false)
+		return
+	}
+	project, entity, runID := s.RunRecord.Project, s.RunRecord.Entity, s.RunRecord.RunId
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		runShouldStop := false
+		data, err := gql.RunStoppedStatus(s.ctx, s.graphqlClient, &project, &entity, runID)
+		switch {
+		case err != nil:
+			err = fmt.Errorf("sender: sendStopStatusRequest: failed to get run stopped status: %s", err)
+			s.logger.CaptureError("sender received error", err)
+		case data.GetProject() != nil && data.GetProject().GetRun() != nil:
+			if stopped := data.GetProject().GetRun().GetStopped(); stopped != nil {
+				runShouldStop = *stopped
+			}
+		}
+		s.respondStopStatus(record, runShouldStop)
+	}()
+}
+
+func (s *Sender) respondStopStatus(record *service.Record, runShouldStop bool) {
+	result := &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_StopStatusResponse{
+					StopStatusResponse: &service.StopStatusResponse{
+						RunShouldStop: runShouldStop,
+					},
+				},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+	s.outChan <- result
 }
 
 func (s *Sender) sendDefer(request *service.DeferRequest) {
@@ -355,6 +630,7 @@ func (s *Sender) sendDefer(request *service.DeferRequest) {
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_PARTIAL_HISTORY:
+		s.flushHistoryLimiter()
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_TB:
@@ -364,7 +640,9 @@ func (s *Sender) sendDefer(request *service.DeferRequest) {
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_DEBOUNCER:
-		s.configDebouncer.Flush(s.upsertConfig)
+		if err := s.runUpserter.Flush(); err != nil {
+			s.logger.CaptureError("sender: failed to send the last run updates", err)
+		}
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_OUTPUT:
@@ -558,7 +836,61 @@ func (s *Sender) checkAndUpdateResumeState(record *service.Record) error {
 	return nil
 }
 
+// checkResumedConfig compares the config of a resumed run with the config the
+// run is resumed with: values may only change if allow_val_change is set on
+// the config or in the settings
+func (s *Sender) checkResumedConfig(record *service.Record, config *service.ConfigRecord) error {
+	if !s.RunRecord.GetResumed() {
+		return nil
+	}
+	tracker := NewConfigTracker()
+	tracker.Load(s.configMap)
+	conflicts := tracker.Conflicts(config.GetUpdate())
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if config.GetAllowValChange() || s.settings.GetAllowValChange().GetValue() {
+		s.logger.Warn("sender: resumed run config values changed", "keys", conflicts)
+		return nil
+	}
+
+	err := fmt.Errorf(
+		"resumed run config values differ for keys %s, use allow_val_change to allow it",
+		strings.Join(conflicts, ", "),
+	)
+	s.sendRunResult(record, &service.RunUpdateResult{
+		Error: &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_USAGE,
+		},
+	})
+	return err
+}
+
+// resumeConfigTimeline seeds the config timeline with the config of the
+// resumed run, before the config the run is resumed with is merged into it
+func (s *Sender) resumeConfigTimeline() {
+	changes := s.configTimeline.Resume(s.configMap, s.RunRecord.GetStartingStep())
+	if len(changes) == 0 {
+		return
+	}
+	s.fwdChan <- &service.Record{
+		RecordType: &service.Record_Files{
+			Files: &service.FilesRecord{
+				Files: []*service.FilesItem{
+					{Path: ConfigTimelineFileName, Policy: service.FilesItem_END},
+				},
+			},
+		},
+	}
+}
+
 func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
+	// the run exists already, its changes go with the other run updates
+	if s.RunRecord != nil && s.graphqlClient != nil {
+		s.sendRunUpdate(record, run)
+		return
+	}
 
 	if s.RunRecord == nil && s.graphqlClient != nil {
 		var ok bool
@@ -567,11 +899,20 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 			err := fmt.Errorf("failed to clone RunRecord")
 			s.logger.CaptureFatalAndPanic("sender: sendRun: ", err)
 		}
+		s.clientRun, _ = proto.Clone(run).(*service.RunRecord)
 
 		if err := s.checkAndUpdateResumeState(record); err != nil {
 			s.logger.Error("sender: sendRun: failed to checkAndUpdateResumeState", "error", err)
 			return
 		}
+		if err := s.checkResumedConfig(record, run.Config); err != nil {
+			s.logger.Error("sender: sendRun: failed to checkResumedConfig", "error", err)
+			return
+		}
+		if s.RunRecord.GetResumed() {
+			s.resumeConfigTimeline()
+			s.configTracker.Load(s.configMap)
+		}
 	}
 
 	if s.graphqlClient != nil {
@@ -596,27 +937,27 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		// this is used to pass the retry function to the graphql client
 		ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
 		data, err := gql.UpsertBucket(
-			ctx,                              // ctx
-			s.graphqlClient,                  // client
-			nil,                              // id
-			&run.RunId,                       // name
-			utils.NilIfZero(run.Project),     // project
-			utils.NilIfZero(run.Entity),      // entity
-			utils.NilIfZero(run.RunGroup),    // groupName
-			nil,                              // description
-			utils.NilIfZero(run.DisplayName), // displayName
-			utils.NilIfZero(run.Notes),       // notes
-			utils.NilIfZero(commit),          // commit
-			&config,                          // config
-			utils.NilIfZero(run.Host),        // host
-			nil,                              // debug
-			utils.NilIfZero(program),         // program
-			utils.NilIfZero(repo),            // repo
-			utils.NilIfZero(run.JobType),     // jobType
-			nil,                              // state
-			nil,                              // sweep
-			tags,                             // tags []string,
-			nil,                              // summaryMetrics
+			ctx,                                   // ctx
+			s.graphqlClient,                       // client
+			nil,                                   // id
+			&run.RunId,                            // name
+			utils.NilIfZero(run.Project),          // project
+			utils.NilIfZero(run.Entity),           // entity
+			utils.NilIfZero(run.RunGroup),         // groupName
+			nil,                                   // description
+			utils.NilIfZero(run.GetDisplayName()), // displayName
+			utils.NilIfZero(run.GetNotes()),       // notes
+			utils.NilIfZero(commit),               // commit
+			&config,                               // config
+			utils.NilIfZero(run.Host),             // host
+			nil,                                   // debug
+			utils.NilIfZero(program),              // program
+			utils.NilIfZero(repo),                 // repo
+			utils.NilIfZero(run.JobType),          // jobType
+			nil,                                   // state
+			nil,                                   // sweep
+			tags,                                  // tags []string,
+			nil,                                   // summaryMetrics
 		)
 		if err != nil {
 			err = fmt.Errorf("failed to upsert bucket: %s", err)
@@ -641,9 +982,10 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 			return
 		}
 
-		s.RunRecord.DisplayName = *data.UpsertBucket.Bucket.DisplayName
+		s.RunRecord.DisplayName = data.UpsertBucket.Bucket.DisplayName
 		s.RunRecord.Project = data.UpsertBucket.Bucket.Project.Name
 		s.RunRecord.Entity = data.UpsertBucket.Bucket.Project.Entity.Name
+		s.runPuller.Start(NewRunMetadata(s.RunRecord))
 	}
 
 	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
@@ -662,10 +1004,110 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 	}
 }
 
+// sendRunUpdate queues the changes of an existing run, such as a new name or
+// new tags, for the run upserter.
+//
+// The client sends the whole run with each change, so a field is only changed
+// if the client changed it since it last sent the run: the other fields may
+// be out of date with the edits made on the server.
+func (s *Sender) sendRunUpdate(record *service.Record, run *service.RunRecord) {
+	if s.clientRun == nil {
+		s.clientRun = &service.RunRecord{}
+	}
+	client := s.clientRun
+	update := &RunUpdate{}
+	// the name and the notes are cleared when they are set to an empty string
+	if run.DisplayName != nil && (client.DisplayName == nil || run.GetDisplayName() != client.GetDisplayName()) {
+		client.DisplayName = proto.String(run.GetDisplayName())
+		if run.GetDisplayName() != s.RunRecord.GetDisplayName() {
+			s.RunRecord.DisplayName = proto.String(run.GetDisplayName())
+			update.DisplayName = s.RunRecord.DisplayName
+			s.runPuller.Changed(RunFieldDisplayName)
+		}
+	}
+	if run.Notes != nil && (client.Notes == nil || run.GetNotes() != client.GetNotes()) {
+		client.Notes = proto.String(run.GetNotes())
+		if run.GetNotes() != s.RunRecord.GetNotes() {
+			s.RunRecord.Notes = proto.String(run.GetNotes())
+			update.Notes = s.RunRecord.Notes
+			s.runPuller.Changed(RunFieldNotes)
+		}
+	}
+	if run.RunGroup != "" && run.RunGroup != client.RunGroup {
+		client.RunGroup = run.RunGroup
+		if run.RunGroup != s.RunRecord.RunGroup {
+			s.RunRecord.RunGroup = run.RunGroup
+			update.Group = &s.RunRecord.RunGroup
+			s.runPuller.Changed(RunFieldGroup)
+		}
+	}
+	if run.Tags != nil && !slices.Equal(run.Tags, client.Tags) {
+		client.Tags = slices.Clone(run.Tags)
+		if !slices.Equal(run.Tags, s.RunRecord.Tags) {
+			s.RunRecord.Tags = slices.Clone(run.Tags)
+			update.Tags = s.RunRecord.Tags
+			s.runPuller.Changed(RunFieldTags)
+		}
+	}
+	if git := run.GetGit(); git != nil && !proto.Equal(git, client.GetGit()) {
+		client.Git, _ = proto.Clone(git).(*service.GitRepoRecord)
+		s.RunRecord.Git, _ = proto.Clone(git).(*service.GitRepoRecord)
+		update.Commit = utils.NilIfZero(git.GetCommit())
+		update.Repo = utils.NilIfZero(git.GetRemoteUrl())
+	}
+	if run.Host != "" && run.Host != client.Host {
+		client.Host = run.Host
+		s.RunRecord.Host = run.Host
+		update.Host = utils.NilIfZero(run.Host)
+	}
+	if run.Config != nil {
+		s.updateConfig(run.Config)
+		update.Config = true
+	}
+	if run.Telemetry != nil {
+		proto.Merge(s.telemetry, run.Telemetry)
+		s.updateConfigPrivate(run.Telemetry)
+		update.Config = true
+	}
+	s.runUpserter.Update(update)
+
+	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
+		s.sendRunResult(record, &service.RunUpdateResult{Run: s.RunRecord})
+	}
+}
+
 // sendHistory sends a history record to the file stream,
 // which will then send it to the server
-func (s *Sender) sendHistory(record *service.Record, _ *service.HistoryRecord) {
-	s.fileStream.StreamRecord(record)
+func (s *Sender) sendHistory(record *service.Record, history *service.HistoryRecord) {
+	if s.historyLimiter == nil {
+		s.fileStream.StreamRecord(record)
+		return
+	}
+	for _, row := range s.historyLimiter.Process(history) {
+		if row == history {
+			s.fileStream.StreamRecord(record)
+			continue
+		}
+		s.fileStream.StreamRecord(&service.Record{
+			RecordType: &service.Record_History{History: row},
+		})
+	}
+}
+
+// flushHistoryLimiter streams the history row the limiter may be holding back
+func (s *Sender) flushHistoryLimiter() {
+	if s.historyLimiter == nil {
+		return
+	}
+	for _, row := range s.historyLimiter.Flush() {
+		s.fileStream.StreamRecord(&service.Record{
+			RecordType: &service.Record_History{History: row},
+		})
+	}
+	s.logger.Info("sender: history rate limit",
+		"dropped", s.historyLimiter.Dropped(),
+		"aggregated", s.historyLimiter.Aggregated(),
+	)
 }
 
 func (s *Sender) sendSummary(_ *service.Record, summary *service.SummaryRecord) {
@@ -697,11 +1139,21 @@ func (s *Sender) sendSummary(_ *service.Record, summary *service.SummaryRecord)
 	s.fileStream.StreamRecord(record)
 }
 
-func (s *Sender) upsertConfig() {
+// upsertRun sends the changes to the run with an UpsertBucket mutation; it is
+// called by the run upserter
+func (s *Sender) upsertRun(update *RunUpdate) error {
 	if s.graphqlClient == nil {
-		return
+		return nil
+	}
+	if s.RunRecord == nil {
+		return fmt.Errorf("sender: upsertRun: run not created yet")
+	}
+
+	var config *string
+	if update.Config {
+		serialized := s.serializeConfig()
+		config = &serialized
 	}
-	config := s.serializeConfig()
 
 	ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
 	_, err := gql.UpsertBucket(
@@ -711,24 +1163,110 @@ func (s *Sender) upsertConfig() {
 		&s.RunRecord.RunId,                   // name
 		utils.NilIfZero(s.RunRecord.Project), // project
 		utils.NilIfZero(s.RunRecord.Entity),  // entity
-		nil,                                  // groupName
+		update.Group,                         // groupName
 		nil,                                  // description
-		nil,                                  // displayName
-		nil,                                  // notes
-		nil,                                  // commit
-		&config,                              // config
-		nil,                                  // host
+		update.DisplayName,                   // displayName
+		update.Notes,                         // notes
+		update.Commit,                        // commit
+		config,                               // config
+		update.Host,                          // host
 		nil,                                  // debug
 		nil,                                  // program
-		nil,                                  // repo
+		update.Repo,                          // repo
 		nil,                                  // jobType
 		nil,                                  // state
 		nil,                                  // sweep
-		nil,                                  // tags []string,
+		update.Tags,                          // tags []string,
 		nil,                                  // summaryMetrics
 	)
 	if err != nil {
-		s.logger.Error("sender: sendConfig:", "error", err)
+		return err
+	}
+	s.runPuller.Upserted(update)
+	return nil
+}
+
+// pullRun reads the fields of the run that can be edited on the server; it
+// is called by the run puller off the sender loop, which is safe since the
+// path of the run is not changed once the run is created
+func (s *Sender) pullRun() (*RunMetadata, error) {
+	if s.graphqlClient == nil || s.RunRecord == nil {
+		return nil, nil
+	}
+	data, err := gql.RunMetadata(
+		s.ctx,
+		s.graphqlClient,
+		&s.RunRecord.Project,
+		&s.RunRecord.Entity,
+		s.RunRecord.RunId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sender: pullRun: %w", err)
+	}
+	run := data.GetProject().GetRun()
+	if run == nil {
+		return nil, nil
+	}
+	metadata := &RunMetadata{
+		DisplayName: utils.ZeroIfNil(run.GetDisplayName()),
+		Notes:       utils.ZeroIfNil(run.GetNotes()),
+		Group:       utils.ZeroIfNil(run.GetGroup()),
+		Tags:        run.GetTags(),
+	}
+	if updatedAt := run.GetUpdatedAt(); updatedAt != nil {
+		metadata.UpdatedAt = *updatedAt
+	}
+	return metadata, nil
+}
+
+// applyRunEdit applies an edit made on the server to the run, in place of
+// any pending change of the client; it is called by the run puller
+func (s *Sender) applyRunEdit(edit *service.RunEdit) {
+	s.logger.Info("sender: run edited on the server", "field", edit.GetField())
+	switch edit.GetField() {
+	case RunFieldDisplayName:
+		s.RunRecord.DisplayName = proto.String(edit.GetValue())
+	case RunFieldNotes:
+		s.RunRecord.Notes = proto.String(edit.GetValue())
+	case RunFieldGroup:
+		s.RunRecord.RunGroup = edit.GetValue()
+	case RunFieldTags:
+		s.RunRecord.Tags = slices.Clone(edit.GetTags())
+	}
+	s.runUpserter.Discard(edit.GetField())
+}
+
+// sendRunEditsRequest responds with the edits made to the run on the server
+// since the last request; a request to pull is answered once the pull is
+// merged
+func (s *Sender) sendRunEditsRequest(record *service.Record, request *service.RunEditsRequest) {
+	if request.GetPull() {
+		s.runPuller.Pull(func() { s.respondRunEdits(record) })
+		return
+	}
+	s.runPuller.Debounce()
+	s.respondRunEdits(record)
+}
+
+func (s *Sender) respondRunEdits(record *service.Record) {
+	response := &service.RunEditsResponse{Edits: s.runPuller.Edits()}
+	if s.RunRecord != nil {
+		response.Run = &service.RunRecord{
+			RunId:       s.RunRecord.RunId,
+			DisplayName: s.RunRecord.DisplayName,
+			Notes:       s.RunRecord.Notes,
+			RunGroup:    s.RunRecord.RunGroup,
+			Tags:        slices.Clone(s.RunRecord.Tags),
+		}
+	}
+	s.outChan <- &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_RunEditsResponse{RunEditsResponse: response},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
 	}
 }
 
@@ -738,7 +1276,7 @@ func (s *Sender) sendConfig(_ *service.Record, configRecord *service.ConfigRecor
 	if configRecord != nil {
 		s.updateConfig(configRecord)
 	}
-	s.configDebouncer.SetNeedsDebounce()
+	s.runUpserter.Update(&RunUpdate{Config: true})
 }
 
 // sendSystemMetrics sends a system metrics record via the file stream
@@ -746,6 +1284,10 @@ func (s *Sender) sendSystemMetrics(record *service.Record, _ *service.StatsRecor
 	s.fileStream.StreamRecord(record)
 }
 
+func (s *Sender) sendEvent(record *service.Record, _ *service.EventRecord) {
+	s.fileStream.StreamRecord(record)
+}
+
 func (s *Sender) sendOutputRaw(record *service.Record, _ *service.OutputRawRecord) {
 	// TODO: match logic handling of lines to the one in the python version
 	// - handle carriage returns (for tqdm-like progress bars)
@@ -761,28 +1303,39 @@ func (s *Sender) sendOutputRaw(record *service.Record, _ *service.OutputRawRecor
 		return
 	}
 
+	if !s.sendOnly {
+		s.writeOutputLine(outputRaw.Line)
+	}
+
+	// generate compatible timestamp to python iso-format (microseconds without Z)
+	t := strings.TrimSuffix(time.Now().UTC().Format(RFC3339Micro), "Z")
+	outputRaw.Line = fmt.Sprintf("%s %s", t, outputRaw.Line)
+	if outputRaw.OutputType == service.OutputRawRecord_STDERR {
+		outputRaw.Line = fmt.Sprintf("ERROR %s", outputRaw.Line)
+	}
+	if s.paused && s.pauseSubsystems.Has(PauseConsole) {
+		s.pausedOutput = append(s.pausedOutput, recordCopy)
+		return
+	}
+	s.fileStream.StreamRecord(recordCopy)
+}
+
+// writeOutputLine appends a line of the console output to the output file
+func (s *Sender) writeOutputLine(line string) {
 	outputFile := filepath.Join(s.settings.GetFilesDir().GetValue(), OutputFileName)
-	// append line to file
 	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
 		s.logger.Error("sender: sendOutputRaw: failed to open output file", "error", err)
-	}
-	if _, err := f.WriteString(outputRaw.Line + "\n"); err != nil {
-		s.logger.Error("sender: sendOutputRaw: failed to write to output file", "error", err)
+		return
 	}
 	defer func() {
 		if err := f.Close(); err != nil {
 			s.logger.Error("sender: sendOutputRaw: failed to close output file", "error", err)
 		}
 	}()
-
-	// generate compatible timestamp to python iso-format (microseconds without Z)
-	t := strings.TrimSuffix(time.Now().UTC().Format(RFC3339Micro), "Z")
-	outputRaw.Line = fmt.Sprintf("%s %s", t, outputRaw.Line)
-	if outputRaw.OutputType == service.OutputRawRecord_STDERR {
-		outputRaw.Line = fmt.Sprintf("ERROR %s", outputRaw.Line)
+	if _, err := f.WriteString(line + "\n"); err != nil {
+		s.logger.Error("sender: sendOutputRaw: failed to write to output file", "error", err)
 	}
-	s.fileStream.StreamRecord(recordCopy)
 }
 
 func (s *Sender) sendAlert(_ *service.Record, alert *service.AlertRecord) {
@@ -837,6 +1390,9 @@ func (s *Sender) sendExit(record *service.Record, _ *service.RunExitRecord) {
 	// response is done by respondExit() and called when defer state machine is complete
 	s.exitRecord = record
 
+	// a run that finishes while paused sends what it held back first
+	s.sendResume(nil)
+
 	s.fileStream.StreamRecord(record)
 
 	// send a defer request to the handler to indicate that the user requested to finish the stream
@@ -876,6 +1432,10 @@ func (s *Sender) sendMetric(record *service.Record, metric *service.MetricRecord
 // sendFiles iterates over the files in the FilesRecord and sends them to
 func (s *Sender) sendFiles(_ *service.Record, filesRecord *service.FilesRecord) {
 	files := filesRecord.GetFiles()
+	if s.paused && s.pauseSubsystems.Has(PauseUploads) {
+		s.deferFiles(files)
+		return
+	}
 	for _, file := range files {
 		if strings.HasPrefix(file.GetPath(), "media") {
 			s.sendFile(file.GetPath(), filetransfer.MediaFile)
@@ -885,6 +1445,19 @@ func (s *Sender) sendFiles(_ *service.Record, filesRecord *service.FilesRecord)
 	}
 }
 
+// deferFiles keeps the files to upload when the run resumes, once per path,
+// since live files may change many times during a pause
+func (s *Sender) deferFiles(files []*service.FilesItem) {
+	for _, file := range files {
+		if slices.ContainsFunc(s.pausedFiles, func(item *service.FilesItem) bool {
+			return item.GetPath() == file.GetPath()
+		}) {
+			continue
+		}
+		s.pausedFiles = append(s.pausedFiles, file)
+	}
+}
+
 // sendFile sends a file to the server
 func (s *Sender) sendFile(name string, fileType filetransfer.FileType) {
 	if s.graphqlClient == nil || s.fileTransferManager == nil {
@@ -907,7 +1480,7 @@ func (s *Sender) sendFile(name string, fileType filetransfer.FileType) {
 		task := &filetransfer.Task{Type: filetransfer.UploadTask, Path: fullPath, Name: file.Name, Url: *file.UploadUrl, FileType: fileType}
 
 		task.SetProgressCallback(
-			func(processed, total int) {
+			func(processed, total int64) {
 				if processed == 0 {
 					return
 				}
@@ -917,8 +1490,8 @@ func (s *Sender) sendFile(name string, fileType filetransfer.FileType) {
 							Type: service.FileTransferInfoRequest_Upload,
 							Path: fullPath,
 							// Url:       *file.UploadUrl,
-							Size:      int64(total),
-							Processed: int64(processed),
+							Size:      total,
+							Processed: processed,
 						},
 					},
 				}
@@ -968,14 +1541,17 @@ func (s *Sender) sendFile(name string, fileType filetransfer.FileType) {
 func (s *Sender) sendLogArtifact(record *service.Record, msg *service.LogArtifactRequest) {
 	var response service.LogArtifactResponse
 	saver := artifacts.NewArtifactSaver(
-		s.ctx, s.graphqlClient, s.fileTransferManager, msg.Artifact, msg.HistoryStep, msg.StagingDir,
+		s.ctx, s.graphqlClient, s.fileTransferManager, s.digestCache, msg.Artifact, msg.HistoryStep, msg.StagingDir,
 	)
+	saver.FilePolicy = s.filePolicy
 	artifactID, err := saver.Save()
 	if err != nil {
 		response.ErrorMessage = err.Error()
 	} else {
 		response.ArtifactId = artifactID
 	}
+	s.warnSkippedArtifactFiles(&saver)
+	s.saveDigestCache()
 
 	result := &service.Result{
 		ResultType: &service.Result_Response{
@@ -991,14 +1567,57 @@ func (s *Sender) sendLogArtifact(record *service.Record, msg *service.LogArtifac
 	s.outChan <- result
 }
 
+// sendArtifact saves an artifact of the transaction log, such as one logged
+// by an offline run, when the log is synced
+func (s *Sender) sendArtifact(_ *service.Record, msg *service.ArtifactRecord) {
+	if s.graphqlClient == nil {
+		return
+	}
+	artifact := proto.Clone(msg).(*service.ArtifactRecord)
+	// the run may be synced to another entity or project
+	if s.RunRecord != nil {
+		artifact.RunId = s.RunRecord.RunId
+		artifact.Entity = s.RunRecord.Entity
+		artifact.Project = s.RunRecord.Project
+	}
+	saver := artifacts.NewArtifactSaver(
+		s.ctx, s.graphqlClient, s.fileTransferManager, s.digestCache, artifact, 0, "",
+	)
+	saver.FilePolicy = s.filePolicy
+	if _, err := saver.Save(); err != nil {
+		s.logger.CaptureError("sender: sendArtifact: failed to save artifact", err)
+	}
+	s.warnSkippedArtifactFiles(&saver)
+	s.saveDigestCache()
+}
+
+// warnSkippedArtifactFiles reports the files the file policy left out of an
+// artifact, which was saved without them and with a digest of its own
+func (s *Sender) warnSkippedArtifactFiles(saver *artifacts.ArtifactSaver) {
+	if len(saver.Skipped) == 0 {
+		return
+	}
+	s.logger.CaptureWarn("sender: artifact saved without skipped files",
+		"artifact", saver.Artifact.GetName(), "paths", saver.Skipped)
+}
+
+// saveDigestCache writes the digests computed for an artifact to disk, so that
+// later runs do not hash the same unchanged files again
+func (s *Sender) saveDigestCache() {
+	if err := s.digestCache.Save(); err != nil {
+		s.logger.CaptureError("sender: failed to save digest cache", err)
+	}
+}
+
 func (s *Sender) sendDownloadArtifact(record *service.Record, msg *service.DownloadArtifactRequest) {
 	var response service.DownloadArtifactResponse
-	downloader := artifacts.NewArtifactDownloader(s.ctx, s.graphqlClient, s.fileTransferManager, msg.ArtifactId, msg.DownloadRoot, &msg.AllowMissingReferences)
+	downloader := artifacts.NewArtifactDownloader(s.ctx, s.graphqlClient, s.fileTransferManager, s.digestCache, msg.ArtifactId, msg.DownloadRoot, &msg.AllowMissingReferences)
 	err := downloader.Download()
 	if err != nil {
 		s.logger.CaptureError("senderError: downloadArtifact: failed to download artifact: %v", err)
 		response.ErrorMessage = err.Error()
 	}
+	s.saveDigestCache()
 
 	result := &service.Result{
 		ResultType: &service.Result_Response{
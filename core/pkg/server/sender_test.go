@@ -2,6 +2,12 @@ package server_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -9,9 +15,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/internal/coretest"
 	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -196,3 +204,426 @@ func TestSendLinkArtifact(t *testing.T) {
 	sender.SendRecord(linkArtifact)
 	<-sender.GetOutboundChannel()
 }
+
+func TestSendStopStatusRequest(t *testing.T) {
+	// Verify that the stopped status of the run is passed back in the
+	// response, and that the sender does not wait for it
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	sender := makeSender(to.MockClient, make(chan *service.Result, 1))
+	sender.RunRecord = &service.RunRecord{
+		RunId:   "run1",
+		Project: "testProject",
+		Entity:  "testEntity",
+	}
+
+	stopped := true
+	release := make(chan struct{})
+	respEncode := &graphql.Response{
+		Data: &gql.RunStoppedStatusResponse{
+			Project: &gql.RunStoppedStatusProject{
+				Run: &gql.RunStoppedStatusProjectRun{
+					Stopped: &stopped,
+				},
+			},
+		},
+	}
+
+	to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(
+		respEncode,
+		func(vars coretest.RequestVars) {
+			<-release
+			assert.Equal(t, "testEntity", vars["entityName"])
+			assert.Equal(t, "testProject", vars["projectName"])
+			assert.Equal(t, "run1", vars["runId"])
+		},
+	))
+
+	stopStatus := &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_StopStatus{
+					StopStatus: &service.StopStatusRequest{},
+				},
+			}},
+		Control: &service.Control{
+			MailboxSlot: "junk",
+		},
+	}
+
+	sender.SendRecord(stopStatus)
+	close(release)
+	result := <-sender.GetOutboundChannel()
+	assert.True(t, result.GetResponse().GetStopStatusResponse().GetRunShouldStop())
+}
+
+func TestSendFilesWhilePaused(t *testing.T) {
+	// Verify that uploads are deferred while the run is paused, and that each
+	// file is uploaded once when it resumes
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	sender := makeSender(to.MockClient, make(chan *service.Result, 1))
+	sender.RunRecord = &service.RunRecord{
+		RunId:   "run1",
+		Project: "testProject",
+		Entity:  "testEntity",
+	}
+
+	files := &service.Record{
+		RecordType: &service.Record_Files{
+			Files: &service.FilesRecord{
+				Files: []*service.FilesItem{{Path: "model.ckpt"}},
+			},
+		},
+	}
+
+	// no request is expected while paused
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Pause{Pause: &service.PauseRequest{}},
+	}))
+	sender.SendRecord(files)
+	sender.SendRecord(files)
+
+	to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(
+		&graphql.Response{Data: &gql.CreateRunFilesResponse{
+			CreateRunFiles: &gql.CreateRunFilesCreateRunFilesCreateRunFilesPayload{},
+		}},
+		func(vars coretest.RequestVars) {
+			assert.Equal(t, []interface{}{"model.ckpt"}, vars["files"])
+		},
+	)).Times(1)
+
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Resume{Resume: &service.ResumeRequest{}},
+	}))
+}
+
+func TestSendRunResumedConfigChange(t *testing.T) {
+	// Verify that resuming a run with a different config value fails and
+	// names the conflicting keys
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sender := server.NewSender(
+		ctx,
+		cancel,
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			RunId:  &wrapperspb.StringValue{Value: "run1"},
+			Resume: &wrapperspb.StringValue{Value: "allow"},
+		},
+		server.WithSenderFwdChannel(make(chan *service.Record, 1)),
+		server.WithSenderOutChannel(make(chan *service.Result, 1)),
+	)
+	sender.SetGraphqlClient(to.MockClient)
+
+	config := `{"lr": {"value": 0.1}, "epochs": {"value": 10}}`
+	respEncode := &graphql.Response{
+		Data: &gql.RunResumeStatusResponse{
+			Model: &gql.RunResumeStatusModelProject{
+				Bucket: createBucketRawData(0, 0, 0, nil, &config, nil, nil),
+			},
+		},
+	}
+	// only the resume status is requested, the run is not upserted
+	to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(respEncode, func(coretest.RequestVars) {})).Times(1)
+
+	run := &service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				RunId:   "run1",
+				Project: "testProject",
+				Config: &service.ConfigRecord{
+					Update: []*service.ConfigItem{
+						{Key: "epochs", ValueJson: "10"},
+						{Key: "lr", ValueJson: "0.2"},
+					},
+				},
+			}},
+		Control: &service.Control{
+			MailboxSlot: "junk",
+		},
+	}
+
+	sender.SendRecord(run)
+	result := <-sender.GetOutboundChannel()
+	runError := result.GetRunResult().GetError()
+	assert.Equal(t, service.ErrorInfo_USAGE, runError.GetCode())
+	assert.Contains(t, runError.GetMessage(), "lr")
+	assert.NotContains(t, runError.GetMessage(), "epochs")
+}
+
+func TestSendRunResumedConfigChangeAllowedByRecord(t *testing.T) {
+	// Verify that a resumed run may change config values if its config
+	// record allows it, that the change is in the config timeline, and that
+	// the config tracker is loaded with the resumed config
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outChan := make(chan *service.Result, 1)
+	fwdChan := make(chan *service.Record, 1)
+	settings := &service.Settings{
+		RunId:    &wrapperspb.StringValue{Value: "run1"},
+		Resume:   &wrapperspb.StringValue{Value: "allow"},
+		FilesDir: &wrapperspb.StringValue{Value: t.TempDir()},
+	}
+	// the handler has applied the config the run is resumed with
+	timeline := server.NewConfigTimeline(observability.NewNoOpLogger(), settings)
+	timeline.Update(&service.ConfigRecord{
+		Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}},
+	}, 0)
+	tracker := server.NewConfigTracker()
+	tracker.Update([]*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}})
+	sender := server.NewSender(
+		ctx,
+		cancel,
+		observability.NewNoOpLogger(),
+		settings,
+		server.WithSenderFwdChannel(fwdChan),
+		server.WithSenderOutChannel(outChan),
+		server.WithSenderConfigTimeline(timeline),
+		server.WithSenderConfigTracker(tracker),
+	)
+	sender.SetGraphqlClient(to.MockClient)
+
+	config := `{"lr": {"value": 0.1}, "epochs": {"value": 10}}`
+	gomock.InOrder(
+		to.MockClient.EXPECT().MakeRequest(
+			gomock.Any(), // context.Context
+			gomock.Any(), // *graphql.Request
+			gomock.Any(), // *graphql.Response
+		).Return(nil).Do(coretest.InjectResponse(
+			&graphql.Response{
+				Data: &gql.RunResumeStatusResponse{
+					Model: &gql.RunResumeStatusModelProject{
+						Bucket: createBucketRawData(0, 0, 0, nil, &config, nil, nil),
+					},
+				},
+			},
+			func(coretest.RequestVars) {},
+		)),
+		to.MockClient.EXPECT().MakeRequest(
+			gomock.Any(), // context.Context
+			gomock.Any(), // *graphql.Request
+			gomock.Any(), // *graphql.Response
+		).Return(nil).Do(coretest.InjectResponse(
+			&graphql.Response{
+				Data: &gql.UpsertBucketResponse{
+					UpsertBucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayload{
+						Bucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun{
+							DisplayName: coretest.StrPtr("FakeName"),
+							Project:     &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject{},
+						},
+					},
+				},
+			},
+			func(vars coretest.RequestVars) {
+				assert.Contains(t, vars["config"], "0.2")
+			},
+		)),
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				RunId:   "run1",
+				Project: "testProject",
+				Config: &service.ConfigRecord{
+					Update:         []*service.ConfigItem{{Key: "lr", ValueJson: "0.2"}},
+					AllowValChange: true,
+				},
+			}},
+		Control: &service.Control{
+			MailboxSlot: "junk",
+		},
+	})
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+
+	files := (<-fwdChan).GetFiles().GetFiles()
+	assert.Len(t, files, 1)
+	assert.Equal(t, server.ConfigTimelineFileName, files[0].GetPath())
+	lines := readLines(t, filepath.Join(settings.GetFilesDir().GetValue(), server.ConfigTimelineFileName))
+	assert.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"key":"lr","old":0.1,"new":0.2`)
+
+	conflicts := tracker.Conflicts([]*service.ConfigItem{
+		{Key: "lr", ValueJson: "0.2"},
+		{Key: "epochs", ValueJson: "20"},
+	})
+	assert.Equal(t, []string{"epochs"}, conflicts)
+}
+
+func TestSendRunUpdateIsCoalesced(t *testing.T) {
+	// Verify that updates of an existing run are sent in one upsert when the
+	// run upserter is flushed
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	sender := makeSender(to.MockClient, make(chan *service.Result, 1))
+	sender.RunRecord = &service.RunRecord{
+		RunId:   "run1",
+		Project: "testProject",
+		Entity:  "testEntity",
+	}
+
+	// no request is expected until the flush
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "run1", DisplayName: proto.String("first")},
+		},
+	})
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{RunId: "run1", DisplayName: proto.String("second"), Tags: []string{"a"}},
+		},
+	})
+
+	to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(
+		&graphql.Response{Data: &gql.UpsertBucketResponse{}},
+		func(vars coretest.RequestVars) {
+			assert.Equal(t, "second", vars["displayName"])
+			assert.Equal(t, []interface{}{"a"}, vars["tags"])
+			assert.Nil(t, vars["config"])
+		},
+	)).Times(1)
+
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Defer{
+			Defer: &service.DeferRequest{State: service.DeferRequest_FLUSH_DEBOUNCER},
+		},
+	}))
+}
+
+func TestSendRunUpdateClearsNotes(t *testing.T) {
+	// Verify that empty notes clear them, and that the git info, the host
+	// and the telemetry of an existing run are sent
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	sender := makeSender(to.MockClient, make(chan *service.Result, 1))
+	sender.RunRecord = &service.RunRecord{
+		RunId:   "run1",
+		Project: "testProject",
+		Entity:  "testEntity",
+		Notes:   proto.String("notes"),
+	}
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				RunId:     "run1",
+				Notes:     proto.String(""),
+				Host:      "host",
+				Git:       &service.GitRepoRecord{RemoteUrl: "repo", Commit: "abc"},
+				Telemetry: &service.TelemetryRecord{PythonVersion: "3.11"},
+			},
+		},
+	})
+
+	to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(
+		&graphql.Response{Data: &gql.UpsertBucketResponse{}},
+		func(vars coretest.RequestVars) {
+			assert.Equal(t, "", vars["notes"])
+			assert.Nil(t, vars["displayName"])
+			assert.Equal(t, "abc", vars["commit"])
+			assert.Equal(t, "repo", vars["repo"])
+			assert.Equal(t, "host", vars["host"])
+			assert.Contains(t, vars["config"], "3.11")
+		},
+	)).Times(1)
+
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Defer{
+			Defer: &service.DeferRequest{State: service.DeferRequest_FLUSH_DEBOUNCER},
+		},
+	}))
+}
+
+// sendHistoryRows streams rows of history through an online sender with a
+// history rate limit of one row per second, and returns the number of rows
+// the file stream sent to the server
+func sendHistoryRows(t *testing.T, rows int, syncing bool) int {
+	t.Helper()
+	var mu sync.Mutex
+	sent := 0
+	fsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data filestream.FsTransmitData
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		mu.Lock()
+		sent += len(data.Files[filestream.HistoryFileName].Content)
+		mu.Unlock()
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer fsServer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sender := server.NewSender(
+		ctx,
+		cancel,
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			RunId:             &wrapperspb.StringValue{Value: "run1"},
+			BaseUrl:           &wrapperspb.StringValue{Value: fsServer.URL},
+			XHistoryRateLimit: &wrapperspb.Int32Value{Value: 1},
+			XSync:             &wrapperspb.BoolValue{Value: syncing},
+		},
+		server.WithSenderFwdChannel(make(chan *service.Record, 1)),
+		server.WithSenderOutChannel(make(chan *service.Result, 1)),
+	)
+	sender.RunRecord = &service.RunRecord{RunId: "run1", Project: "project", Entity: "entity"}
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}))
+	for step := 0; step < rows; step++ {
+		sender.SendRecord(&service.Record{
+			RecordType: &service.Record_History{History: &service.HistoryRecord{
+				Item: []*service.HistoryItem{{Key: "_step", ValueJson: fmt.Sprint(step)}},
+			}},
+		})
+	}
+	sender.SendRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_Defer{
+			Defer: &service.DeferRequest{State: service.DeferRequest_FLUSH_FS},
+		},
+	}))
+
+	mu.Lock()
+	defer mu.Unlock()
+	return sent
+}
+
+func TestSendHistoryIsLimited(t *testing.T) {
+	// Verify that the history streamed by a live run is rate limited
+	assert.Less(t, sendHistoryRows(t, 10, false), 10)
+}
+
+func TestSendHistoryReplayedBySyncIsNotLimited(t *testing.T) {
+	// Verify that a synced run streams all of its history, since it is
+	// replayed much faster than it was logged
+	assert.Equal(t, 10, sendHistoryRows(t, 10, true))
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/corelib"
+	fs "github.com/wandb/wandb/core/pkg/filestream"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// RunSnapshotDir is the directory of the files directory that the snapshot
+// files are written to. It is never uploaded: the server keeps its own
+// history and summary files under the same names.
+const RunSnapshotDir = "wandb-snapshot"
+
+// RunSnapshot keeps a human-readable copy of the history and summary of a run
+// in its files directory, for local tools that do not read the transaction log.
+//
+// History rows are appended to wandb-snapshot/wandb-history.jsonl with one
+// write per line, and wandb-snapshot/wandb-summary.json is replaced
+// atomically, so readers never see a partial row or summary. Close syncs both
+// files to disk.
+type RunSnapshot struct {
+	logger      *observability.CoreLogger
+	historyPath string
+	summaryPath string
+	historyFile *os.File
+}
+
+func NewRunSnapshot(logger *observability.CoreLogger, settings *service.Settings) *RunSnapshot {
+	dir := filepath.Join(settings.GetFilesDir().GetValue(), RunSnapshotDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		logger.CaptureError("snapshot: failed to create directory", err)
+	}
+	return &RunSnapshot{
+		logger:      logger,
+		historyPath: filepath.Join(dir, fs.HistoryFileName),
+		summaryPath: filepath.Join(dir, fs.SummaryFileName),
+	}
+}
+
+// isRunSnapshotFile returns whether a path of the files directory, relative
+// to it or absolute, is a snapshot file
+func isRunSnapshotFile(filesDir, path string) bool {
+	if filepath.IsAbs(path) {
+		rel, err := filepath.Rel(filesDir, path)
+		if err != nil {
+			return false
+		}
+		path = rel
+	}
+	dir, _, _ := strings.Cut(filepath.ToSlash(filepath.Clean(path)), "/")
+	return dir == RunSnapshotDir
+}
+
+// WriteHistory appends a history row.
+func (rs *RunSnapshot) WriteHistory(items []*service.HistoryItem) {
+	if rs == nil {
+		return
+	}
+	if rs.historyFile == nil {
+		// append, so that a resumed run continues the existing file
+		f, err := os.OpenFile(rs.historyPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			rs.logger.CaptureError("snapshot: failed to open history file", err)
+			return
+		}
+		rs.historyFile = f
+	}
+	line, err := corelib.JsonifyItems(items)
+	if err != nil {
+		rs.logger.CaptureError("snapshot: failed to encode history", err)
+		return
+	}
+	if _, err := rs.historyFile.WriteString(line + "\n"); err != nil {
+		rs.logger.CaptureError("snapshot: failed to write history", err)
+	}
+}
+
+// WriteSummary replaces the summary file with the given consolidated summary.
+func (rs *RunSnapshot) WriteSummary(summary map[string]string) {
+	if rs == nil {
+		return
+	}
+	rs.writeSummary(summary, false)
+}
+
+// Close writes the final summary and syncs the files to disk.
+func (rs *RunSnapshot) Close(summary map[string]string) {
+	if rs == nil {
+		return
+	}
+	rs.writeSummary(summary, true)
+	if rs.historyFile == nil {
+		return
+	}
+	if err := rs.historyFile.Sync(); err != nil {
+		rs.logger.CaptureError("snapshot: failed to sync history file", err)
+	}
+	if err := rs.historyFile.Close(); err != nil {
+		rs.logger.CaptureError("snapshot: failed to close history file", err)
+	}
+	rs.historyFile = nil
+}
+
+func (rs *RunSnapshot) writeSummary(summary map[string]string, sync bool) {
+	items := make([]*service.SummaryItem, 0, len(summary))
+	for key, value := range summary {
+		items = append(items, &service.SummaryItem{Key: key, ValueJson: value})
+	}
+	data, err := corelib.JsonifyItems(items)
+	if err != nil {
+		rs.logger.CaptureError("snapshot: failed to encode summary", err)
+		return
+	}
+	if err := writeFileAtomic(rs.summaryPath, []byte(data), sync); err != nil {
+		rs.logger.CaptureError("snapshot: failed to write summary file", err)
+	}
+}
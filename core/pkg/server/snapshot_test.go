@@ -0,0 +1,119 @@
+package server_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func makeRunSnapshot(filesDir string) *server.RunSnapshot {
+	return server.NewRunSnapshot(
+		observability.NewNoOpLogger(),
+		&service.Settings{FilesDir: &wrapperspb.StringValue{Value: filesDir}},
+	)
+}
+
+func readLines(t *testing.T, path string) []string {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+func TestRunSnapshotHistory(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := makeRunSnapshot(dir)
+
+	snapshot.WriteHistory([]*service.HistoryItem{
+		{Key: "_step", ValueJson: "0"},
+		{Key: "loss", ValueJson: "0.5"},
+	})
+	snapshot.WriteHistory([]*service.HistoryItem{
+		{Key: "_step", ValueJson: "1"},
+		{Key: "loss", ValueJson: "0.25"},
+	})
+
+	// rows are visible before the run finishes
+	lines := readLines(t, filepath.Join(dir, server.RunSnapshotDir, "wandb-history.jsonl"))
+	assert.Equal(t, []string{`{"_step":0,"loss":0.5}`, `{"_step":1,"loss":0.25}`}, lines)
+
+	snapshot.Close(map[string]string{"loss": "0.25"})
+
+	// a resumed run appends to the existing history
+	snapshot = makeRunSnapshot(dir)
+	snapshot.WriteHistory([]*service.HistoryItem{{Key: "_step", ValueJson: "2"}})
+	snapshot.Close(map[string]string{})
+	lines = readLines(t, filepath.Join(dir, server.RunSnapshotDir, "wandb-history.jsonl"))
+	assert.Len(t, lines, 3)
+	assert.Equal(t, `{"_step":2}`, lines[2])
+}
+
+func TestRunSnapshotSummary(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := makeRunSnapshot(dir)
+	summaryPath := filepath.Join(dir, server.RunSnapshotDir, "wandb-summary.json")
+
+	snapshot.WriteSummary(map[string]string{"loss": "0.5"})
+	data, err := os.ReadFile(summaryPath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"loss":0.5}`, string(data))
+
+	snapshot.Close(map[string]string{
+		"loss":   "0.25",
+		"_wandb": `{"runtime": 3}`,
+	})
+	data, err = os.ReadFile(summaryPath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"loss":0.25,"_wandb":{"runtime":3}}`, string(data))
+
+	// the file is replaced atomically, no temporary file is left behind
+	entries, err := os.ReadDir(filepath.Join(dir, server.RunSnapshotDir))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestRunSnapshotFilesAreNotUploaded(t *testing.T) {
+	dir := t.TempDir()
+	snapshot := makeRunSnapshot(dir)
+	snapshot.WriteHistory([]*service.HistoryItem{{Key: "_step", ValueJson: "0"}})
+	snapshot.Close(map[string]string{"loss": "0.5"})
+	model := filepath.Join(dir, "model.pt")
+	assert.NoError(t, os.WriteFile(model, []byte("model"), 0644))
+
+	fileHandler := server.NewFileHandler(
+		observability.NewNoOpLogger(),
+		&service.Settings{FilesDir: &wrapperspb.StringValue{Value: dir}},
+		nil,
+	)
+	record := fileHandler.Handle(&service.Record{
+		RecordType: &service.Record_Files{Files: &service.FilesRecord{Files: []*service.FilesItem{
+			{Path: filepath.Join(server.RunSnapshotDir, "wandb-history.jsonl"), Policy: service.FilesItem_NOW},
+			{Path: filepath.Join(dir, server.RunSnapshotDir, "wandb-summary.json"), Policy: service.FilesItem_NOW},
+			{Path: filepath.Join(dir, "*", "*"), Policy: service.FilesItem_END},
+			{Path: model, Policy: service.FilesItem_NOW},
+		}}},
+	})
+
+	var uploaded []string
+	for _, file := range record.GetFiles().GetFiles() {
+		uploaded = append(uploaded, file.GetPath())
+	}
+	for _, file := range fileHandler.Final().GetFiles().GetFiles() {
+		uploaded = append(uploaded, file.GetPath())
+	}
+	assert.Equal(t, []string{model}, uploaded)
+}
+
+func TestRunSnapshotDisabled(t *testing.T) {
+	// a nil snapshot is the disabled state and ignores all calls
+	var snapshot *server.RunSnapshot
+	snapshot.WriteHistory([]*service.HistoryItem{{Key: "_step", ValueJson: "0"}})
+	snapshot.WriteSummary(map[string]string{"loss": "0.5"})
+	snapshot.Close(map[string]string{"loss": "0.5"})
+}
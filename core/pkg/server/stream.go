@@ -2,8 +2,12 @@ package server
 
 import (
 	"context"
+	"os"
 	"sync"
+	"time"
 
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/ingest"
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -18,7 +22,8 @@ const (
 // data for a W&B run, store it locally, and send it to a W&B server.
 // Stream.handler receives incoming data from the client and dispatches it to
 // Stream.writer, which writes it to a local file. Stream.writer then sends the
-// data to Stream.sender, which sends it to the W&B server. Stream.dispatcher
+// data to Stream.sender, which sends it to the W&B server, and to
+// Stream.mirrors, which send it to additional servers. Stream.dispatcher
 // handles dispatching responses to the appropriate client responders.
 type Stream struct {
 	// ctx is the context for the stream
@@ -30,6 +35,9 @@ type Stream struct {
 	// logger is the logger for the stream
 	logger *observability.CoreLogger
 
+	// logFile is the file the logger writes to, closed with the stream
+	logFile *os.File
+
 	// wg is the WaitGroup for the stream
 	wg sync.WaitGroup
 
@@ -45,6 +53,9 @@ type Stream struct {
 	// sender is the sender for the stream
 	sender *Sender
 
+	// mirrors send the run to the additional servers of the settings
+	mirrors []*Mirror
+
 	// inChan is the channel for incoming messages
 	inChan chan *service.Record
 
@@ -56,15 +67,20 @@ type Stream struct {
 
 	// dispatcher is the dispatcher for the stream
 	dispatcher *Dispatcher
+
+	// closeOnce makes Close safe to call more than once
+	closeOnce sync.Once
 }
 
 // NewStream creates a new stream with the given settings and responders.
 func NewStream(ctx context.Context, settings *service.Settings, streamId string) *Stream {
 	ctx, cancel := context.WithCancel(ctx)
+	logger, logFile := SetupStreamLogger(settings)
 	s := &Stream{
 		ctx:          ctx,
 		cancel:       cancel,
-		logger:       SetupStreamLogger(settings),
+		logger:       logger,
+		logFile:      logFile,
 		wg:           sync.WaitGroup{},
 		settings:     settings,
 		inChan:       make(chan *service.Record, BufferSize),
@@ -72,15 +88,28 @@ func NewStream(ctx context.Context, settings *service.Settings, streamId string)
 		outChan:      make(chan *service.ServerResponse, BufferSize),
 	}
 
+	var runSnapshot *RunSnapshot
+	if s.settings.GetXSaveHistoryAndSummary().GetValue() {
+		runSnapshot = NewRunSnapshot(s.logger, s.settings)
+	}
+
+	fileTransferProgress := filetransfer.NewProgressTracker()
+	configTimeline := NewConfigTimeline(s.logger, s.settings)
+	configTracker := NewConfigTracker()
 	s.handler = NewHandler(s.ctx, s.logger,
 		WithHandlerSettings(s.settings),
 		WithHandlerFwdChannel(make(chan *service.Record, BufferSize)),
 		WithHandlerOutChannel(make(chan *service.Result, BufferSize)),
 		WithHandlerSystemMonitor(monitor.NewSystemMonitor(s.settings, s.logger, s.loopBackChan)),
+		WithHandlerIngestListener(ingest.NewListener(s.settings, s.logger, s.loopBackChan)),
 		WithHandlerFileHandler(NewFileHandler(s.logger, s.settings, s.loopBackChan)),
 		WithHandlerFileTransferHandler(NewFileTransferHandler()),
+		WithHandlerFileTransferProgress(fileTransferProgress),
+		WithHandlerConfigTimeline(configTimeline),
+		WithHandlerConfigTracker(configTracker),
 		WithHandlerSummaryHandler(NewSummaryHandler(s.logger)),
 		WithHandlerMetricHandler(NewMetricHandler()),
+		WithHandlerRunSnapshot(runSnapshot),
 	)
 
 	s.writer = NewWriter(s.ctx, s.logger,
@@ -91,8 +120,13 @@ func NewStream(ctx context.Context, settings *service.Settings, streamId string)
 	s.sender = NewSender(s.ctx, s.cancel, s.logger, s.settings,
 		WithSenderFwdChannel(s.loopBackChan),
 		WithSenderOutChannel(make(chan *service.Result, BufferSize)),
+		WithSenderFileTransferProgress(fileTransferProgress),
+		WithSenderConfigTimeline(configTimeline),
+		WithSenderConfigTracker(configTracker),
 	)
 
+	s.mirrors = NewMirrors(s.ctx, s.logger, s.settings)
+
 	s.dispatcher = NewDispatcher(s.logger)
 
 	s.logger.Info("created new stream", "id", s.settings.RunId)
@@ -142,10 +176,32 @@ func (s *Stream) Start() {
 		s.wg.Done()
 	}()
 
-	// send the data to the server
+	// send the data to the server, and a copy to each mirror
+	senderChan := s.writer.fwdChan
+	if len(s.mirrors) > 0 {
+		senderChan = make(chan *service.Record, BufferSize)
+		s.wg.Add(1)
+		go func() {
+			for record := range s.writer.fwdChan {
+				for _, mirror := range s.mirrors {
+					mirror.Queue(record)
+				}
+				senderChan <- record
+			}
+			close(senderChan)
+			for _, mirror := range s.mirrors {
+				mirror.Close()
+			}
+			s.wg.Done()
+		}()
+	}
+	// the mirrors are waited for separately, see closeMirrors
+	for _, mirror := range s.mirrors {
+		go mirror.Do()
+	}
 	s.wg.Add(1)
 	go func() {
-		s.sender.Do(s.writer.fwdChan)
+		s.sender.Do(senderChan)
 		s.wg.Done()
 	}()
 
@@ -180,13 +236,47 @@ func (s *Stream) GetRun() *service.RunRecord {
 }
 
 // Close Gracefully wait for handler, writer, sender, dispatcher to shut down cleanly
-// assumes an exit record has already been sent
+// assumes an exit record has already been sent. It is safe to call more than once.
 func (s *Stream) Close() {
-	// wait for the context to be canceled in the defer state machine in the sender
-	<-s.ctx.Done()
-	close(s.loopBackChan)
-	close(s.inChan)
-	s.wg.Wait()
+	s.closeOnce.Do(func() {
+		// wait for the context to be canceled in the defer state machine in the sender
+		<-s.ctx.Done()
+		close(s.loopBackChan)
+		close(s.inChan)
+		s.wg.Wait()
+		s.closeMirrors()
+		s.logger.Info("closed stream", "id", s.settings.RunId)
+		if s.logFile != nil {
+			_ = s.logFile.Close()
+		}
+	})
+}
+
+// closeMirrors waits for the mirrors to finish sending the run, and aborts
+// those that are still sending at the shutdown deadline of the settings, so
+// that an unreachable mirror does not hold the stream for all its retries
+func (s *Stream) closeMirrors() {
+	if len(s.mirrors) == 0 {
+		return
+	}
+	timeout := defaultMirrorShutdownTimeout
+	if s.settings.XMirrorShutdownTimeoutSeconds != nil {
+		seconds := s.settings.GetXMirrorShutdownTimeoutSeconds().GetValue()
+		timeout = time.Duration(seconds * float64(time.Second))
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for _, mirror := range s.mirrors {
+		select {
+		case <-mirror.Done():
+			continue
+		case <-deadline.C:
+		}
+		for _, mirror := range s.mirrors {
+			mirror.Abort()
+		}
+		return
+	}
 }
 
 // Respond Handle internal responses like from the finish and close path
@@ -215,7 +305,6 @@ func (s *Stream) FinishAndClose(exitCode int32) {
 	s.Close()
 
 	s.PrintFooter()
-	s.logger.Info("closed stream", "id", s.settings.RunId)
 }
 
 func (s *Stream) PrintFooter() {
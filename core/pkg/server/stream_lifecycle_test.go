@@ -0,0 +1,189 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/watcher"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// lifecycleIterations is the number of runs driven through a stream in the
+// lifecycle conformance tests
+const lifecycleIterations = 20
+
+func makeOfflineSettings(t *testing.T, runId string) *service.Settings {
+	dir := filepath.Join(t.TempDir(), runId)
+	filesDir := filepath.Join(dir, "files")
+	logDir := filepath.Join(dir, "logs")
+	for _, d := range []string{filesDir, logDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &service.Settings{
+		RunId:                   &wrapperspb.StringValue{Value: runId},
+		XOffline:                &wrapperspb.BoolValue{Value: true},
+		SyncFile:                &wrapperspb.StringValue{Value: filepath.Join(dir, "run.wandb")},
+		FilesDir:                &wrapperspb.StringValue{Value: filesDir},
+		LogDir:                  &wrapperspb.StringValue{Value: logDir},
+		LogInternal:             &wrapperspb.StringValue{Value: filepath.Join(logDir, "debug-internal.log")},
+		XStatsSampleRateSeconds: &wrapperspb.DoubleValue{Value: 0.01},
+		XStatsSamplesToAverage:  &wrapperspb.Int32Value{Value: 1},
+		XDisableMachineInfo:     &wrapperspb.BoolValue{Value: true},
+	}
+}
+
+func makeRequestRecord(request *service.Request) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Request{Request: request},
+		Control:    &service.Control{Local: true},
+	}
+}
+
+// runStreamLifecycle drives a stream through a whole run: init, log, pause,
+// resume, finish and teardown
+func runStreamLifecycle(t *testing.T, settings *service.Settings) {
+	runId := settings.GetRunId().GetValue()
+	stream := server.NewStream(context.Background(), settings, runId)
+	stream.Start()
+
+	run := &service.RunRecord{
+		RunId:     runId,
+		StartTime: timestamppb.Now(),
+	}
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Run{Run: run},
+	})
+	stream.HandleRecord(makeRequestRecord(&service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{Run: run}},
+	}))
+
+	for step := 0; step < 10; step++ {
+		if step == 4 {
+			stream.HandleRecord(makeRequestRecord(&service.Request{
+				RequestType: &service.Request_Pause{Pause: &service.PauseRequest{}},
+			}))
+		}
+		if step == 6 {
+			stream.HandleRecord(makeRequestRecord(&service.Request{
+				RequestType: &service.Request_Resume{Resume: &service.ResumeRequest{}},
+			}))
+		}
+		stream.HandleRecord(makeRequestRecord(&service.Request{
+			RequestType: &service.Request_PartialHistory{
+				PartialHistory: &service.PartialHistoryRequest{
+					Item: []*service.HistoryItem{
+						{Key: "loss", ValueJson: fmt.Sprintf("%d", step)},
+					},
+				},
+			},
+		}))
+	}
+	// give the system monitor a chance to sample
+	time.Sleep(20 * time.Millisecond)
+
+	stream.FinishAndClose(0)
+	// closing again is safe
+	stream.Close()
+}
+
+func countOpenFiles(t *testing.T) int {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		t.Skip("cannot count open files on this platform")
+	}
+	return len(entries)
+}
+
+// settleGoroutines waits until the number of goroutines stops changing, and
+// returns it
+func settleGoroutines() int {
+	current := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		time.Sleep(20 * time.Millisecond)
+		next := runtime.NumGoroutine()
+		if next == current {
+			break
+		}
+		current = next
+	}
+	return current
+}
+
+// waitForGoroutines waits until at most n goroutines are running, and returns
+// the number of goroutines left
+func waitForGoroutines(n int) int {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		current := runtime.NumGoroutine()
+		if current <= n || time.Now().After(deadline) {
+			return current
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestStreamLifecycleNoLeaks(t *testing.T) {
+	testStreamLifecycleNoLeaks(t, func(runId string) *service.Settings {
+		return makeOfflineSettings(t, runId)
+	})
+}
+
+func TestOnlineStreamLifecycleNoLeaks(t *testing.T) {
+	fs := newFakeServer(t, false)
+	testStreamLifecycleNoLeaks(t, func(runId string) *service.Settings {
+		return makeOnlineSettings(t, runId, fs.URL)
+	})
+}
+
+func testStreamLifecycleNoLeaks(t *testing.T, makeSettings func(runId string) *service.Settings) {
+	// warm up: the first run starts process-wide goroutines, such as those
+	// of the http transport, which are not leaks
+	runStreamLifecycle(t, makeSettings("warmup"))
+
+	goroutines := settleGoroutines()
+	files := countOpenFiles(t)
+
+	for i := 0; i < lifecycleIterations; i++ {
+		runStreamLifecycle(t, makeSettings(fmt.Sprintf("run%d", i)))
+	}
+
+	left := waitForGoroutines(goroutines)
+	if left > goroutines {
+		buf := make([]byte, 1<<20)
+		n := runtime.Stack(buf, true)
+		t.Errorf("leaked %d goroutines:\n%s", left-goroutines, buf[:n])
+	}
+	assert.LessOrEqual(t, countOpenFiles(t), files, "leaked file descriptors")
+}
+
+func TestWatcherAddAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("file"), 0644))
+	outChan := make(chan *service.Record, 1)
+
+	w := watcher.NewWatcher(observability.NewNoOpLogger(), outChan)
+	assert.NoError(t, w.Add(path))
+	assert.Equal(t, path, (<-outChan).GetFiles().GetFiles()[0].GetPath())
+	w.Close()
+
+	// a closed watcher is not started again, and reports no more changes
+	assert.ErrorIs(t, w.Add(path), watcher.ErrClosed)
+	w.Start()
+	assert.NoError(t, os.WriteFile(path, []byte("changed"), 0644))
+	time.Sleep(300 * time.Millisecond)
+	assert.Empty(t, outChan)
+	w.Close()
+}
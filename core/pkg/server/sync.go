@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -73,6 +74,12 @@ func (s *SyncService) SyncRecord(record *service.Record, err error) {
 		s.syncErr = err
 	}
 
+	// the exit is noted here rather than when it is synced, as the records
+	// ahead of it may still be syncing when the end of the log is read
+	if record.GetExit() != nil {
+		s.exitSeen = true
+	}
+
 	if err != nil && !s.exitSeen {
 		record = &service.Record{
 			RecordType: &service.Record_Exit{
@@ -144,7 +151,6 @@ func (s *SyncService) syncRun(record *service.Record) {
 }
 
 func (s *SyncService) syncExit(record *service.Record) {
-	s.exitSeen = true
 	s.senderFunc(record)
 }
 
@@ -167,3 +173,47 @@ func (s *SyncService) Flush() {
 	s.flushCallback(s.syncErr)
 
 }
+
+// SyncFile uploads the transaction log named by the sync_file setting through
+// a new stream, as `wandb sync` does, and returns the response of the sync.
+// The settings must have _sync set.
+func SyncFile(ctx context.Context, settings *service.Settings) (*service.SyncResponse, error) {
+	// the sender does not report a log it cannot read, so check it first
+	store := NewStore(ctx, settings.GetSyncFile().GetValue(), observability.NewNoOpLogger())
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return nil, fmt.Errorf("sync: can't read %s: %w", settings.GetSyncFile().GetValue(), err)
+	}
+	_ = store.Close()
+
+	stream := NewStream(ctx, settings, settings.GetRunId().GetValue())
+	stream.AddResponders(ResponderEntry{stream, internalConnectionId})
+	stream.Start()
+
+	var response *service.SyncResponse
+	done := make(chan struct{})
+	go func() {
+		for resp := range stream.outChan {
+			result := resp.GetResultCommunicate()
+			if sync := result.GetResponse().GetSyncResponse(); sync != nil {
+				response = sync
+			}
+		}
+		close(done)
+	}()
+
+	stream.HandleRecord(&service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Sync{Sync: &service.SyncRequest{}},
+			},
+		},
+		Control: &service.Control{ConnectionId: internalConnectionId},
+	})
+	stream.Close()
+	<-done
+
+	if response == nil {
+		return nil, fmt.Errorf("sync: no response")
+	}
+	return response, nil
+}
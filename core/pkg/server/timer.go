@@ -4,13 +4,35 @@ import "time"
 
 // Timer is used to track the run start and execution times
 type Timer struct {
+	// now is the clock of the timer, time.Now if nil
+	now         func() time.Time
 	startTime   time.Time
 	resumeTime  time.Time
+	pausedAt    time.Time
 	accumulated time.Duration
+	pauses      []TimerPause
 	isStarted   bool
 	isPaused    bool
 }
 
+// TimerPause is a period during which the timer was paused
+type TimerPause struct {
+	Start    time.Time
+	Duration time.Duration
+}
+
+// NewTimer creates a timer that reads the time from the given clock
+func NewTimer(now func() time.Time) Timer {
+	return Timer{now: now}
+}
+
+func (t *Timer) clock() time.Time {
+	if t.now == nil {
+		return time.Now()
+	}
+	return t.now()
+}
+
 func (t *Timer) GetStartTimeMicro() float64 {
 	return float64(t.startTime.UnixMicro()) / 1e6
 }
@@ -19,7 +41,7 @@ func (t *Timer) Start(startTime *time.Time) {
 	if startTime != nil {
 		t.startTime = *startTime
 	} else {
-		t.startTime = time.Now()
+		t.startTime = t.clock()
 	}
 	t.resumeTime = t.startTime
 	t.isStarted = true
@@ -27,19 +49,34 @@ func (t *Timer) Start(startTime *time.Time) {
 
 func (t *Timer) Pause() {
 	if !t.isPaused {
-		elapsed := time.Since(t.resumeTime)
-		t.accumulated += elapsed
+		t.pausedAt = t.clock()
+		t.accumulated += t.pausedAt.Sub(t.resumeTime)
 		t.isPaused = true
 	}
 }
 
 func (t *Timer) Resume() {
 	if t.isPaused {
-		t.resumeTime = time.Now()
+		t.resumeTime = t.clock()
+		t.pauses = append(t.pauses, TimerPause{
+			Start:    t.pausedAt,
+			Duration: t.resumeTime.Sub(t.pausedAt),
+		})
 		t.isPaused = false
 	}
 }
 
+// IsPaused returns whether the timer is paused
+func (t *Timer) IsPaused() bool {
+	return t.isPaused
+}
+
+// Pauses returns the periods during which the timer was paused, not
+// counting the current one
+func (t *Timer) Pauses() []TimerPause {
+	return t.pauses
+}
+
 func (t *Timer) Elapsed() time.Duration {
 	if !t.isStarted {
 		return 0
@@ -47,5 +84,5 @@ func (t *Timer) Elapsed() time.Duration {
 	if t.isPaused {
 		return t.accumulated
 	}
-	return t.accumulated + time.Since(t.resumeTime)
+	return t.accumulated + t.clock().Sub(t.resumeTime)
 }
@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/server"
+)
+
+func TestTimerElapsedExcludesPauses(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	timer := server.NewTimer(clock.Now)
+	timer.Start(nil)
+
+	clock.Advance(10 * time.Second)
+	timer.Pause()
+	clock.Advance(time.Hour)
+	assert.Equal(t, 10*time.Second, timer.Elapsed())
+
+	timer.Resume()
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, 15*time.Second, timer.Elapsed())
+
+	timer.Pause()
+	clock.Advance(30 * time.Second)
+	timer.Resume()
+	clock.Advance(time.Second)
+	assert.Equal(t, 16*time.Second, timer.Elapsed())
+
+	assert.Equal(t, []server.TimerPause{
+		{Start: time.Unix(1010, 0), Duration: time.Hour},
+		{Start: time.Unix(4615, 0), Duration: 30 * time.Second},
+	}, timer.Pauses())
+}
+
+func TestTimerPauseResumeTwice(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	timer := server.NewTimer(clock.Now)
+	timer.Start(nil)
+
+	clock.Advance(time.Second)
+	timer.Pause()
+	clock.Advance(time.Second)
+	// a second pause does not restart the pause
+	timer.Pause()
+	assert.True(t, timer.IsPaused())
+	clock.Advance(time.Second)
+	timer.Resume()
+	timer.Resume()
+	assert.False(t, timer.IsPaused())
+
+	assert.Equal(t, time.Second, timer.Elapsed())
+	assert.Len(t, timer.Pauses(), 1)
+	assert.Equal(t, 2*time.Second, timer.Pauses()[0].Duration)
+}
+
+func TestParsePauseSubsystems(t *testing.T) {
+	subsystems, err := server.ParsePauseSubsystems(nil)
+	assert.NoError(t, err)
+	assert.True(t, subsystems.Has(server.PauseSystemMonitor))
+	assert.True(t, subsystems.Has(server.PauseFileStream))
+	assert.True(t, subsystems.Has(server.PauseUploads))
+	assert.True(t, subsystems.Has(server.PauseConsole))
+
+	subsystems, err = server.ParsePauseSubsystems([]string{"system", " Console"})
+	assert.NoError(t, err)
+	assert.True(t, subsystems.Has(server.PauseSystemMonitor))
+	assert.True(t, subsystems.Has(server.PauseConsole))
+	assert.False(t, subsystems.Has(server.PauseFileStream))
+
+	subsystems, err = server.ParsePauseSubsystems([]string{"uploads", "gpu"})
+	assert.Error(t, err)
+	assert.True(t, subsystems.Has(server.PauseUploads))
+}
@@ -43,6 +43,31 @@ func writePortFile(portFile string, port int) {
 	// slog.Info("wrote port file", "file", portFile, "port", port)
 }
 
+// writeFileAtomic replaces the file at path with data, so that readers see
+// either the old or the new content. If sync is set, the data is flushed to
+// disk before the file is replaced.
+func writeFileAtomic(path string, data []byte, sync bool) error {
+	tempFile := fmt.Sprintf("%s.tmp", path)
+	f, err := os.Create(tempFile)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if sync {
+		if err = f.Sync(); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFile, path)
+}
+
 // Helper function to copy a file
 func copyFile(src, dst string) error {
 	source, err := os.Open(src)
@@ -132,8 +132,10 @@ func (w *Writer) handleRecord(record *service.Record) {
 	case nil:
 		w.logger.Error("nil record type")
 	default:
-		w.sendRecord(record)
+		// the record is numbered before it is sent, since the sender and
+		// the mirrors read it concurrently
 		w.storeRecord(record)
+		w.sendRecord(record)
 	}
 }
 
@@ -248,167 +248,189 @@ type Settings struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	XArgs                            *ListStringValue         `protobuf:"bytes,1,opt,name=_args,json=Args,proto3" json:"_args,omitempty"`
-	XAwsLambda                       *wrapperspb.BoolValue    `protobuf:"bytes,2,opt,name=_aws_lambda,json=AwsLambda,proto3" json:"_aws_lambda,omitempty"`
-	XAsyncUploadConcurrencyLimit     *wrapperspb.Int32Value   `protobuf:"bytes,3,opt,name=_async_upload_concurrency_limit,json=AsyncUploadConcurrencyLimit,proto3" json:"_async_upload_concurrency_limit,omitempty"`
-	XCliOnlyMode                     *wrapperspb.BoolValue    `protobuf:"bytes,4,opt,name=_cli_only_mode,json=CliOnlyMode,proto3" json:"_cli_only_mode,omitempty"`
-	XColab                           *wrapperspb.BoolValue    `protobuf:"bytes,5,opt,name=_colab,json=Colab,proto3" json:"_colab,omitempty"`
-	XCuda                            *wrapperspb.StringValue  `protobuf:"bytes,6,opt,name=_cuda,json=Cuda,proto3" json:"_cuda,omitempty"`
-	XDisableMeta                     *wrapperspb.BoolValue    `protobuf:"bytes,7,opt,name=_disable_meta,json=DisableMeta,proto3" json:"_disable_meta,omitempty"`
-	XDisableService                  *wrapperspb.BoolValue    `protobuf:"bytes,8,opt,name=_disable_service,json=DisableService,proto3" json:"_disable_service,omitempty"`
-	XDisableSetproctitle             *wrapperspb.BoolValue    `protobuf:"bytes,9,opt,name=_disable_setproctitle,json=DisableSetproctitle,proto3" json:"_disable_setproctitle,omitempty"`
-	XDisableStats                    *wrapperspb.BoolValue    `protobuf:"bytes,10,opt,name=_disable_stats,json=DisableStats,proto3" json:"_disable_stats,omitempty"`
-	XDisableViewer                   *wrapperspb.BoolValue    `protobuf:"bytes,11,opt,name=_disable_viewer,json=DisableViewer,proto3" json:"_disable_viewer,omitempty"`
-	XDisableMachineInfo              *wrapperspb.BoolValue    `protobuf:"bytes,158,opt,name=_disable_machine_info,json=DisableMachineInfo,proto3" json:"_disable_machine_info,omitempty"`
-	XExceptExit                      *wrapperspb.BoolValue    `protobuf:"bytes,12,opt,name=_except_exit,json=ExceptExit,proto3" json:"_except_exit,omitempty"`
-	XExecutable                      *wrapperspb.StringValue  `protobuf:"bytes,13,opt,name=_executable,json=Executable,proto3" json:"_executable,omitempty"`
-	XExtraHttpHeaders                *MapStringKeyStringValue `protobuf:"bytes,14,opt,name=_extra_http_headers,json=ExtraHttpHeaders,proto3" json:"_extra_http_headers,omitempty"`
-	XProxies                         *MapStringKeyStringValue `protobuf:"bytes,200,opt,name=_proxies,json=Proxies,proto3" json:"_proxies,omitempty"`
-	XFileStreamRetryMax              *wrapperspb.Int32Value   `protobuf:"bytes,147,opt,name=_file_stream_retry_max,json=FileStreamRetryMax,proto3" json:"_file_stream_retry_max,omitempty"`
-	XFileStreamRetryWaitMinSeconds   *wrapperspb.Int32Value   `protobuf:"bytes,148,opt,name=_file_stream_retry_wait_min_seconds,json=FileStreamRetryWaitMinSeconds,proto3" json:"_file_stream_retry_wait_min_seconds,omitempty"`
-	XFileStreamRetryWaitMaxSeconds   *wrapperspb.Int32Value   `protobuf:"bytes,149,opt,name=_file_stream_retry_wait_max_seconds,json=FileStreamRetryWaitMaxSeconds,proto3" json:"_file_stream_retry_wait_max_seconds,omitempty"`
-	XFileStreamTimeoutSeconds        *wrapperspb.Int32Value   `protobuf:"bytes,15,opt,name=_file_stream_timeout_seconds,json=FileStreamTimeoutSeconds,proto3" json:"_file_stream_timeout_seconds,omitempty"`
-	XFileTransferRetryMax            *wrapperspb.Int32Value   `protobuf:"bytes,150,opt,name=_file_transfer_retry_max,json=FileTransferRetryMax,proto3" json:"_file_transfer_retry_max,omitempty"`
-	XFileTransferRetryWaitMinSeconds *wrapperspb.Int32Value   `protobuf:"bytes,151,opt,name=_file_transfer_retry_wait_min_seconds,json=FileTransferRetryWaitMinSeconds,proto3" json:"_file_transfer_retry_wait_min_seconds,omitempty"`
-	XFileTransferRetryWaitMaxSeconds *wrapperspb.Int32Value   `protobuf:"bytes,152,opt,name=_file_transfer_retry_wait_max_seconds,json=FileTransferRetryWaitMaxSeconds,proto3" json:"_file_transfer_retry_wait_max_seconds,omitempty"`
-	XFileTransferTimeoutSeconds      *wrapperspb.Int32Value   `protobuf:"bytes,153,opt,name=_file_transfer_timeout_seconds,json=FileTransferTimeoutSeconds,proto3" json:"_file_transfer_timeout_seconds,omitempty"`
-	XFlowControlCustom               *wrapperspb.BoolValue    `protobuf:"bytes,16,opt,name=_flow_control_custom,json=FlowControlCustom,proto3" json:"_flow_control_custom,omitempty"`
-	XFlowControlDisabled             *wrapperspb.BoolValue    `protobuf:"bytes,17,opt,name=_flow_control_disabled,json=FlowControlDisabled,proto3" json:"_flow_control_disabled,omitempty"`
-	XGraphqlRetryMax                 *wrapperspb.Int32Value   `protobuf:"bytes,154,opt,name=_graphql_retry_max,json=GraphqlRetryMax,proto3" json:"_graphql_retry_max,omitempty"`
-	XGraphqlRetryWaitMinSeconds      *wrapperspb.Int32Value   `protobuf:"bytes,155,opt,name=_graphql_retry_wait_min_seconds,json=GraphqlRetryWaitMinSeconds,proto3" json:"_graphql_retry_wait_min_seconds,omitempty"`
-	XGraphqlRetryWaitMaxSeconds      *wrapperspb.Int32Value   `protobuf:"bytes,156,opt,name=_graphql_retry_wait_max_seconds,json=GraphqlRetryWaitMaxSeconds,proto3" json:"_graphql_retry_wait_max_seconds,omitempty"`
-	XGraphqlTimeoutSeconds           *wrapperspb.Int32Value   `protobuf:"bytes,157,opt,name=_graphql_timeout_seconds,json=GraphqlTimeoutSeconds,proto3" json:"_graphql_timeout_seconds,omitempty"`
-	XInternalCheckProcess            *wrapperspb.DoubleValue  `protobuf:"bytes,18,opt,name=_internal_check_process,json=InternalCheckProcess,proto3" json:"_internal_check_process,omitempty"`
-	XInternalQueueTimeout            *wrapperspb.DoubleValue  `protobuf:"bytes,19,opt,name=_internal_queue_timeout,json=InternalQueueTimeout,proto3" json:"_internal_queue_timeout,omitempty"`
-	XIpython                         *wrapperspb.BoolValue    `protobuf:"bytes,20,opt,name=_ipython,json=Ipython,proto3" json:"_ipython,omitempty"`
-	XJupyter                         *wrapperspb.BoolValue    `protobuf:"bytes,21,opt,name=_jupyter,json=Jupyter,proto3" json:"_jupyter,omitempty"`
-	XJupyterName                     *wrapperspb.StringValue  `protobuf:"bytes,143,opt,name=_jupyter_name,json=JupyterName,proto3" json:"_jupyter_name,omitempty"`
-	XJupyterPath                     *wrapperspb.StringValue  `protobuf:"bytes,144,opt,name=_jupyter_path,json=JupyterPath,proto3" json:"_jupyter_path,omitempty"`
-	XJupyterRoot                     *wrapperspb.StringValue  `protobuf:"bytes,22,opt,name=_jupyter_root,json=JupyterRoot,proto3" json:"_jupyter_root,omitempty"`
-	XKaggle                          *wrapperspb.BoolValue    `protobuf:"bytes,23,opt,name=_kaggle,json=Kaggle,proto3" json:"_kaggle,omitempty"`
-	XLivePolicyRateLimit             *wrapperspb.Int32Value   `protobuf:"bytes,24,opt,name=_live_policy_rate_limit,json=LivePolicyRateLimit,proto3" json:"_live_policy_rate_limit,omitempty"`
-	XLivePolicyWaitTime              *wrapperspb.Int32Value   `protobuf:"bytes,25,opt,name=_live_policy_wait_time,json=LivePolicyWaitTime,proto3" json:"_live_policy_wait_time,omitempty"`
-	XLogLevel                        *wrapperspb.Int32Value   `protobuf:"bytes,26,opt,name=_log_level,json=LogLevel,proto3" json:"_log_level,omitempty"`
-	XNetworkBuffer                   *wrapperspb.Int32Value   `protobuf:"bytes,27,opt,name=_network_buffer,json=NetworkBuffer,proto3" json:"_network_buffer,omitempty"`
-	XNoop                            *wrapperspb.BoolValue    `protobuf:"bytes,28,opt,name=_noop,json=Noop,proto3" json:"_noop,omitempty"`
-	XNotebook                        *wrapperspb.BoolValue    `protobuf:"bytes,29,opt,name=_notebook,json=Notebook,proto3" json:"_notebook,omitempty"`
-	XOffline                         *wrapperspb.BoolValue    `protobuf:"bytes,30,opt,name=_offline,json=Offline,proto3" json:"_offline,omitempty"`
-	XSync                            *wrapperspb.BoolValue    `protobuf:"bytes,31,opt,name=_sync,json=Sync,proto3" json:"_sync,omitempty"`
-	XOs                              *wrapperspb.StringValue  `protobuf:"bytes,32,opt,name=_os,json=Os,proto3" json:"_os,omitempty"`
-	XPlatform                        *wrapperspb.StringValue  `protobuf:"bytes,33,opt,name=_platform,json=Platform,proto3" json:"_platform,omitempty"`
-	XPython                          *wrapperspb.StringValue  `protobuf:"bytes,34,opt,name=_python,json=Python,proto3" json:"_python,omitempty"`
-	XRunqueueItemId                  *wrapperspb.StringValue  `protobuf:"bytes,35,opt,name=_runqueue_item_id,json=RunqueueItemId,proto3" json:"_runqueue_item_id,omitempty"`
-	XRequireCore                     *wrapperspb.BoolValue    `protobuf:"bytes,36,opt,name=_require_core,json=RequireCore,proto3" json:"_require_core,omitempty"`
-	XSaveRequirements                *wrapperspb.BoolValue    `protobuf:"bytes,37,opt,name=_save_requirements,json=SaveRequirements,proto3" json:"_save_requirements,omitempty"`
-	XServiceTransport                *wrapperspb.StringValue  `protobuf:"bytes,38,opt,name=_service_transport,json=ServiceTransport,proto3" json:"_service_transport,omitempty"`
-	XServiceWait                     *wrapperspb.DoubleValue  `protobuf:"bytes,39,opt,name=_service_wait,json=ServiceWait,proto3" json:"_service_wait,omitempty"`
-	XStartDatetime                   *wrapperspb.StringValue  `protobuf:"bytes,40,opt,name=_start_datetime,json=StartDatetime,proto3" json:"_start_datetime,omitempty"`
-	XStartTime                       *wrapperspb.DoubleValue  `protobuf:"bytes,41,opt,name=_start_time,json=StartTime,proto3" json:"_start_time,omitempty"`
-	XStatsPid                        *wrapperspb.Int32Value   `protobuf:"bytes,42,opt,name=_stats_pid,json=StatsPid,proto3" json:"_stats_pid,omitempty"`
-	XStatsSampleRateSeconds          *wrapperspb.DoubleValue  `protobuf:"bytes,43,opt,name=_stats_sample_rate_seconds,json=StatsSampleRateSeconds,proto3" json:"_stats_sample_rate_seconds,omitempty"`
-	XStatsSamplesToAverage           *wrapperspb.Int32Value   `protobuf:"bytes,44,opt,name=_stats_samples_to_average,json=StatsSamplesToAverage,proto3" json:"_stats_samples_to_average,omitempty"`
-	XStatsJoinAssets                 *wrapperspb.BoolValue    `protobuf:"bytes,45,opt,name=_stats_join_assets,json=StatsJoinAssets,proto3" json:"_stats_join_assets,omitempty"`
-	XStatsNeuronMonitorConfigPath    *wrapperspb.StringValue  `protobuf:"bytes,46,opt,name=_stats_neuron_monitor_config_path,json=StatsNeuronMonitorConfigPath,proto3" json:"_stats_neuron_monitor_config_path,omitempty"`
-	XStatsOpenMetricsEndpoints       *MapStringKeyStringValue `protobuf:"bytes,47,opt,name=_stats_open_metrics_endpoints,json=StatsOpenMetricsEndpoints,proto3" json:"_stats_open_metrics_endpoints,omitempty"`
-	XStatsOpenMetricsFilters         *OpenMetricsFilters      `protobuf:"bytes,48,opt,name=_stats_open_metrics_filters,json=StatsOpenMetricsFilters,proto3" json:"_stats_open_metrics_filters,omitempty"`
-	XStatsDiskPaths                  *ListStringValue         `protobuf:"bytes,146,opt,name=_stats_disk_paths,json=StatsDiskPaths,proto3" json:"_stats_disk_paths,omitempty"`
-	XStatsBufferSize                 *wrapperspb.Int32Value   `protobuf:"bytes,161,opt,name=_stats_buffer_size,json=StatsBufferSize,proto3" json:"_stats_buffer_size,omitempty"`
-	XTmpCodeDir                      *wrapperspb.StringValue  `protobuf:"bytes,49,opt,name=_tmp_code_dir,json=TmpCodeDir,proto3" json:"_tmp_code_dir,omitempty"`
-	XTracelog                        *wrapperspb.StringValue  `protobuf:"bytes,50,opt,name=_tracelog,json=Tracelog,proto3" json:"_tracelog,omitempty"`
-	XUnsavedKeys                     *ListStringValue         `protobuf:"bytes,51,opt,name=_unsaved_keys,json=UnsavedKeys,proto3" json:"_unsaved_keys,omitempty"`
-	XWindows                         *wrapperspb.BoolValue    `protobuf:"bytes,52,opt,name=_windows,json=Windows,proto3" json:"_windows,omitempty"`
-	AllowValChange                   *wrapperspb.BoolValue    `protobuf:"bytes,53,opt,name=allow_val_change,json=allowValChange,proto3" json:"allow_val_change,omitempty"`
-	Anonymous                        *wrapperspb.StringValue  `protobuf:"bytes,54,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
-	ApiKey                           *wrapperspb.StringValue  `protobuf:"bytes,55,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	AzureAccountUrlToAccessKey       *MapStringKeyStringValue `protobuf:"bytes,56,opt,name=azure_account_url_to_access_key,json=azureAccountUrlToAccessKey,proto3" json:"azure_account_url_to_access_key,omitempty"`
-	BaseUrl                          *wrapperspb.StringValue  `protobuf:"bytes,57,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
-	CodeDir                          *wrapperspb.StringValue  `protobuf:"bytes,58,opt,name=code_dir,json=codeDir,proto3" json:"code_dir,omitempty"`
-	ConfigPaths                      *ListStringValue         `protobuf:"bytes,59,opt,name=config_paths,json=configPaths,proto3" json:"config_paths,omitempty"`
-	ColabUrl                         *wrapperspb.StringValue  `protobuf:"bytes,160,opt,name=colab_url,json=colabUrl,proto3" json:"colab_url,omitempty"`
-	Console                          *wrapperspb.StringValue  `protobuf:"bytes,60,opt,name=console,proto3" json:"console,omitempty"`
-	Deployment                       *wrapperspb.StringValue  `protobuf:"bytes,61,opt,name=deployment,proto3" json:"deployment,omitempty"`
-	DisableCode                      *wrapperspb.BoolValue    `protobuf:"bytes,62,opt,name=disable_code,json=disableCode,proto3" json:"disable_code,omitempty"`
-	DisableGit                       *wrapperspb.BoolValue    `protobuf:"bytes,63,opt,name=disable_git,json=disableGit,proto3" json:"disable_git,omitempty"`
-	DisableHints                     *wrapperspb.BoolValue    `protobuf:"bytes,64,opt,name=disable_hints,json=disableHints,proto3" json:"disable_hints,omitempty"`
-	DisableJobCreation               *wrapperspb.BoolValue    `protobuf:"bytes,65,opt,name=disable_job_creation,json=disableJobCreation,proto3" json:"disable_job_creation,omitempty"`
-	Disabled                         *wrapperspb.BoolValue    `protobuf:"bytes,66,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Docker                           *wrapperspb.StringValue  `protobuf:"bytes,67,opt,name=docker,proto3" json:"docker,omitempty"`
-	Email                            *wrapperspb.StringValue  `protobuf:"bytes,68,opt,name=email,proto3" json:"email,omitempty"`
-	Entity                           *wrapperspb.StringValue  `protobuf:"bytes,69,opt,name=entity,proto3" json:"entity,omitempty"`
-	FilesDir                         *wrapperspb.StringValue  `protobuf:"bytes,70,opt,name=files_dir,json=filesDir,proto3" json:"files_dir,omitempty"`
-	Force                            *wrapperspb.BoolValue    `protobuf:"bytes,71,opt,name=force,proto3" json:"force,omitempty"`
-	GitCommit                        *wrapperspb.StringValue  `protobuf:"bytes,72,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
-	GitRemote                        *wrapperspb.StringValue  `protobuf:"bytes,73,opt,name=git_remote,json=gitRemote,proto3" json:"git_remote,omitempty"`
-	GitRemoteUrl                     *wrapperspb.StringValue  `protobuf:"bytes,74,opt,name=git_remote_url,json=gitRemoteUrl,proto3" json:"git_remote_url,omitempty"`
-	GitRoot                          *wrapperspb.StringValue  `protobuf:"bytes,75,opt,name=git_root,json=gitRoot,proto3" json:"git_root,omitempty"`
-	HeartbeatSeconds                 *wrapperspb.Int32Value   `protobuf:"bytes,76,opt,name=heartbeat_seconds,json=heartbeatSeconds,proto3" json:"heartbeat_seconds,omitempty"`
-	Host                             *wrapperspb.StringValue  `protobuf:"bytes,77,opt,name=host,proto3" json:"host,omitempty"`
-	IgnoreGlobs                      *ListStringValue         `protobuf:"bytes,78,opt,name=ignore_globs,json=ignoreGlobs,proto3" json:"ignore_globs,omitempty"`
-	InitTimeout                      *wrapperspb.DoubleValue  `protobuf:"bytes,79,opt,name=init_timeout,json=initTimeout,proto3" json:"init_timeout,omitempty"`
-	IsLocal                          *wrapperspb.BoolValue    `protobuf:"bytes,80,opt,name=is_local,json=isLocal,proto3" json:"is_local,omitempty"`
-	JobName                          *wrapperspb.StringValue  `protobuf:"bytes,145,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	JobSource                        *wrapperspb.StringValue  `protobuf:"bytes,81,opt,name=job_source,json=jobSource,proto3" json:"job_source,omitempty"`
-	LabelDisable                     *wrapperspb.BoolValue    `protobuf:"bytes,82,opt,name=label_disable,json=labelDisable,proto3" json:"label_disable,omitempty"`
-	Launch                           *wrapperspb.BoolValue    `protobuf:"bytes,83,opt,name=launch,proto3" json:"launch,omitempty"`
-	LaunchConfigPath                 *wrapperspb.StringValue  `protobuf:"bytes,84,opt,name=launch_config_path,json=launchConfigPath,proto3" json:"launch_config_path,omitempty"`
-	LogDir                           *wrapperspb.StringValue  `protobuf:"bytes,85,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
-	LogInternal                      *wrapperspb.StringValue  `protobuf:"bytes,86,opt,name=log_internal,json=logInternal,proto3" json:"log_internal,omitempty"`
-	LogSymlinkInternal               *wrapperspb.StringValue  `protobuf:"bytes,87,opt,name=log_symlink_internal,json=logSymlinkInternal,proto3" json:"log_symlink_internal,omitempty"`
-	LogSymlinkUser                   *wrapperspb.StringValue  `protobuf:"bytes,88,opt,name=log_symlink_user,json=logSymlinkUser,proto3" json:"log_symlink_user,omitempty"`
-	LogUser                          *wrapperspb.StringValue  `protobuf:"bytes,89,opt,name=log_user,json=logUser,proto3" json:"log_user,omitempty"`
-	LoginTimeout                     *wrapperspb.DoubleValue  `protobuf:"bytes,90,opt,name=login_timeout,json=loginTimeout,proto3" json:"login_timeout,omitempty"`
-	Mode                             *wrapperspb.StringValue  `protobuf:"bytes,92,opt,name=mode,proto3" json:"mode,omitempty"`
-	NotebookName                     *wrapperspb.StringValue  `protobuf:"bytes,93,opt,name=notebook_name,json=notebookName,proto3" json:"notebook_name,omitempty"`
-	Problem                          *wrapperspb.StringValue  `protobuf:"bytes,94,opt,name=problem,proto3" json:"problem,omitempty"`
-	Program                          *wrapperspb.StringValue  `protobuf:"bytes,95,opt,name=program,proto3" json:"program,omitempty"`
-	ProgramAbspath                   *wrapperspb.StringValue  `protobuf:"bytes,159,opt,name=program_abspath,json=programAbspath,proto3" json:"program_abspath,omitempty"`
-	ProgramRelpath                   *wrapperspb.StringValue  `protobuf:"bytes,96,opt,name=program_relpath,json=programRelpath,proto3" json:"program_relpath,omitempty"`
-	Project                          *wrapperspb.StringValue  `protobuf:"bytes,97,opt,name=project,proto3" json:"project,omitempty"`
-	ProjectUrl                       *wrapperspb.StringValue  `protobuf:"bytes,98,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
-	Quiet                            *wrapperspb.BoolValue    `protobuf:"bytes,99,opt,name=quiet,proto3" json:"quiet,omitempty"`
-	Reinit                           *wrapperspb.BoolValue    `protobuf:"bytes,100,opt,name=reinit,proto3" json:"reinit,omitempty"`
-	Relogin                          *wrapperspb.BoolValue    `protobuf:"bytes,101,opt,name=relogin,proto3" json:"relogin,omitempty"`
-	Resume                           *wrapperspb.StringValue  `protobuf:"bytes,102,opt,name=resume,proto3" json:"resume,omitempty"`
-	ResumeFname                      *wrapperspb.StringValue  `protobuf:"bytes,103,opt,name=resume_fname,json=resumeFname,proto3" json:"resume_fname,omitempty"`
-	Resumed                          *wrapperspb.BoolValue    `protobuf:"bytes,104,opt,name=resumed,proto3" json:"resumed,omitempty"`
-	RootDir                          *wrapperspb.StringValue  `protobuf:"bytes,105,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
-	RunGroup                         *wrapperspb.StringValue  `protobuf:"bytes,106,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
-	RunId                            *wrapperspb.StringValue  `protobuf:"bytes,107,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	RunJobType                       *wrapperspb.StringValue  `protobuf:"bytes,108,opt,name=run_job_type,json=runJobType,proto3" json:"run_job_type,omitempty"`
-	RunMode                          *wrapperspb.StringValue  `protobuf:"bytes,109,opt,name=run_mode,json=runMode,proto3" json:"run_mode,omitempty"`
-	RunName                          *wrapperspb.StringValue  `protobuf:"bytes,110,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
-	RunNotes                         *wrapperspb.StringValue  `protobuf:"bytes,111,opt,name=run_notes,json=runNotes,proto3" json:"run_notes,omitempty"`
-	RunTags                          *ListStringValue         `protobuf:"bytes,112,opt,name=run_tags,json=runTags,proto3" json:"run_tags,omitempty"`
-	RunUrl                           *wrapperspb.StringValue  `protobuf:"bytes,113,opt,name=run_url,json=runUrl,proto3" json:"run_url,omitempty"`
-	SagemakerDisable                 *wrapperspb.BoolValue    `protobuf:"bytes,114,opt,name=sagemaker_disable,json=sagemakerDisable,proto3" json:"sagemaker_disable,omitempty"`
-	SaveCode                         *wrapperspb.BoolValue    `protobuf:"bytes,115,opt,name=save_code,json=saveCode,proto3" json:"save_code,omitempty"`
-	SettingsSystem                   *wrapperspb.StringValue  `protobuf:"bytes,116,opt,name=settings_system,json=settingsSystem,proto3" json:"settings_system,omitempty"`
-	SettingsWorkspace                *wrapperspb.StringValue  `protobuf:"bytes,117,opt,name=settings_workspace,json=settingsWorkspace,proto3" json:"settings_workspace,omitempty"`
-	ShowColors                       *wrapperspb.BoolValue    `protobuf:"bytes,118,opt,name=show_colors,json=showColors,proto3" json:"show_colors,omitempty"`
-	ShowEmoji                        *wrapperspb.BoolValue    `protobuf:"bytes,119,opt,name=show_emoji,json=showEmoji,proto3" json:"show_emoji,omitempty"`
-	ShowErrors                       *wrapperspb.BoolValue    `protobuf:"bytes,120,opt,name=show_errors,json=showErrors,proto3" json:"show_errors,omitempty"`
-	ShowInfo                         *wrapperspb.BoolValue    `protobuf:"bytes,121,opt,name=show_info,json=showInfo,proto3" json:"show_info,omitempty"`
-	ShowWarnings                     *wrapperspb.BoolValue    `protobuf:"bytes,122,opt,name=show_warnings,json=showWarnings,proto3" json:"show_warnings,omitempty"`
-	Silent                           *wrapperspb.BoolValue    `protobuf:"bytes,123,opt,name=silent,proto3" json:"silent,omitempty"`
-	StartMethod                      *wrapperspb.StringValue  `protobuf:"bytes,124,opt,name=start_method,json=startMethod,proto3" json:"start_method,omitempty"`
-	Strict                           *wrapperspb.BoolValue    `protobuf:"bytes,125,opt,name=strict,proto3" json:"strict,omitempty"`
-	SummaryErrors                    *wrapperspb.Int32Value   `protobuf:"bytes,126,opt,name=summary_errors,json=summaryErrors,proto3" json:"summary_errors,omitempty"`
-	SummaryTimeout                   *wrapperspb.Int32Value   `protobuf:"bytes,127,opt,name=summary_timeout,json=summaryTimeout,proto3" json:"summary_timeout,omitempty"`
-	SummaryWarnings                  *wrapperspb.Int32Value   `protobuf:"bytes,128,opt,name=summary_warnings,json=summaryWarnings,proto3" json:"summary_warnings,omitempty"`
-	SweepId                          *wrapperspb.StringValue  `protobuf:"bytes,129,opt,name=sweep_id,json=sweepId,proto3" json:"sweep_id,omitempty"`
-	SweepParamPath                   *wrapperspb.StringValue  `protobuf:"bytes,130,opt,name=sweep_param_path,json=sweepParamPath,proto3" json:"sweep_param_path,omitempty"`
-	SweepUrl                         *wrapperspb.StringValue  `protobuf:"bytes,131,opt,name=sweep_url,json=sweepUrl,proto3" json:"sweep_url,omitempty"`
-	Symlink                          *wrapperspb.BoolValue    `protobuf:"bytes,132,opt,name=symlink,proto3" json:"symlink,omitempty"`
-	SyncDir                          *wrapperspb.StringValue  `protobuf:"bytes,133,opt,name=sync_dir,json=syncDir,proto3" json:"sync_dir,omitempty"`
-	SyncFile                         *wrapperspb.StringValue  `protobuf:"bytes,134,opt,name=sync_file,json=syncFile,proto3" json:"sync_file,omitempty"`
-	SyncSymlinkLatest                *wrapperspb.StringValue  `protobuf:"bytes,135,opt,name=sync_symlink_latest,json=syncSymlinkLatest,proto3" json:"sync_symlink_latest,omitempty"`
-	SystemSample                     *wrapperspb.Int32Value   `protobuf:"bytes,136,opt,name=system_sample,json=systemSample,proto3" json:"system_sample,omitempty"`
-	SystemSampleSeconds              *wrapperspb.Int32Value   `protobuf:"bytes,137,opt,name=system_sample_seconds,json=systemSampleSeconds,proto3" json:"system_sample_seconds,omitempty"`
-	TableRaiseOnMaxRowLimitExceeded  *wrapperspb.BoolValue    `protobuf:"bytes,138,opt,name=table_raise_on_max_row_limit_exceeded,json=tableRaiseOnMaxRowLimitExceeded,proto3" json:"table_raise_on_max_row_limit_exceeded,omitempty"`
-	Timespec                         *wrapperspb.StringValue  `protobuf:"bytes,139,opt,name=timespec,proto3" json:"timespec,omitempty"`
-	TmpDir                           *wrapperspb.StringValue  `protobuf:"bytes,140,opt,name=tmp_dir,json=tmpDir,proto3" json:"tmp_dir,omitempty"`
-	Username                         *wrapperspb.StringValue  `protobuf:"bytes,141,opt,name=username,proto3" json:"username,omitempty"`
-	WandbDir                         *wrapperspb.StringValue  `protobuf:"bytes,142,opt,name=wandb_dir,json=wandbDir,proto3" json:"wandb_dir,omitempty"`
+	XArgs                            *ListStringValue                     `protobuf:"bytes,1,opt,name=_args,json=Args,proto3" json:"_args,omitempty"`
+	XAwsLambda                       *wrapperspb.BoolValue                `protobuf:"bytes,2,opt,name=_aws_lambda,json=AwsLambda,proto3" json:"_aws_lambda,omitempty"`
+	XAsyncUploadConcurrencyLimit     *wrapperspb.Int32Value               `protobuf:"bytes,3,opt,name=_async_upload_concurrency_limit,json=AsyncUploadConcurrencyLimit,proto3" json:"_async_upload_concurrency_limit,omitempty"`
+	XCliOnlyMode                     *wrapperspb.BoolValue                `protobuf:"bytes,4,opt,name=_cli_only_mode,json=CliOnlyMode,proto3" json:"_cli_only_mode,omitempty"`
+	XColab                           *wrapperspb.BoolValue                `protobuf:"bytes,5,opt,name=_colab,json=Colab,proto3" json:"_colab,omitempty"`
+	XConfigTimelineHistory           *wrapperspb.BoolValue                `protobuf:"bytes,166,opt,name=_config_timeline_history,json=ConfigTimelineHistory,proto3" json:"_config_timeline_history,omitempty"`
+	XCredentialHelperTimeoutSeconds  *wrapperspb.DoubleValue              `protobuf:"bytes,170,opt,name=_credential_helper_timeout_seconds,json=CredentialHelperTimeoutSeconds,proto3" json:"_credential_helper_timeout_seconds,omitempty"`
+	XCuda                            *wrapperspb.StringValue              `protobuf:"bytes,6,opt,name=_cuda,json=Cuda,proto3" json:"_cuda,omitempty"`
+	XDisableMeta                     *wrapperspb.BoolValue                `protobuf:"bytes,7,opt,name=_disable_meta,json=DisableMeta,proto3" json:"_disable_meta,omitempty"`
+	XDisableService                  *wrapperspb.BoolValue                `protobuf:"bytes,8,opt,name=_disable_service,json=DisableService,proto3" json:"_disable_service,omitempty"`
+	XDisableSetproctitle             *wrapperspb.BoolValue                `protobuf:"bytes,9,opt,name=_disable_setproctitle,json=DisableSetproctitle,proto3" json:"_disable_setproctitle,omitempty"`
+	XDisableStats                    *wrapperspb.BoolValue                `protobuf:"bytes,10,opt,name=_disable_stats,json=DisableStats,proto3" json:"_disable_stats,omitempty"`
+	XDisableViewer                   *wrapperspb.BoolValue                `protobuf:"bytes,11,opt,name=_disable_viewer,json=DisableViewer,proto3" json:"_disable_viewer,omitempty"`
+	XDisableMachineInfo              *wrapperspb.BoolValue                `protobuf:"bytes,158,opt,name=_disable_machine_info,json=DisableMachineInfo,proto3" json:"_disable_machine_info,omitempty"`
+	XExceptExit                      *wrapperspb.BoolValue                `protobuf:"bytes,12,opt,name=_except_exit,json=ExceptExit,proto3" json:"_except_exit,omitempty"`
+	XExecutable                      *wrapperspb.StringValue              `protobuf:"bytes,13,opt,name=_executable,json=Executable,proto3" json:"_executable,omitempty"`
+	XExtraHttpHeaders                *MapStringKeyStringValue             `protobuf:"bytes,14,opt,name=_extra_http_headers,json=ExtraHttpHeaders,proto3" json:"_extra_http_headers,omitempty"`
+	XProxies                         *MapStringKeyStringValue             `protobuf:"bytes,200,opt,name=_proxies,json=Proxies,proto3" json:"_proxies,omitempty"`
+	XFileStreamRetryMax              *wrapperspb.Int32Value               `protobuf:"bytes,147,opt,name=_file_stream_retry_max,json=FileStreamRetryMax,proto3" json:"_file_stream_retry_max,omitempty"`
+	XFileStreamRetryWaitMinSeconds   *wrapperspb.Int32Value               `protobuf:"bytes,148,opt,name=_file_stream_retry_wait_min_seconds,json=FileStreamRetryWaitMinSeconds,proto3" json:"_file_stream_retry_wait_min_seconds,omitempty"`
+	XFileStreamRetryWaitMaxSeconds   *wrapperspb.Int32Value               `protobuf:"bytes,149,opt,name=_file_stream_retry_wait_max_seconds,json=FileStreamRetryWaitMaxSeconds,proto3" json:"_file_stream_retry_wait_max_seconds,omitempty"`
+	XFileStreamTimeoutSeconds        *wrapperspb.Int32Value               `protobuf:"bytes,15,opt,name=_file_stream_timeout_seconds,json=FileStreamTimeoutSeconds,proto3" json:"_file_stream_timeout_seconds,omitempty"`
+	XFileTransferRetryMax            *wrapperspb.Int32Value               `protobuf:"bytes,150,opt,name=_file_transfer_retry_max,json=FileTransferRetryMax,proto3" json:"_file_transfer_retry_max,omitempty"`
+	XFileTransferRetryWaitMinSeconds *wrapperspb.Int32Value               `protobuf:"bytes,151,opt,name=_file_transfer_retry_wait_min_seconds,json=FileTransferRetryWaitMinSeconds,proto3" json:"_file_transfer_retry_wait_min_seconds,omitempty"`
+	XFileTransferRetryWaitMaxSeconds *wrapperspb.Int32Value               `protobuf:"bytes,152,opt,name=_file_transfer_retry_wait_max_seconds,json=FileTransferRetryWaitMaxSeconds,proto3" json:"_file_transfer_retry_wait_max_seconds,omitempty"`
+	XFileTransferTimeoutSeconds      *wrapperspb.Int32Value               `protobuf:"bytes,153,opt,name=_file_transfer_timeout_seconds,json=FileTransferTimeoutSeconds,proto3" json:"_file_transfer_timeout_seconds,omitempty"`
+	XFlowControlCustom               *wrapperspb.BoolValue                `protobuf:"bytes,16,opt,name=_flow_control_custom,json=FlowControlCustom,proto3" json:"_flow_control_custom,omitempty"`
+	XFlowControlDisabled             *wrapperspb.BoolValue                `protobuf:"bytes,17,opt,name=_flow_control_disabled,json=FlowControlDisabled,proto3" json:"_flow_control_disabled,omitempty"`
+	XGraphqlCacheTtls                *MapStringKeyStringValue             `protobuf:"bytes,179,opt,name=_graphql_cache_ttls,json=GraphqlCacheTtls,proto3" json:"_graphql_cache_ttls,omitempty"`
+	XGraphqlRetryMax                 *wrapperspb.Int32Value               `protobuf:"bytes,154,opt,name=_graphql_retry_max,json=GraphqlRetryMax,proto3" json:"_graphql_retry_max,omitempty"`
+	XGraphqlRetryWaitMinSeconds      *wrapperspb.Int32Value               `protobuf:"bytes,155,opt,name=_graphql_retry_wait_min_seconds,json=GraphqlRetryWaitMinSeconds,proto3" json:"_graphql_retry_wait_min_seconds,omitempty"`
+	XGraphqlRetryWaitMaxSeconds      *wrapperspb.Int32Value               `protobuf:"bytes,156,opt,name=_graphql_retry_wait_max_seconds,json=GraphqlRetryWaitMaxSeconds,proto3" json:"_graphql_retry_wait_max_seconds,omitempty"`
+	XGraphqlTimeoutSeconds           *wrapperspb.Int32Value               `protobuf:"bytes,157,opt,name=_graphql_timeout_seconds,json=GraphqlTimeoutSeconds,proto3" json:"_graphql_timeout_seconds,omitempty"`
+	XHistoryRateLimit                *wrapperspb.Int32Value               `protobuf:"bytes,162,opt,name=_history_rate_limit,json=HistoryRateLimit,proto3" json:"_history_rate_limit,omitempty"`
+	XHistoryRateLimitPolicy          *wrapperspb.StringValue              `protobuf:"bytes,163,opt,name=_history_rate_limit_policy,json=HistoryRateLimitPolicy,proto3" json:"_history_rate_limit_policy,omitempty"`
+	XHistoryTypePolicy               *wrapperspb.StringValue              `protobuf:"bytes,177,opt,name=_history_type_policy,json=HistoryTypePolicy,proto3" json:"_history_type_policy,omitempty"`
+	XIngestHttpAddress               *wrapperspb.StringValue              `protobuf:"bytes,173,opt,name=_ingest_http_address,json=IngestHttpAddress,proto3" json:"_ingest_http_address,omitempty"`
+	XIngestIntervalSeconds           *wrapperspb.DoubleValue              `protobuf:"bytes,175,opt,name=_ingest_interval_seconds,json=IngestIntervalSeconds,proto3" json:"_ingest_interval_seconds,omitempty"`
+	XIngestPrefix                    *wrapperspb.StringValue              `protobuf:"bytes,174,opt,name=_ingest_prefix,json=IngestPrefix,proto3" json:"_ingest_prefix,omitempty"`
+	XIngestStatsdAddress             *wrapperspb.StringValue              `protobuf:"bytes,172,opt,name=_ingest_statsd_address,json=IngestStatsdAddress,proto3" json:"_ingest_statsd_address,omitempty"`
+	XIngestTarget                    *wrapperspb.StringValue              `protobuf:"bytes,176,opt,name=_ingest_target,json=IngestTarget,proto3" json:"_ingest_target,omitempty"`
+	XInternalCheckProcess            *wrapperspb.DoubleValue              `protobuf:"bytes,18,opt,name=_internal_check_process,json=InternalCheckProcess,proto3" json:"_internal_check_process,omitempty"`
+	XInternalQueueTimeout            *wrapperspb.DoubleValue              `protobuf:"bytes,19,opt,name=_internal_queue_timeout,json=InternalQueueTimeout,proto3" json:"_internal_queue_timeout,omitempty"`
+	XIpython                         *wrapperspb.BoolValue                `protobuf:"bytes,20,opt,name=_ipython,json=Ipython,proto3" json:"_ipython,omitempty"`
+	XJupyter                         *wrapperspb.BoolValue                `protobuf:"bytes,21,opt,name=_jupyter,json=Jupyter,proto3" json:"_jupyter,omitempty"`
+	XJupyterName                     *wrapperspb.StringValue              `protobuf:"bytes,143,opt,name=_jupyter_name,json=JupyterName,proto3" json:"_jupyter_name,omitempty"`
+	XJupyterPath                     *wrapperspb.StringValue              `protobuf:"bytes,144,opt,name=_jupyter_path,json=JupyterPath,proto3" json:"_jupyter_path,omitempty"`
+	XJupyterRoot                     *wrapperspb.StringValue              `protobuf:"bytes,22,opt,name=_jupyter_root,json=JupyterRoot,proto3" json:"_jupyter_root,omitempty"`
+	XKaggle                          *wrapperspb.BoolValue                `protobuf:"bytes,23,opt,name=_kaggle,json=Kaggle,proto3" json:"_kaggle,omitempty"`
+	XLivePolicyRateLimit             *wrapperspb.Int32Value               `protobuf:"bytes,24,opt,name=_live_policy_rate_limit,json=LivePolicyRateLimit,proto3" json:"_live_policy_rate_limit,omitempty"`
+	XLivePolicyWaitTime              *wrapperspb.Int32Value               `protobuf:"bytes,25,opt,name=_live_policy_wait_time,json=LivePolicyWaitTime,proto3" json:"_live_policy_wait_time,omitempty"`
+	XLogLevel                        *wrapperspb.Int32Value               `protobuf:"bytes,26,opt,name=_log_level,json=LogLevel,proto3" json:"_log_level,omitempty"`
+	XMirrorShutdownTimeoutSeconds    *wrapperspb.DoubleValue              `protobuf:"bytes,183,opt,name=_mirror_shutdown_timeout_seconds,json=MirrorShutdownTimeoutSeconds,proto3" json:"_mirror_shutdown_timeout_seconds,omitempty"`
+	XMirrors                         *MapStringKeyMapStringKeyStringValue `protobuf:"bytes,171,opt,name=_mirrors,json=Mirrors,proto3" json:"_mirrors,omitempty"`
+	XNetworkBuffer                   *wrapperspb.Int32Value               `protobuf:"bytes,27,opt,name=_network_buffer,json=NetworkBuffer,proto3" json:"_network_buffer,omitempty"`
+	XNoop                            *wrapperspb.BoolValue                `protobuf:"bytes,28,opt,name=_noop,json=Noop,proto3" json:"_noop,omitempty"`
+	XNotebook                        *wrapperspb.BoolValue                `protobuf:"bytes,29,opt,name=_notebook,json=Notebook,proto3" json:"_notebook,omitempty"`
+	XOffline                         *wrapperspb.BoolValue                `protobuf:"bytes,30,opt,name=_offline,json=Offline,proto3" json:"_offline,omitempty"`
+	XSync                            *wrapperspb.BoolValue                `protobuf:"bytes,31,opt,name=_sync,json=Sync,proto3" json:"_sync,omitempty"`
+	XOs                              *wrapperspb.StringValue              `protobuf:"bytes,32,opt,name=_os,json=Os,proto3" json:"_os,omitempty"`
+	XPauseSubsystems                 *ListStringValue                     `protobuf:"bytes,165,opt,name=_pause_subsystems,json=PauseSubsystems,proto3" json:"_pause_subsystems,omitempty"`
+	XPlatform                        *wrapperspb.StringValue              `protobuf:"bytes,33,opt,name=_platform,json=Platform,proto3" json:"_platform,omitempty"`
+	XPython                          *wrapperspb.StringValue              `protobuf:"bytes,34,opt,name=_python,json=Python,proto3" json:"_python,omitempty"`
+	XRunPullIntervalSeconds          *wrapperspb.DoubleValue              `protobuf:"bytes,178,opt,name=_run_pull_interval_seconds,json=RunPullIntervalSeconds,proto3" json:"_run_pull_interval_seconds,omitempty"`
+	XRunUpsertBurst                  *wrapperspb.Int32Value               `protobuf:"bytes,167,opt,name=_run_upsert_burst,json=RunUpsertBurst,proto3" json:"_run_upsert_burst,omitempty"`
+	XRunUpsertIntervalSeconds        *wrapperspb.DoubleValue              `protobuf:"bytes,168,opt,name=_run_upsert_interval_seconds,json=RunUpsertIntervalSeconds,proto3" json:"_run_upsert_interval_seconds,omitempty"`
+	XRunqueueItemId                  *wrapperspb.StringValue              `protobuf:"bytes,35,opt,name=_runqueue_item_id,json=RunqueueItemId,proto3" json:"_runqueue_item_id,omitempty"`
+	XRequireCore                     *wrapperspb.BoolValue                `protobuf:"bytes,36,opt,name=_require_core,json=RequireCore,proto3" json:"_require_core,omitempty"`
+	XResourcePriceTable              *wrapperspb.StringValue              `protobuf:"bytes,180,opt,name=_resource_price_table,json=ResourcePriceTable,proto3" json:"_resource_price_table,omitempty"`
+	XSaveRequirements                *wrapperspb.BoolValue                `protobuf:"bytes,37,opt,name=_save_requirements,json=SaveRequirements,proto3" json:"_save_requirements,omitempty"`
+	XSaveHistoryAndSummary           *wrapperspb.BoolValue                `protobuf:"bytes,164,opt,name=_save_history_and_summary,json=SaveHistoryAndSummary,proto3" json:"_save_history_and_summary,omitempty"`
+	XServiceTransport                *wrapperspb.StringValue              `protobuf:"bytes,38,opt,name=_service_transport,json=ServiceTransport,proto3" json:"_service_transport,omitempty"`
+	XServiceWait                     *wrapperspb.DoubleValue              `protobuf:"bytes,39,opt,name=_service_wait,json=ServiceWait,proto3" json:"_service_wait,omitempty"`
+	XStartDatetime                   *wrapperspb.StringValue              `protobuf:"bytes,40,opt,name=_start_datetime,json=StartDatetime,proto3" json:"_start_datetime,omitempty"`
+	XStartTime                       *wrapperspb.DoubleValue              `protobuf:"bytes,41,opt,name=_start_time,json=StartTime,proto3" json:"_start_time,omitempty"`
+	XStatsPid                        *wrapperspb.Int32Value               `protobuf:"bytes,42,opt,name=_stats_pid,json=StatsPid,proto3" json:"_stats_pid,omitempty"`
+	XStatsSampleRateSeconds          *wrapperspb.DoubleValue              `protobuf:"bytes,43,opt,name=_stats_sample_rate_seconds,json=StatsSampleRateSeconds,proto3" json:"_stats_sample_rate_seconds,omitempty"`
+	XStatsSamplesToAverage           *wrapperspb.Int32Value               `protobuf:"bytes,44,opt,name=_stats_samples_to_average,json=StatsSamplesToAverage,proto3" json:"_stats_samples_to_average,omitempty"`
+	XStatsJoinAssets                 *wrapperspb.BoolValue                `protobuf:"bytes,45,opt,name=_stats_join_assets,json=StatsJoinAssets,proto3" json:"_stats_join_assets,omitempty"`
+	XStatsNeuronMonitorConfigPath    *wrapperspb.StringValue              `protobuf:"bytes,46,opt,name=_stats_neuron_monitor_config_path,json=StatsNeuronMonitorConfigPath,proto3" json:"_stats_neuron_monitor_config_path,omitempty"`
+	XStatsOpenMetricsEndpoints       *MapStringKeyStringValue             `protobuf:"bytes,47,opt,name=_stats_open_metrics_endpoints,json=StatsOpenMetricsEndpoints,proto3" json:"_stats_open_metrics_endpoints,omitempty"`
+	XStatsOpenMetricsFilters         *OpenMetricsFilters                  `protobuf:"bytes,48,opt,name=_stats_open_metrics_filters,json=StatsOpenMetricsFilters,proto3" json:"_stats_open_metrics_filters,omitempty"`
+	XStatsDiskPaths                  *ListStringValue                     `protobuf:"bytes,146,opt,name=_stats_disk_paths,json=StatsDiskPaths,proto3" json:"_stats_disk_paths,omitempty"`
+	XStatsBufferSize                 *wrapperspb.Int32Value               `protobuf:"bytes,161,opt,name=_stats_buffer_size,json=StatsBufferSize,proto3" json:"_stats_buffer_size,omitempty"`
+	XStopPollingIntervalSeconds      *wrapperspb.DoubleValue              `protobuf:"bytes,182,opt,name=_stop_polling_interval_seconds,json=StopPollingIntervalSeconds,proto3" json:"_stop_polling_interval_seconds,omitempty"`
+	XSymlinkPolicy                   *wrapperspb.StringValue              `protobuf:"bytes,181,opt,name=_symlink_policy,json=SymlinkPolicy,proto3" json:"_symlink_policy,omitempty"`
+	XTmpCodeDir                      *wrapperspb.StringValue              `protobuf:"bytes,49,opt,name=_tmp_code_dir,json=TmpCodeDir,proto3" json:"_tmp_code_dir,omitempty"`
+	XTracelog                        *wrapperspb.StringValue              `protobuf:"bytes,50,opt,name=_tracelog,json=Tracelog,proto3" json:"_tracelog,omitempty"`
+	XUnsavedKeys                     *ListStringValue                     `protobuf:"bytes,51,opt,name=_unsaved_keys,json=UnsavedKeys,proto3" json:"_unsaved_keys,omitempty"`
+	XWindows                         *wrapperspb.BoolValue                `protobuf:"bytes,52,opt,name=_windows,json=Windows,proto3" json:"_windows,omitempty"`
+	AllowValChange                   *wrapperspb.BoolValue                `protobuf:"bytes,53,opt,name=allow_val_change,json=allowValChange,proto3" json:"allow_val_change,omitempty"`
+	Anonymous                        *wrapperspb.StringValue              `protobuf:"bytes,54,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	ApiKey                           *wrapperspb.StringValue              `protobuf:"bytes,55,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	AzureAccountUrlToAccessKey       *MapStringKeyStringValue             `protobuf:"bytes,56,opt,name=azure_account_url_to_access_key,json=azureAccountUrlToAccessKey,proto3" json:"azure_account_url_to_access_key,omitempty"`
+	BaseUrl                          *wrapperspb.StringValue              `protobuf:"bytes,57,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	CodeDir                          *wrapperspb.StringValue              `protobuf:"bytes,58,opt,name=code_dir,json=codeDir,proto3" json:"code_dir,omitempty"`
+	ConfigPaths                      *ListStringValue                     `protobuf:"bytes,59,opt,name=config_paths,json=configPaths,proto3" json:"config_paths,omitempty"`
+	ColabUrl                         *wrapperspb.StringValue              `protobuf:"bytes,160,opt,name=colab_url,json=colabUrl,proto3" json:"colab_url,omitempty"`
+	Console                          *wrapperspb.StringValue              `protobuf:"bytes,60,opt,name=console,proto3" json:"console,omitempty"`
+	CredentialHelper                 *wrapperspb.StringValue              `protobuf:"bytes,169,opt,name=credential_helper,json=credentialHelper,proto3" json:"credential_helper,omitempty"`
+	Deployment                       *wrapperspb.StringValue              `protobuf:"bytes,61,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	DisableCode                      *wrapperspb.BoolValue                `protobuf:"bytes,62,opt,name=disable_code,json=disableCode,proto3" json:"disable_code,omitempty"`
+	DisableGit                       *wrapperspb.BoolValue                `protobuf:"bytes,63,opt,name=disable_git,json=disableGit,proto3" json:"disable_git,omitempty"`
+	DisableHints                     *wrapperspb.BoolValue                `protobuf:"bytes,64,opt,name=disable_hints,json=disableHints,proto3" json:"disable_hints,omitempty"`
+	DisableJobCreation               *wrapperspb.BoolValue                `protobuf:"bytes,65,opt,name=disable_job_creation,json=disableJobCreation,proto3" json:"disable_job_creation,omitempty"`
+	Disabled                         *wrapperspb.BoolValue                `protobuf:"bytes,66,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Docker                           *wrapperspb.StringValue              `protobuf:"bytes,67,opt,name=docker,proto3" json:"docker,omitempty"`
+	Email                            *wrapperspb.StringValue              `protobuf:"bytes,68,opt,name=email,proto3" json:"email,omitempty"`
+	Entity                           *wrapperspb.StringValue              `protobuf:"bytes,69,opt,name=entity,proto3" json:"entity,omitempty"`
+	FilesDir                         *wrapperspb.StringValue              `protobuf:"bytes,70,opt,name=files_dir,json=filesDir,proto3" json:"files_dir,omitempty"`
+	Force                            *wrapperspb.BoolValue                `protobuf:"bytes,71,opt,name=force,proto3" json:"force,omitempty"`
+	GitCommit                        *wrapperspb.StringValue              `protobuf:"bytes,72,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	GitRemote                        *wrapperspb.StringValue              `protobuf:"bytes,73,opt,name=git_remote,json=gitRemote,proto3" json:"git_remote,omitempty"`
+	GitRemoteUrl                     *wrapperspb.StringValue              `protobuf:"bytes,74,opt,name=git_remote_url,json=gitRemoteUrl,proto3" json:"git_remote_url,omitempty"`
+	GitRoot                          *wrapperspb.StringValue              `protobuf:"bytes,75,opt,name=git_root,json=gitRoot,proto3" json:"git_root,omitempty"`
+	HeartbeatSeconds                 *wrapperspb.Int32Value               `protobuf:"bytes,76,opt,name=heartbeat_seconds,json=heartbeatSeconds,proto3" json:"heartbeat_seconds,omitempty"`
+	Host                             *wrapperspb.StringValue              `protobuf:"bytes,77,opt,name=host,proto3" json:"host,omitempty"`
+	IgnoreGlobs                      *ListStringValue                     `protobuf:"bytes,78,opt,name=ignore_globs,json=ignoreGlobs,proto3" json:"ignore_globs,omitempty"`
+	InitTimeout                      *wrapperspb.DoubleValue              `protobuf:"bytes,79,opt,name=init_timeout,json=initTimeout,proto3" json:"init_timeout,omitempty"`
+	IsLocal                          *wrapperspb.BoolValue                `protobuf:"bytes,80,opt,name=is_local,json=isLocal,proto3" json:"is_local,omitempty"`
+	JobName                          *wrapperspb.StringValue              `protobuf:"bytes,145,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	JobSource                        *wrapperspb.StringValue              `protobuf:"bytes,81,opt,name=job_source,json=jobSource,proto3" json:"job_source,omitempty"`
+	LabelDisable                     *wrapperspb.BoolValue                `protobuf:"bytes,82,opt,name=label_disable,json=labelDisable,proto3" json:"label_disable,omitempty"`
+	Launch                           *wrapperspb.BoolValue                `protobuf:"bytes,83,opt,name=launch,proto3" json:"launch,omitempty"`
+	LaunchConfigPath                 *wrapperspb.StringValue              `protobuf:"bytes,84,opt,name=launch_config_path,json=launchConfigPath,proto3" json:"launch_config_path,omitempty"`
+	LogDir                           *wrapperspb.StringValue              `protobuf:"bytes,85,opt,name=log_dir,json=logDir,proto3" json:"log_dir,omitempty"`
+	LogInternal                      *wrapperspb.StringValue              `protobuf:"bytes,86,opt,name=log_internal,json=logInternal,proto3" json:"log_internal,omitempty"`
+	LogSymlinkInternal               *wrapperspb.StringValue              `protobuf:"bytes,87,opt,name=log_symlink_internal,json=logSymlinkInternal,proto3" json:"log_symlink_internal,omitempty"`
+	LogSymlinkUser                   *wrapperspb.StringValue              `protobuf:"bytes,88,opt,name=log_symlink_user,json=logSymlinkUser,proto3" json:"log_symlink_user,omitempty"`
+	LogUser                          *wrapperspb.StringValue              `protobuf:"bytes,89,opt,name=log_user,json=logUser,proto3" json:"log_user,omitempty"`
+	LoginTimeout                     *wrapperspb.DoubleValue              `protobuf:"bytes,90,opt,name=login_timeout,json=loginTimeout,proto3" json:"login_timeout,omitempty"`
+	Mode                             *wrapperspb.StringValue              `protobuf:"bytes,92,opt,name=mode,proto3" json:"mode,omitempty"`
+	NotebookName                     *wrapperspb.StringValue              `protobuf:"bytes,93,opt,name=notebook_name,json=notebookName,proto3" json:"notebook_name,omitempty"`
+	Problem                          *wrapperspb.StringValue              `protobuf:"bytes,94,opt,name=problem,proto3" json:"problem,omitempty"`
+	Program                          *wrapperspb.StringValue              `protobuf:"bytes,95,opt,name=program,proto3" json:"program,omitempty"`
+	ProgramAbspath                   *wrapperspb.StringValue              `protobuf:"bytes,159,opt,name=program_abspath,json=programAbspath,proto3" json:"program_abspath,omitempty"`
+	ProgramRelpath                   *wrapperspb.StringValue              `protobuf:"bytes,96,opt,name=program_relpath,json=programRelpath,proto3" json:"program_relpath,omitempty"`
+	Project                          *wrapperspb.StringValue              `protobuf:"bytes,97,opt,name=project,proto3" json:"project,omitempty"`
+	ProjectUrl                       *wrapperspb.StringValue              `protobuf:"bytes,98,opt,name=project_url,json=projectUrl,proto3" json:"project_url,omitempty"`
+	Quiet                            *wrapperspb.BoolValue                `protobuf:"bytes,99,opt,name=quiet,proto3" json:"quiet,omitempty"`
+	Reinit                           *wrapperspb.BoolValue                `protobuf:"bytes,100,opt,name=reinit,proto3" json:"reinit,omitempty"`
+	Relogin                          *wrapperspb.BoolValue                `protobuf:"bytes,101,opt,name=relogin,proto3" json:"relogin,omitempty"`
+	Resume                           *wrapperspb.StringValue              `protobuf:"bytes,102,opt,name=resume,proto3" json:"resume,omitempty"`
+	ResumeFname                      *wrapperspb.StringValue              `protobuf:"bytes,103,opt,name=resume_fname,json=resumeFname,proto3" json:"resume_fname,omitempty"`
+	Resumed                          *wrapperspb.BoolValue                `protobuf:"bytes,104,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	RootDir                          *wrapperspb.StringValue              `protobuf:"bytes,105,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	RunGroup                         *wrapperspb.StringValue              `protobuf:"bytes,106,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	RunId                            *wrapperspb.StringValue              `protobuf:"bytes,107,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RunJobType                       *wrapperspb.StringValue              `protobuf:"bytes,108,opt,name=run_job_type,json=runJobType,proto3" json:"run_job_type,omitempty"`
+	RunMode                          *wrapperspb.StringValue              `protobuf:"bytes,109,opt,name=run_mode,json=runMode,proto3" json:"run_mode,omitempty"`
+	RunName                          *wrapperspb.StringValue              `protobuf:"bytes,110,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
+	RunNotes                         *wrapperspb.StringValue              `protobuf:"bytes,111,opt,name=run_notes,json=runNotes,proto3" json:"run_notes,omitempty"`
+	RunTags                          *ListStringValue                     `protobuf:"bytes,112,opt,name=run_tags,json=runTags,proto3" json:"run_tags,omitempty"`
+	RunUrl                           *wrapperspb.StringValue              `protobuf:"bytes,113,opt,name=run_url,json=runUrl,proto3" json:"run_url,omitempty"`
+	SagemakerDisable                 *wrapperspb.BoolValue                `protobuf:"bytes,114,opt,name=sagemaker_disable,json=sagemakerDisable,proto3" json:"sagemaker_disable,omitempty"`
+	SaveCode                         *wrapperspb.BoolValue                `protobuf:"bytes,115,opt,name=save_code,json=saveCode,proto3" json:"save_code,omitempty"`
+	SettingsSystem                   *wrapperspb.StringValue              `protobuf:"bytes,116,opt,name=settings_system,json=settingsSystem,proto3" json:"settings_system,omitempty"`
+	SettingsWorkspace                *wrapperspb.StringValue              `protobuf:"bytes,117,opt,name=settings_workspace,json=settingsWorkspace,proto3" json:"settings_workspace,omitempty"`
+	ShowColors                       *wrapperspb.BoolValue                `protobuf:"bytes,118,opt,name=show_colors,json=showColors,proto3" json:"show_colors,omitempty"`
+	ShowEmoji                        *wrapperspb.BoolValue                `protobuf:"bytes,119,opt,name=show_emoji,json=showEmoji,proto3" json:"show_emoji,omitempty"`
+	ShowErrors                       *wrapperspb.BoolValue                `protobuf:"bytes,120,opt,name=show_errors,json=showErrors,proto3" json:"show_errors,omitempty"`
+	ShowInfo                         *wrapperspb.BoolValue                `protobuf:"bytes,121,opt,name=show_info,json=showInfo,proto3" json:"show_info,omitempty"`
+	ShowWarnings                     *wrapperspb.BoolValue                `protobuf:"bytes,122,opt,name=show_warnings,json=showWarnings,proto3" json:"show_warnings,omitempty"`
+	Silent                           *wrapperspb.BoolValue                `protobuf:"bytes,123,opt,name=silent,proto3" json:"silent,omitempty"`
+	StartMethod                      *wrapperspb.StringValue              `protobuf:"bytes,124,opt,name=start_method,json=startMethod,proto3" json:"start_method,omitempty"`
+	Strict                           *wrapperspb.BoolValue                `protobuf:"bytes,125,opt,name=strict,proto3" json:"strict,omitempty"`
+	SummaryErrors                    *wrapperspb.Int32Value               `protobuf:"bytes,126,opt,name=summary_errors,json=summaryErrors,proto3" json:"summary_errors,omitempty"`
+	SummaryTimeout                   *wrapperspb.Int32Value               `protobuf:"bytes,127,opt,name=summary_timeout,json=summaryTimeout,proto3" json:"summary_timeout,omitempty"`
+	SummaryWarnings                  *wrapperspb.Int32Value               `protobuf:"bytes,128,opt,name=summary_warnings,json=summaryWarnings,proto3" json:"summary_warnings,omitempty"`
+	SweepId                          *wrapperspb.StringValue              `protobuf:"bytes,129,opt,name=sweep_id,json=sweepId,proto3" json:"sweep_id,omitempty"`
+	SweepParamPath                   *wrapperspb.StringValue              `protobuf:"bytes,130,opt,name=sweep_param_path,json=sweepParamPath,proto3" json:"sweep_param_path,omitempty"`
+	SweepUrl                         *wrapperspb.StringValue              `protobuf:"bytes,131,opt,name=sweep_url,json=sweepUrl,proto3" json:"sweep_url,omitempty"`
+	Symlink                          *wrapperspb.BoolValue                `protobuf:"bytes,132,opt,name=symlink,proto3" json:"symlink,omitempty"`
+	SyncDir                          *wrapperspb.StringValue              `protobuf:"bytes,133,opt,name=sync_dir,json=syncDir,proto3" json:"sync_dir,omitempty"`
+	SyncFile                         *wrapperspb.StringValue              `protobuf:"bytes,134,opt,name=sync_file,json=syncFile,proto3" json:"sync_file,omitempty"`
+	SyncSymlinkLatest                *wrapperspb.StringValue              `protobuf:"bytes,135,opt,name=sync_symlink_latest,json=syncSymlinkLatest,proto3" json:"sync_symlink_latest,omitempty"`
+	SystemSample                     *wrapperspb.Int32Value               `protobuf:"bytes,136,opt,name=system_sample,json=systemSample,proto3" json:"system_sample,omitempty"`
+	SystemSampleSeconds              *wrapperspb.Int32Value               `protobuf:"bytes,137,opt,name=system_sample_seconds,json=systemSampleSeconds,proto3" json:"system_sample_seconds,omitempty"`
+	TableRaiseOnMaxRowLimitExceeded  *wrapperspb.BoolValue                `protobuf:"bytes,138,opt,name=table_raise_on_max_row_limit_exceeded,json=tableRaiseOnMaxRowLimitExceeded,proto3" json:"table_raise_on_max_row_limit_exceeded,omitempty"`
+	Timespec                         *wrapperspb.StringValue              `protobuf:"bytes,139,opt,name=timespec,proto3" json:"timespec,omitempty"`
+	TmpDir                           *wrapperspb.StringValue              `protobuf:"bytes,140,opt,name=tmp_dir,json=tmpDir,proto3" json:"tmp_dir,omitempty"`
+	Username                         *wrapperspb.StringValue              `protobuf:"bytes,141,opt,name=username,proto3" json:"username,omitempty"`
+	WandbDir                         *wrapperspb.StringValue              `protobuf:"bytes,142,opt,name=wandb_dir,json=wandbDir,proto3" json:"wandb_dir,omitempty"`
 }
 
 func (x *Settings) Reset() {
@@ -478,6 +500,20 @@ func (x *Settings) GetXColab() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetXConfigTimelineHistory() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XConfigTimelineHistory
+	}
+	return nil
+}
+
+func (x *Settings) GetXCredentialHelperTimeoutSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XCredentialHelperTimeoutSeconds
+	}
+	return nil
+}
+
 func (x *Settings) GetXCuda() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XCuda
@@ -625,6 +661,13 @@ func (x *Settings) GetXFlowControlDisabled() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetXGraphqlCacheTtls() *MapStringKeyStringValue {
+	if x != nil {
+		return x.XGraphqlCacheTtls
+	}
+	return nil
+}
+
 func (x *Settings) GetXGraphqlRetryMax() *wrapperspb.Int32Value {
 	if x != nil {
 		return x.XGraphqlRetryMax
@@ -653,6 +696,62 @@ func (x *Settings) GetXGraphqlTimeoutSeconds() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *Settings) GetXHistoryRateLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XHistoryRateLimit
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryRateLimitPolicy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryRateLimitPolicy
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryTypePolicy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryTypePolicy
+	}
+	return nil
+}
+
+func (x *Settings) GetXIngestHttpAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XIngestHttpAddress
+	}
+	return nil
+}
+
+func (x *Settings) GetXIngestIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XIngestIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXIngestPrefix() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XIngestPrefix
+	}
+	return nil
+}
+
+func (x *Settings) GetXIngestStatsdAddress() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XIngestStatsdAddress
+	}
+	return nil
+}
+
+func (x *Settings) GetXIngestTarget() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XIngestTarget
+	}
+	return nil
+}
+
 func (x *Settings) GetXInternalCheckProcess() *wrapperspb.DoubleValue {
 	if x != nil {
 		return x.XInternalCheckProcess
@@ -730,6 +829,20 @@ func (x *Settings) GetXLogLevel() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *Settings) GetXMirrorShutdownTimeoutSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XMirrorShutdownTimeoutSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXMirrors() *MapStringKeyMapStringKeyStringValue {
+	if x != nil {
+		return x.XMirrors
+	}
+	return nil
+}
+
 func (x *Settings) GetXNetworkBuffer() *wrapperspb.Int32Value {
 	if x != nil {
 		return x.XNetworkBuffer
@@ -772,6 +885,13 @@ func (x *Settings) GetXOs() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *Settings) GetXPauseSubsystems() *ListStringValue {
+	if x != nil {
+		return x.XPauseSubsystems
+	}
+	return nil
+}
+
 func (x *Settings) GetXPlatform() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XPlatform
@@ -786,6 +906,27 @@ func (x *Settings) GetXPython() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *Settings) GetXRunPullIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XRunPullIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXRunUpsertBurst() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XRunUpsertBurst
+	}
+	return nil
+}
+
+func (x *Settings) GetXRunUpsertIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XRunUpsertIntervalSeconds
+	}
+	return nil
+}
+
 func (x *Settings) GetXRunqueueItemId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XRunqueueItemId
@@ -800,6 +941,13 @@ func (x *Settings) GetXRequireCore() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetXResourcePriceTable() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XResourcePriceTable
+	}
+	return nil
+}
+
 func (x *Settings) GetXSaveRequirements() *wrapperspb.BoolValue {
 	if x != nil {
 		return x.XSaveRequirements
@@ -807,6 +955,13 @@ func (x *Settings) GetXSaveRequirements() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Settings) GetXSaveHistoryAndSummary() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XSaveHistoryAndSummary
+	}
+	return nil
+}
+
 func (x *Settings) GetXServiceTransport() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XServiceTransport
@@ -898,6 +1053,20 @@ func (x *Settings) GetXStatsBufferSize() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *Settings) GetXStopPollingIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStopPollingIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXSymlinkPolicy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XSymlinkPolicy
+	}
+	return nil
+}
+
 func (x *Settings) GetXTmpCodeDir() *wrapperspb.StringValue {
 	if x != nil {
 		return x.XTmpCodeDir
@@ -989,6 +1158,13 @@ func (x *Settings) GetConsole() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *Settings) GetCredentialHelper() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CredentialHelper
+	}
+	return nil
+}
+
 func (x *Settings) GetDeployment() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Deployment
@@ -1615,7 +1791,7 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65,
 	0x79, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
 	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xea, 0x54, 0x0a,
+	0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xaa, 0x63, 0x0a,
 	0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x5f, 0x61, 0x72,
 	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62,
 	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
@@ -1637,183 +1813,252 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x31, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x43, 0x6f, 0x6c,
-	0x61, 0x62, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x63, 0x75, 0x64, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x04, 0x43, 0x75, 0x64, 0x61, 0x12, 0x3e, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x5f,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x70, 0x72, 0x6f, 0x63, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x65, 0x74, 0x70, 0x72, 0x6f, 0x63, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x5f,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42, 0x0a,
-	0x0f, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x9e, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x3c, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x65, 0x78, 0x69,
-	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x61, 0x62, 0x12, 0x54, 0x0a, 0x18, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0xa6,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x69, 0x0a, 0x22, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xaa,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x1e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48,
+	0x65, 0x6c, 0x70, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x63, 0x75, 0x64, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x04, 0x43, 0x75, 0x64, 0x61, 0x12, 0x3e, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x15,
+	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x70, 0x72, 0x6f, 0x63,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x70, 0x72, 0x6f, 0x63, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e,
+	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42,
+	0x0a, 0x0f, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x45, 0x78, 0x69, 0x74, 0x12,
-	0x3d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x56,
-	0x0a, 0x13, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61,
-	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x45, 0x78, 0x74, 0x72, 0x61, 0x48, 0x74, 0x74, 0x70, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69,
-	0x65, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64,
-	0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72,
-	0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x93, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6c, 0x75, 0x65, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x12, 0x4e, 0x0a, 0x15, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x9e, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x65, 0x78,
+	0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x45, 0x78, 0x69, 0x74,
+	0x12, 0x3d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x56, 0x0a, 0x13, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77,
+	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x45, 0x78, 0x74, 0x72, 0x61, 0x48, 0x74, 0x74, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x78,
+	0x69, 0x65, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e,
+	0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x16,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x93, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x68,
+	0x0a, 0x23, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x94, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x46, 0x69, 0x6c, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x68, 0x0a,
-	0x23, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65,
-	0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x94, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x6e,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x68, 0x0a, 0x23, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x95,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x68, 0x0a, 0x23, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x95, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x5b, 0x0a, 0x1c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x54, 0x0a, 0x18, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x96, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x14, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x6c, 0x0a, 0x25, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x97,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x75, 0x65, 0x52, 0x1f, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x6c, 0x0a, 0x25, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x98, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x1f, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
 	0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x12, 0x5b, 0x0a, 0x1c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x73, 0x12, 0x60, 0x0a, 0x1e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x99, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x14, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x46,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x12, 0x4f, 0x0a, 0x16, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x46, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x57, 0x0a, 0x13, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x73, 0x18, 0xb3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x5f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78,
+	0x18, 0x9a, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x54,
-	0x0a, 0x18, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14,
-	0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x74, 0x72,
-	0x79, 0x4d, 0x61, 0x78, 0x12, 0x6c, 0x0a, 0x25, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69,
-	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x97, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x1f, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x6c, 0x0a, 0x25, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x98, 0x01, 0x20, 0x01,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x61, 0x0a, 0x1f, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x9b, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x61, 0x0a, 0x1f, 0x5f, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x9c, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x1f, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x60, 0x0a, 0x1e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x99, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x4b, 0x0a, 0x14, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x46, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12,
-	0x4f, 0x0a, 0x16, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x46, 0x6c, 0x6f,
-	0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x49, 0x0a, 0x12, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x74,
-	0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x9a, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x71, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x61, 0x0a, 0x1f, 0x5f,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x9b,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x1a, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x61,
-	0x0a, 0x1f, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79,
-	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x9c, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x12, 0x55, 0x0a, 0x18, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x9d, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x15, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x17, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a,
-	0x17, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x1a, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x61, 0x69,
+	0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x18, 0x5f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x9d, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x5f, 0x69, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x49, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x5f, 0x6a, 0x75,
-	0x70, 0x79, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72,
-	0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x8f, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x90, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0xa2, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x59, 0x0a, 0x1a, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0xa3, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x16, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4e, 0x0a, 0x14, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0xb1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4e, 0x0a, 0x14, 0x5f, 0x69,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0xad, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x48,
+	0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x18, 0x5f, 0x69,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xaf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x5f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0xae, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x4a, 0x75, 0x70, 0x79, 0x74,
-	0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x40, 0x0a, 0x0d, 0x5f, 0x6a, 0x75, 0x70, 0x79, 0x74,
-	0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x52, 0x0a, 0x16, 0x5f, 0x69, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0xac, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x5f,
+	0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0xb0, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x53, 0x0a, 0x17, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x14, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x53, 0x0a, 0x17, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x5f, 0x69,
+	0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x49, 0x70, 0x79, 0x74, 0x68, 0x6f,
+	0x6e, 0x12, 0x35, 0x0a, 0x08, 0x5f, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x6a, 0x75, 0x70,
+	0x79, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x8f, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
+	0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x5f,
+	0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x90, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x40,
+	0x0a, 0x0d, 0x5f, 0x6a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x4a, 0x75, 0x70, 0x79, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x5f, 0x6b, 0x61, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x4b,
+	0x61, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x17, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x13, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x16, 0x5f, 0x6c, 0x69, 0x76,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f,
+	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x4a, 0x75, 0x70,
-	0x79, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x5f, 0x6b, 0x61, 0x67,
-	0x67, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x4b, 0x61, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x51, 0x0a,
-	0x17, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x4c, 0x69, 0x76,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x4f, 0x0a, 0x16, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x4c,
-	0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x43, 0x0a, 0x0f,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x65, 0x0a, 0x20, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xb7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1c, 0x4d,
+	0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x08, 0x5f,
+	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xab, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0f,
 	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18,
 	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
@@ -1834,145 +2079,192 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x79, 0x6e, 0x63, 0x12, 0x2d, 0x0a, 0x03, 0x5f, 0x6f, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
-	0x4f, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x35, 0x0a,
-	0x07, 0x5f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x50, 0x79,
-	0x74, 0x68, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x11, 0x5f, 0x72, 0x75, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x52,
-	0x75, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x3e, 0x0a,
-	0x0d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x24,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x48, 0x0a,
-	0x12, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x26, 0x20,
+	0x4f, 0x73, 0x12, 0x4c, 0x0a, 0x11, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x75, 0x62,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0xa5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x39, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x21, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x77, 0x61, 0x69, 0x74, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x57, 0x61, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b,
+	0x65, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x5f,
+	0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x50, 0x79, 0x74, 0x68,
+	0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x1a, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0xb2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x52, 0x75, 0x6e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a,
+	0x11, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x62, 0x75, 0x72,
+	0x73, 0x74, 0x18, 0xa7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x52, 0x75, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x1c, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x75,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xa8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x18, 0x52, 0x75, 0x6e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x5f, 0x72, 0x75, 0x6e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
+	0x52, 0x75, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x3e,
+	0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x50,
+	0x0a, 0x15, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0xb4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x48, 0x0a, 0x12, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x19, 0x5f, 0x73,
+	0x61, 0x76, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0xa4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x53, 0x61, 0x76, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x4a, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x50, 0x69, 0x64, 0x12, 0x58, 0x0a, 0x1a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x55,
-	0x0a, 0x19, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
-	0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x65,
-	0x0a, 0x21, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4e, 0x65,
-	0x75, 0x72, 0x6f, 0x6e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x69, 0x0a, 0x1d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f,
-	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77,
-	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x65, 0x6e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x60, 0x0a, 0x1b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x17, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x92, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12,
-	0x49, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0xa1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x31, 0x20, 0x01, 0x28,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x40, 0x0a,
+	0x0d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x61, 0x69, 0x74, 0x12,
+	0x44, 0x0a, 0x0f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x69,
+	0x64, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x69, 0x64, 0x12, 0x58,
+	0x0a, 0x1a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x16, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x19, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x47, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f,
+	0x69, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x21, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5f, 0x6e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x2e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x1c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4e, 0x65, 0x75, 0x72, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x69, 0x0a, 0x1d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x19, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x1b, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x17, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x11,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x92, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0xa1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x61, 0x0a, 0x1e, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xb6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x53, 0x74, 0x6f,
+	0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x5f, 0x73, 0x79, 0x6d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0xb5, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0a, 0x54, 0x6d, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x5f,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x43, 0x0a, 0x0d, 0x5f, 0x75, 0x6e, 0x73, 0x61, 0x76,
-	0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b,
-	0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x5f,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56,
-	0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
-	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
-	0x6d, 0x6f, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x0d, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3f,
+	0x0a, 0x0d, 0x5f, 0x74, 0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x6c, 0x0a, 0x1f, 0x61,
-	0x7a, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c,
-	0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x38,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b,
-	0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1a, 0x61,
-	0x7a, 0x75, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x54, 0x6f,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x3a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x72, 0x12, 0x42, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x3b, 0x20, 0x01, 0x28,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x54, 0x6d, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x72, 0x12,
+	0x39, 0x0a, 0x09, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x54, 0x72, 0x61, 0x63, 0x65, 0x6c, 0x6f, 0x67, 0x12, 0x43, 0x0a, 0x0d, 0x5f, 0x75,
+	0x6e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
 	0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12,
-	0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0xa0, 0x01, 0x20,
+	0x75, 0x65, 0x52, 0x0b, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x35, 0x0a, 0x08, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x34, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x76, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12,
+	0x6c, 0x0a, 0x1f, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x1a, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x72, 0x6c, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x62,
+	0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x72, 0x12,
+	0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x55, 0x72, 0x6c, 0x12,
+	0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x18, 0xa9, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x73,
-	0x6f, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x65, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x65, 0x6c,
+	0x70, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
 	0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
@@ -2321,8 +2613,8 @@ var file_wandb_proto_wandb_settings_proto_goTypes = []interface{}{
 	nil,                                         // 6: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry
 	(*wrapperspb.BoolValue)(nil),                // 7: google.protobuf.BoolValue
 	(*wrapperspb.Int32Value)(nil),               // 8: google.protobuf.Int32Value
-	(*wrapperspb.StringValue)(nil),              // 9: google.protobuf.StringValue
-	(*wrapperspb.DoubleValue)(nil),              // 10: google.protobuf.DoubleValue
+	(*wrapperspb.DoubleValue)(nil),              // 9: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),              // 10: google.protobuf.StringValue
 }
 var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	5,   // 0: wandb_internal.MapStringKeyStringValue.value:type_name -> wandb_internal.MapStringKeyStringValue.ValueEntry
@@ -2334,168 +2626,190 @@ var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	8,   // 6: wandb_internal.Settings._async_upload_concurrency_limit:type_name -> google.protobuf.Int32Value
 	7,   // 7: wandb_internal.Settings._cli_only_mode:type_name -> google.protobuf.BoolValue
 	7,   // 8: wandb_internal.Settings._colab:type_name -> google.protobuf.BoolValue
-	9,   // 9: wandb_internal.Settings._cuda:type_name -> google.protobuf.StringValue
-	7,   // 10: wandb_internal.Settings._disable_meta:type_name -> google.protobuf.BoolValue
-	7,   // 11: wandb_internal.Settings._disable_service:type_name -> google.protobuf.BoolValue
-	7,   // 12: wandb_internal.Settings._disable_setproctitle:type_name -> google.protobuf.BoolValue
-	7,   // 13: wandb_internal.Settings._disable_stats:type_name -> google.protobuf.BoolValue
-	7,   // 14: wandb_internal.Settings._disable_viewer:type_name -> google.protobuf.BoolValue
-	7,   // 15: wandb_internal.Settings._disable_machine_info:type_name -> google.protobuf.BoolValue
-	7,   // 16: wandb_internal.Settings._except_exit:type_name -> google.protobuf.BoolValue
-	9,   // 17: wandb_internal.Settings._executable:type_name -> google.protobuf.StringValue
-	1,   // 18: wandb_internal.Settings._extra_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
-	1,   // 19: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
-	8,   // 20: wandb_internal.Settings._file_stream_retry_max:type_name -> google.protobuf.Int32Value
-	8,   // 21: wandb_internal.Settings._file_stream_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 22: wandb_internal.Settings._file_stream_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 23: wandb_internal.Settings._file_stream_timeout_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 24: wandb_internal.Settings._file_transfer_retry_max:type_name -> google.protobuf.Int32Value
-	8,   // 25: wandb_internal.Settings._file_transfer_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 26: wandb_internal.Settings._file_transfer_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 27: wandb_internal.Settings._file_transfer_timeout_seconds:type_name -> google.protobuf.Int32Value
-	7,   // 28: wandb_internal.Settings._flow_control_custom:type_name -> google.protobuf.BoolValue
-	7,   // 29: wandb_internal.Settings._flow_control_disabled:type_name -> google.protobuf.BoolValue
-	8,   // 30: wandb_internal.Settings._graphql_retry_max:type_name -> google.protobuf.Int32Value
-	8,   // 31: wandb_internal.Settings._graphql_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 32: wandb_internal.Settings._graphql_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
-	8,   // 33: wandb_internal.Settings._graphql_timeout_seconds:type_name -> google.protobuf.Int32Value
-	10,  // 34: wandb_internal.Settings._internal_check_process:type_name -> google.protobuf.DoubleValue
-	10,  // 35: wandb_internal.Settings._internal_queue_timeout:type_name -> google.protobuf.DoubleValue
-	7,   // 36: wandb_internal.Settings._ipython:type_name -> google.protobuf.BoolValue
-	7,   // 37: wandb_internal.Settings._jupyter:type_name -> google.protobuf.BoolValue
-	9,   // 38: wandb_internal.Settings._jupyter_name:type_name -> google.protobuf.StringValue
-	9,   // 39: wandb_internal.Settings._jupyter_path:type_name -> google.protobuf.StringValue
-	9,   // 40: wandb_internal.Settings._jupyter_root:type_name -> google.protobuf.StringValue
-	7,   // 41: wandb_internal.Settings._kaggle:type_name -> google.protobuf.BoolValue
-	8,   // 42: wandb_internal.Settings._live_policy_rate_limit:type_name -> google.protobuf.Int32Value
-	8,   // 43: wandb_internal.Settings._live_policy_wait_time:type_name -> google.protobuf.Int32Value
-	8,   // 44: wandb_internal.Settings._log_level:type_name -> google.protobuf.Int32Value
-	8,   // 45: wandb_internal.Settings._network_buffer:type_name -> google.protobuf.Int32Value
-	7,   // 46: wandb_internal.Settings._noop:type_name -> google.protobuf.BoolValue
-	7,   // 47: wandb_internal.Settings._notebook:type_name -> google.protobuf.BoolValue
-	7,   // 48: wandb_internal.Settings._offline:type_name -> google.protobuf.BoolValue
-	7,   // 49: wandb_internal.Settings._sync:type_name -> google.protobuf.BoolValue
-	9,   // 50: wandb_internal.Settings._os:type_name -> google.protobuf.StringValue
-	9,   // 51: wandb_internal.Settings._platform:type_name -> google.protobuf.StringValue
-	9,   // 52: wandb_internal.Settings._python:type_name -> google.protobuf.StringValue
-	9,   // 53: wandb_internal.Settings._runqueue_item_id:type_name -> google.protobuf.StringValue
-	7,   // 54: wandb_internal.Settings._require_core:type_name -> google.protobuf.BoolValue
-	7,   // 55: wandb_internal.Settings._save_requirements:type_name -> google.protobuf.BoolValue
-	9,   // 56: wandb_internal.Settings._service_transport:type_name -> google.protobuf.StringValue
-	10,  // 57: wandb_internal.Settings._service_wait:type_name -> google.protobuf.DoubleValue
-	9,   // 58: wandb_internal.Settings._start_datetime:type_name -> google.protobuf.StringValue
-	10,  // 59: wandb_internal.Settings._start_time:type_name -> google.protobuf.DoubleValue
-	8,   // 60: wandb_internal.Settings._stats_pid:type_name -> google.protobuf.Int32Value
-	10,  // 61: wandb_internal.Settings._stats_sample_rate_seconds:type_name -> google.protobuf.DoubleValue
-	8,   // 62: wandb_internal.Settings._stats_samples_to_average:type_name -> google.protobuf.Int32Value
-	7,   // 63: wandb_internal.Settings._stats_join_assets:type_name -> google.protobuf.BoolValue
-	9,   // 64: wandb_internal.Settings._stats_neuron_monitor_config_path:type_name -> google.protobuf.StringValue
-	1,   // 65: wandb_internal.Settings._stats_open_metrics_endpoints:type_name -> wandb_internal.MapStringKeyStringValue
-	3,   // 66: wandb_internal.Settings._stats_open_metrics_filters:type_name -> wandb_internal.OpenMetricsFilters
-	0,   // 67: wandb_internal.Settings._stats_disk_paths:type_name -> wandb_internal.ListStringValue
-	8,   // 68: wandb_internal.Settings._stats_buffer_size:type_name -> google.protobuf.Int32Value
-	9,   // 69: wandb_internal.Settings._tmp_code_dir:type_name -> google.protobuf.StringValue
-	9,   // 70: wandb_internal.Settings._tracelog:type_name -> google.protobuf.StringValue
-	0,   // 71: wandb_internal.Settings._unsaved_keys:type_name -> wandb_internal.ListStringValue
-	7,   // 72: wandb_internal.Settings._windows:type_name -> google.protobuf.BoolValue
-	7,   // 73: wandb_internal.Settings.allow_val_change:type_name -> google.protobuf.BoolValue
-	9,   // 74: wandb_internal.Settings.anonymous:type_name -> google.protobuf.StringValue
-	9,   // 75: wandb_internal.Settings.api_key:type_name -> google.protobuf.StringValue
-	1,   // 76: wandb_internal.Settings.azure_account_url_to_access_key:type_name -> wandb_internal.MapStringKeyStringValue
-	9,   // 77: wandb_internal.Settings.base_url:type_name -> google.protobuf.StringValue
-	9,   // 78: wandb_internal.Settings.code_dir:type_name -> google.protobuf.StringValue
-	0,   // 79: wandb_internal.Settings.config_paths:type_name -> wandb_internal.ListStringValue
-	9,   // 80: wandb_internal.Settings.colab_url:type_name -> google.protobuf.StringValue
-	9,   // 81: wandb_internal.Settings.console:type_name -> google.protobuf.StringValue
-	9,   // 82: wandb_internal.Settings.deployment:type_name -> google.protobuf.StringValue
-	7,   // 83: wandb_internal.Settings.disable_code:type_name -> google.protobuf.BoolValue
-	7,   // 84: wandb_internal.Settings.disable_git:type_name -> google.protobuf.BoolValue
-	7,   // 85: wandb_internal.Settings.disable_hints:type_name -> google.protobuf.BoolValue
-	7,   // 86: wandb_internal.Settings.disable_job_creation:type_name -> google.protobuf.BoolValue
-	7,   // 87: wandb_internal.Settings.disabled:type_name -> google.protobuf.BoolValue
-	9,   // 88: wandb_internal.Settings.docker:type_name -> google.protobuf.StringValue
-	9,   // 89: wandb_internal.Settings.email:type_name -> google.protobuf.StringValue
-	9,   // 90: wandb_internal.Settings.entity:type_name -> google.protobuf.StringValue
-	9,   // 91: wandb_internal.Settings.files_dir:type_name -> google.protobuf.StringValue
-	7,   // 92: wandb_internal.Settings.force:type_name -> google.protobuf.BoolValue
-	9,   // 93: wandb_internal.Settings.git_commit:type_name -> google.protobuf.StringValue
-	9,   // 94: wandb_internal.Settings.git_remote:type_name -> google.protobuf.StringValue
-	9,   // 95: wandb_internal.Settings.git_remote_url:type_name -> google.protobuf.StringValue
-	9,   // 96: wandb_internal.Settings.git_root:type_name -> google.protobuf.StringValue
-	8,   // 97: wandb_internal.Settings.heartbeat_seconds:type_name -> google.protobuf.Int32Value
-	9,   // 98: wandb_internal.Settings.host:type_name -> google.protobuf.StringValue
-	0,   // 99: wandb_internal.Settings.ignore_globs:type_name -> wandb_internal.ListStringValue
-	10,  // 100: wandb_internal.Settings.init_timeout:type_name -> google.protobuf.DoubleValue
-	7,   // 101: wandb_internal.Settings.is_local:type_name -> google.protobuf.BoolValue
-	9,   // 102: wandb_internal.Settings.job_name:type_name -> google.protobuf.StringValue
-	9,   // 103: wandb_internal.Settings.job_source:type_name -> google.protobuf.StringValue
-	7,   // 104: wandb_internal.Settings.label_disable:type_name -> google.protobuf.BoolValue
-	7,   // 105: wandb_internal.Settings.launch:type_name -> google.protobuf.BoolValue
-	9,   // 106: wandb_internal.Settings.launch_config_path:type_name -> google.protobuf.StringValue
-	9,   // 107: wandb_internal.Settings.log_dir:type_name -> google.protobuf.StringValue
-	9,   // 108: wandb_internal.Settings.log_internal:type_name -> google.protobuf.StringValue
-	9,   // 109: wandb_internal.Settings.log_symlink_internal:type_name -> google.protobuf.StringValue
-	9,   // 110: wandb_internal.Settings.log_symlink_user:type_name -> google.protobuf.StringValue
-	9,   // 111: wandb_internal.Settings.log_user:type_name -> google.protobuf.StringValue
-	10,  // 112: wandb_internal.Settings.login_timeout:type_name -> google.protobuf.DoubleValue
-	9,   // 113: wandb_internal.Settings.mode:type_name -> google.protobuf.StringValue
-	9,   // 114: wandb_internal.Settings.notebook_name:type_name -> google.protobuf.StringValue
-	9,   // 115: wandb_internal.Settings.problem:type_name -> google.protobuf.StringValue
-	9,   // 116: wandb_internal.Settings.program:type_name -> google.protobuf.StringValue
-	9,   // 117: wandb_internal.Settings.program_abspath:type_name -> google.protobuf.StringValue
-	9,   // 118: wandb_internal.Settings.program_relpath:type_name -> google.protobuf.StringValue
-	9,   // 119: wandb_internal.Settings.project:type_name -> google.protobuf.StringValue
-	9,   // 120: wandb_internal.Settings.project_url:type_name -> google.protobuf.StringValue
-	7,   // 121: wandb_internal.Settings.quiet:type_name -> google.protobuf.BoolValue
-	7,   // 122: wandb_internal.Settings.reinit:type_name -> google.protobuf.BoolValue
-	7,   // 123: wandb_internal.Settings.relogin:type_name -> google.protobuf.BoolValue
-	9,   // 124: wandb_internal.Settings.resume:type_name -> google.protobuf.StringValue
-	9,   // 125: wandb_internal.Settings.resume_fname:type_name -> google.protobuf.StringValue
-	7,   // 126: wandb_internal.Settings.resumed:type_name -> google.protobuf.BoolValue
-	9,   // 127: wandb_internal.Settings.root_dir:type_name -> google.protobuf.StringValue
-	9,   // 128: wandb_internal.Settings.run_group:type_name -> google.protobuf.StringValue
-	9,   // 129: wandb_internal.Settings.run_id:type_name -> google.protobuf.StringValue
-	9,   // 130: wandb_internal.Settings.run_job_type:type_name -> google.protobuf.StringValue
-	9,   // 131: wandb_internal.Settings.run_mode:type_name -> google.protobuf.StringValue
-	9,   // 132: wandb_internal.Settings.run_name:type_name -> google.protobuf.StringValue
-	9,   // 133: wandb_internal.Settings.run_notes:type_name -> google.protobuf.StringValue
-	0,   // 134: wandb_internal.Settings.run_tags:type_name -> wandb_internal.ListStringValue
-	9,   // 135: wandb_internal.Settings.run_url:type_name -> google.protobuf.StringValue
-	7,   // 136: wandb_internal.Settings.sagemaker_disable:type_name -> google.protobuf.BoolValue
-	7,   // 137: wandb_internal.Settings.save_code:type_name -> google.protobuf.BoolValue
-	9,   // 138: wandb_internal.Settings.settings_system:type_name -> google.protobuf.StringValue
-	9,   // 139: wandb_internal.Settings.settings_workspace:type_name -> google.protobuf.StringValue
-	7,   // 140: wandb_internal.Settings.show_colors:type_name -> google.protobuf.BoolValue
-	7,   // 141: wandb_internal.Settings.show_emoji:type_name -> google.protobuf.BoolValue
-	7,   // 142: wandb_internal.Settings.show_errors:type_name -> google.protobuf.BoolValue
-	7,   // 143: wandb_internal.Settings.show_info:type_name -> google.protobuf.BoolValue
-	7,   // 144: wandb_internal.Settings.show_warnings:type_name -> google.protobuf.BoolValue
-	7,   // 145: wandb_internal.Settings.silent:type_name -> google.protobuf.BoolValue
-	9,   // 146: wandb_internal.Settings.start_method:type_name -> google.protobuf.StringValue
-	7,   // 147: wandb_internal.Settings.strict:type_name -> google.protobuf.BoolValue
-	8,   // 148: wandb_internal.Settings.summary_errors:type_name -> google.protobuf.Int32Value
-	8,   // 149: wandb_internal.Settings.summary_timeout:type_name -> google.protobuf.Int32Value
-	8,   // 150: wandb_internal.Settings.summary_warnings:type_name -> google.protobuf.Int32Value
-	9,   // 151: wandb_internal.Settings.sweep_id:type_name -> google.protobuf.StringValue
-	9,   // 152: wandb_internal.Settings.sweep_param_path:type_name -> google.protobuf.StringValue
-	9,   // 153: wandb_internal.Settings.sweep_url:type_name -> google.protobuf.StringValue
-	7,   // 154: wandb_internal.Settings.symlink:type_name -> google.protobuf.BoolValue
-	9,   // 155: wandb_internal.Settings.sync_dir:type_name -> google.protobuf.StringValue
-	9,   // 156: wandb_internal.Settings.sync_file:type_name -> google.protobuf.StringValue
-	9,   // 157: wandb_internal.Settings.sync_symlink_latest:type_name -> google.protobuf.StringValue
-	8,   // 158: wandb_internal.Settings.system_sample:type_name -> google.protobuf.Int32Value
-	8,   // 159: wandb_internal.Settings.system_sample_seconds:type_name -> google.protobuf.Int32Value
-	7,   // 160: wandb_internal.Settings.table_raise_on_max_row_limit_exceeded:type_name -> google.protobuf.BoolValue
-	9,   // 161: wandb_internal.Settings.timespec:type_name -> google.protobuf.StringValue
-	9,   // 162: wandb_internal.Settings.tmp_dir:type_name -> google.protobuf.StringValue
-	9,   // 163: wandb_internal.Settings.username:type_name -> google.protobuf.StringValue
-	9,   // 164: wandb_internal.Settings.wandb_dir:type_name -> google.protobuf.StringValue
-	1,   // 165: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
-	166, // [166:166] is the sub-list for method output_type
-	166, // [166:166] is the sub-list for method input_type
-	166, // [166:166] is the sub-list for extension type_name
-	166, // [166:166] is the sub-list for extension extendee
-	0,   // [0:166] is the sub-list for field type_name
+	7,   // 9: wandb_internal.Settings._config_timeline_history:type_name -> google.protobuf.BoolValue
+	9,   // 10: wandb_internal.Settings._credential_helper_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 11: wandb_internal.Settings._cuda:type_name -> google.protobuf.StringValue
+	7,   // 12: wandb_internal.Settings._disable_meta:type_name -> google.protobuf.BoolValue
+	7,   // 13: wandb_internal.Settings._disable_service:type_name -> google.protobuf.BoolValue
+	7,   // 14: wandb_internal.Settings._disable_setproctitle:type_name -> google.protobuf.BoolValue
+	7,   // 15: wandb_internal.Settings._disable_stats:type_name -> google.protobuf.BoolValue
+	7,   // 16: wandb_internal.Settings._disable_viewer:type_name -> google.protobuf.BoolValue
+	7,   // 17: wandb_internal.Settings._disable_machine_info:type_name -> google.protobuf.BoolValue
+	7,   // 18: wandb_internal.Settings._except_exit:type_name -> google.protobuf.BoolValue
+	10,  // 19: wandb_internal.Settings._executable:type_name -> google.protobuf.StringValue
+	1,   // 20: wandb_internal.Settings._extra_http_headers:type_name -> wandb_internal.MapStringKeyStringValue
+	1,   // 21: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
+	8,   // 22: wandb_internal.Settings._file_stream_retry_max:type_name -> google.protobuf.Int32Value
+	8,   // 23: wandb_internal.Settings._file_stream_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 24: wandb_internal.Settings._file_stream_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 25: wandb_internal.Settings._file_stream_timeout_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 26: wandb_internal.Settings._file_transfer_retry_max:type_name -> google.protobuf.Int32Value
+	8,   // 27: wandb_internal.Settings._file_transfer_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 28: wandb_internal.Settings._file_transfer_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 29: wandb_internal.Settings._file_transfer_timeout_seconds:type_name -> google.protobuf.Int32Value
+	7,   // 30: wandb_internal.Settings._flow_control_custom:type_name -> google.protobuf.BoolValue
+	7,   // 31: wandb_internal.Settings._flow_control_disabled:type_name -> google.protobuf.BoolValue
+	1,   // 32: wandb_internal.Settings._graphql_cache_ttls:type_name -> wandb_internal.MapStringKeyStringValue
+	8,   // 33: wandb_internal.Settings._graphql_retry_max:type_name -> google.protobuf.Int32Value
+	8,   // 34: wandb_internal.Settings._graphql_retry_wait_min_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 35: wandb_internal.Settings._graphql_retry_wait_max_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 36: wandb_internal.Settings._graphql_timeout_seconds:type_name -> google.protobuf.Int32Value
+	8,   // 37: wandb_internal.Settings._history_rate_limit:type_name -> google.protobuf.Int32Value
+	10,  // 38: wandb_internal.Settings._history_rate_limit_policy:type_name -> google.protobuf.StringValue
+	10,  // 39: wandb_internal.Settings._history_type_policy:type_name -> google.protobuf.StringValue
+	10,  // 40: wandb_internal.Settings._ingest_http_address:type_name -> google.protobuf.StringValue
+	9,   // 41: wandb_internal.Settings._ingest_interval_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 42: wandb_internal.Settings._ingest_prefix:type_name -> google.protobuf.StringValue
+	10,  // 43: wandb_internal.Settings._ingest_statsd_address:type_name -> google.protobuf.StringValue
+	10,  // 44: wandb_internal.Settings._ingest_target:type_name -> google.protobuf.StringValue
+	9,   // 45: wandb_internal.Settings._internal_check_process:type_name -> google.protobuf.DoubleValue
+	9,   // 46: wandb_internal.Settings._internal_queue_timeout:type_name -> google.protobuf.DoubleValue
+	7,   // 47: wandb_internal.Settings._ipython:type_name -> google.protobuf.BoolValue
+	7,   // 48: wandb_internal.Settings._jupyter:type_name -> google.protobuf.BoolValue
+	10,  // 49: wandb_internal.Settings._jupyter_name:type_name -> google.protobuf.StringValue
+	10,  // 50: wandb_internal.Settings._jupyter_path:type_name -> google.protobuf.StringValue
+	10,  // 51: wandb_internal.Settings._jupyter_root:type_name -> google.protobuf.StringValue
+	7,   // 52: wandb_internal.Settings._kaggle:type_name -> google.protobuf.BoolValue
+	8,   // 53: wandb_internal.Settings._live_policy_rate_limit:type_name -> google.protobuf.Int32Value
+	8,   // 54: wandb_internal.Settings._live_policy_wait_time:type_name -> google.protobuf.Int32Value
+	8,   // 55: wandb_internal.Settings._log_level:type_name -> google.protobuf.Int32Value
+	9,   // 56: wandb_internal.Settings._mirror_shutdown_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	2,   // 57: wandb_internal.Settings._mirrors:type_name -> wandb_internal.MapStringKeyMapStringKeyStringValue
+	8,   // 58: wandb_internal.Settings._network_buffer:type_name -> google.protobuf.Int32Value
+	7,   // 59: wandb_internal.Settings._noop:type_name -> google.protobuf.BoolValue
+	7,   // 60: wandb_internal.Settings._notebook:type_name -> google.protobuf.BoolValue
+	7,   // 61: wandb_internal.Settings._offline:type_name -> google.protobuf.BoolValue
+	7,   // 62: wandb_internal.Settings._sync:type_name -> google.protobuf.BoolValue
+	10,  // 63: wandb_internal.Settings._os:type_name -> google.protobuf.StringValue
+	0,   // 64: wandb_internal.Settings._pause_subsystems:type_name -> wandb_internal.ListStringValue
+	10,  // 65: wandb_internal.Settings._platform:type_name -> google.protobuf.StringValue
+	10,  // 66: wandb_internal.Settings._python:type_name -> google.protobuf.StringValue
+	9,   // 67: wandb_internal.Settings._run_pull_interval_seconds:type_name -> google.protobuf.DoubleValue
+	8,   // 68: wandb_internal.Settings._run_upsert_burst:type_name -> google.protobuf.Int32Value
+	9,   // 69: wandb_internal.Settings._run_upsert_interval_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 70: wandb_internal.Settings._runqueue_item_id:type_name -> google.protobuf.StringValue
+	7,   // 71: wandb_internal.Settings._require_core:type_name -> google.protobuf.BoolValue
+	10,  // 72: wandb_internal.Settings._resource_price_table:type_name -> google.protobuf.StringValue
+	7,   // 73: wandb_internal.Settings._save_requirements:type_name -> google.protobuf.BoolValue
+	7,   // 74: wandb_internal.Settings._save_history_and_summary:type_name -> google.protobuf.BoolValue
+	10,  // 75: wandb_internal.Settings._service_transport:type_name -> google.protobuf.StringValue
+	9,   // 76: wandb_internal.Settings._service_wait:type_name -> google.protobuf.DoubleValue
+	10,  // 77: wandb_internal.Settings._start_datetime:type_name -> google.protobuf.StringValue
+	9,   // 78: wandb_internal.Settings._start_time:type_name -> google.protobuf.DoubleValue
+	8,   // 79: wandb_internal.Settings._stats_pid:type_name -> google.protobuf.Int32Value
+	9,   // 80: wandb_internal.Settings._stats_sample_rate_seconds:type_name -> google.protobuf.DoubleValue
+	8,   // 81: wandb_internal.Settings._stats_samples_to_average:type_name -> google.protobuf.Int32Value
+	7,   // 82: wandb_internal.Settings._stats_join_assets:type_name -> google.protobuf.BoolValue
+	10,  // 83: wandb_internal.Settings._stats_neuron_monitor_config_path:type_name -> google.protobuf.StringValue
+	1,   // 84: wandb_internal.Settings._stats_open_metrics_endpoints:type_name -> wandb_internal.MapStringKeyStringValue
+	3,   // 85: wandb_internal.Settings._stats_open_metrics_filters:type_name -> wandb_internal.OpenMetricsFilters
+	0,   // 86: wandb_internal.Settings._stats_disk_paths:type_name -> wandb_internal.ListStringValue
+	8,   // 87: wandb_internal.Settings._stats_buffer_size:type_name -> google.protobuf.Int32Value
+	9,   // 88: wandb_internal.Settings._stop_polling_interval_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 89: wandb_internal.Settings._symlink_policy:type_name -> google.protobuf.StringValue
+	10,  // 90: wandb_internal.Settings._tmp_code_dir:type_name -> google.protobuf.StringValue
+	10,  // 91: wandb_internal.Settings._tracelog:type_name -> google.protobuf.StringValue
+	0,   // 92: wandb_internal.Settings._unsaved_keys:type_name -> wandb_internal.ListStringValue
+	7,   // 93: wandb_internal.Settings._windows:type_name -> google.protobuf.BoolValue
+	7,   // 94: wandb_internal.Settings.allow_val_change:type_name -> google.protobuf.BoolValue
+	10,  // 95: wandb_internal.Settings.anonymous:type_name -> google.protobuf.StringValue
+	10,  // 96: wandb_internal.Settings.api_key:type_name -> google.protobuf.StringValue
+	1,   // 97: wandb_internal.Settings.azure_account_url_to_access_key:type_name -> wandb_internal.MapStringKeyStringValue
+	10,  // 98: wandb_internal.Settings.base_url:type_name -> google.protobuf.StringValue
+	10,  // 99: wandb_internal.Settings.code_dir:type_name -> google.protobuf.StringValue
+	0,   // 100: wandb_internal.Settings.config_paths:type_name -> wandb_internal.ListStringValue
+	10,  // 101: wandb_internal.Settings.colab_url:type_name -> google.protobuf.StringValue
+	10,  // 102: wandb_internal.Settings.console:type_name -> google.protobuf.StringValue
+	10,  // 103: wandb_internal.Settings.credential_helper:type_name -> google.protobuf.StringValue
+	10,  // 104: wandb_internal.Settings.deployment:type_name -> google.protobuf.StringValue
+	7,   // 105: wandb_internal.Settings.disable_code:type_name -> google.protobuf.BoolValue
+	7,   // 106: wandb_internal.Settings.disable_git:type_name -> google.protobuf.BoolValue
+	7,   // 107: wandb_internal.Settings.disable_hints:type_name -> google.protobuf.BoolValue
+	7,   // 108: wandb_internal.Settings.disable_job_creation:type_name -> google.protobuf.BoolValue
+	7,   // 109: wandb_internal.Settings.disabled:type_name -> google.protobuf.BoolValue
+	10,  // 110: wandb_internal.Settings.docker:type_name -> google.protobuf.StringValue
+	10,  // 111: wandb_internal.Settings.email:type_name -> google.protobuf.StringValue
+	10,  // 112: wandb_internal.Settings.entity:type_name -> google.protobuf.StringValue
+	10,  // 113: wandb_internal.Settings.files_dir:type_name -> google.protobuf.StringValue
+	7,   // 114: wandb_internal.Settings.force:type_name -> google.protobuf.BoolValue
+	10,  // 115: wandb_internal.Settings.git_commit:type_name -> google.protobuf.StringValue
+	10,  // 116: wandb_internal.Settings.git_remote:type_name -> google.protobuf.StringValue
+	10,  // 117: wandb_internal.Settings.git_remote_url:type_name -> google.protobuf.StringValue
+	10,  // 118: wandb_internal.Settings.git_root:type_name -> google.protobuf.StringValue
+	8,   // 119: wandb_internal.Settings.heartbeat_seconds:type_name -> google.protobuf.Int32Value
+	10,  // 120: wandb_internal.Settings.host:type_name -> google.protobuf.StringValue
+	0,   // 121: wandb_internal.Settings.ignore_globs:type_name -> wandb_internal.ListStringValue
+	9,   // 122: wandb_internal.Settings.init_timeout:type_name -> google.protobuf.DoubleValue
+	7,   // 123: wandb_internal.Settings.is_local:type_name -> google.protobuf.BoolValue
+	10,  // 124: wandb_internal.Settings.job_name:type_name -> google.protobuf.StringValue
+	10,  // 125: wandb_internal.Settings.job_source:type_name -> google.protobuf.StringValue
+	7,   // 126: wandb_internal.Settings.label_disable:type_name -> google.protobuf.BoolValue
+	7,   // 127: wandb_internal.Settings.launch:type_name -> google.protobuf.BoolValue
+	10,  // 128: wandb_internal.Settings.launch_config_path:type_name -> google.protobuf.StringValue
+	10,  // 129: wandb_internal.Settings.log_dir:type_name -> google.protobuf.StringValue
+	10,  // 130: wandb_internal.Settings.log_internal:type_name -> google.protobuf.StringValue
+	10,  // 131: wandb_internal.Settings.log_symlink_internal:type_name -> google.protobuf.StringValue
+	10,  // 132: wandb_internal.Settings.log_symlink_user:type_name -> google.protobuf.StringValue
+	10,  // 133: wandb_internal.Settings.log_user:type_name -> google.protobuf.StringValue
+	9,   // 134: wandb_internal.Settings.login_timeout:type_name -> google.protobuf.DoubleValue
+	10,  // 135: wandb_internal.Settings.mode:type_name -> google.protobuf.StringValue
+	10,  // 136: wandb_internal.Settings.notebook_name:type_name -> google.protobuf.StringValue
+	10,  // 137: wandb_internal.Settings.problem:type_name -> google.protobuf.StringValue
+	10,  // 138: wandb_internal.Settings.program:type_name -> google.protobuf.StringValue
+	10,  // 139: wandb_internal.Settings.program_abspath:type_name -> google.protobuf.StringValue
+	10,  // 140: wandb_internal.Settings.program_relpath:type_name -> google.protobuf.StringValue
+	10,  // 141: wandb_internal.Settings.project:type_name -> google.protobuf.StringValue
+	10,  // 142: wandb_internal.Settings.project_url:type_name -> google.protobuf.StringValue
+	7,   // 143: wandb_internal.Settings.quiet:type_name -> google.protobuf.BoolValue
+	7,   // 144: wandb_internal.Settings.reinit:type_name -> google.protobuf.BoolValue
+	7,   // 145: wandb_internal.Settings.relogin:type_name -> google.protobuf.BoolValue
+	10,  // 146: wandb_internal.Settings.resume:type_name -> google.protobuf.StringValue
+	10,  // 147: wandb_internal.Settings.resume_fname:type_name -> google.protobuf.StringValue
+	7,   // 148: wandb_internal.Settings.resumed:type_name -> google.protobuf.BoolValue
+	10,  // 149: wandb_internal.Settings.root_dir:type_name -> google.protobuf.StringValue
+	10,  // 150: wandb_internal.Settings.run_group:type_name -> google.protobuf.StringValue
+	10,  // 151: wandb_internal.Settings.run_id:type_name -> google.protobuf.StringValue
+	10,  // 152: wandb_internal.Settings.run_job_type:type_name -> google.protobuf.StringValue
+	10,  // 153: wandb_internal.Settings.run_mode:type_name -> google.protobuf.StringValue
+	10,  // 154: wandb_internal.Settings.run_name:type_name -> google.protobuf.StringValue
+	10,  // 155: wandb_internal.Settings.run_notes:type_name -> google.protobuf.StringValue
+	0,   // 156: wandb_internal.Settings.run_tags:type_name -> wandb_internal.ListStringValue
+	10,  // 157: wandb_internal.Settings.run_url:type_name -> google.protobuf.StringValue
+	7,   // 158: wandb_internal.Settings.sagemaker_disable:type_name -> google.protobuf.BoolValue
+	7,   // 159: wandb_internal.Settings.save_code:type_name -> google.protobuf.BoolValue
+	10,  // 160: wandb_internal.Settings.settings_system:type_name -> google.protobuf.StringValue
+	10,  // 161: wandb_internal.Settings.settings_workspace:type_name -> google.protobuf.StringValue
+	7,   // 162: wandb_internal.Settings.show_colors:type_name -> google.protobuf.BoolValue
+	7,   // 163: wandb_internal.Settings.show_emoji:type_name -> google.protobuf.BoolValue
+	7,   // 164: wandb_internal.Settings.show_errors:type_name -> google.protobuf.BoolValue
+	7,   // 165: wandb_internal.Settings.show_info:type_name -> google.protobuf.BoolValue
+	7,   // 166: wandb_internal.Settings.show_warnings:type_name -> google.protobuf.BoolValue
+	7,   // 167: wandb_internal.Settings.silent:type_name -> google.protobuf.BoolValue
+	10,  // 168: wandb_internal.Settings.start_method:type_name -> google.protobuf.StringValue
+	7,   // 169: wandb_internal.Settings.strict:type_name -> google.protobuf.BoolValue
+	8,   // 170: wandb_internal.Settings.summary_errors:type_name -> google.protobuf.Int32Value
+	8,   // 171: wandb_internal.Settings.summary_timeout:type_name -> google.protobuf.Int32Value
+	8,   // 172: wandb_internal.Settings.summary_warnings:type_name -> google.protobuf.Int32Value
+	10,  // 173: wandb_internal.Settings.sweep_id:type_name -> google.protobuf.StringValue
+	10,  // 174: wandb_internal.Settings.sweep_param_path:type_name -> google.protobuf.StringValue
+	10,  // 175: wandb_internal.Settings.sweep_url:type_name -> google.protobuf.StringValue
+	7,   // 176: wandb_internal.Settings.symlink:type_name -> google.protobuf.BoolValue
+	10,  // 177: wandb_internal.Settings.sync_dir:type_name -> google.protobuf.StringValue
+	10,  // 178: wandb_internal.Settings.sync_file:type_name -> google.protobuf.StringValue
+	10,  // 179: wandb_internal.Settings.sync_symlink_latest:type_name -> google.protobuf.StringValue
+	8,   // 180: wandb_internal.Settings.system_sample:type_name -> google.protobuf.Int32Value
+	8,   // 181: wandb_internal.Settings.system_sample_seconds:type_name -> google.protobuf.Int32Value
+	7,   // 182: wandb_internal.Settings.table_raise_on_max_row_limit_exceeded:type_name -> google.protobuf.BoolValue
+	10,  // 183: wandb_internal.Settings.timespec:type_name -> google.protobuf.StringValue
+	10,  // 184: wandb_internal.Settings.tmp_dir:type_name -> google.protobuf.StringValue
+	10,  // 185: wandb_internal.Settings.username:type_name -> google.protobuf.StringValue
+	10,  // 186: wandb_internal.Settings.wandb_dir:type_name -> google.protobuf.StringValue
+	1,   // 187: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
+	188, // [188:188] is the sub-list for method output_type
+	188, // [188:188] is the sub-list for method input_type
+	188, // [188:188] is the sub-list for extension type_name
+	188, // [188:188] is the sub-list for extension extendee
+	0,   // [0:188] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_settings_proto_init() }
@@ -6,7 +6,7 @@ from wandb import wandb_sdk
 
 
 def get_callback(d):
-    def callback_func(key=None, val=None, data=None):
+    def callback_func(key=None, val=None, data=None, allow_val_change=False):
         print("CONFIG", key, val, data)
         if data:
             d.update(data)
@@ -85,6 +85,19 @@ def test_run_publish_config(mock_run, parse_records, record_q):
     assert len(config) == 2
     assert config[0]["t"] == "1"
     assert config[1]["t2"] == "2"
+    # only changes allowed by wandb_config are marked as such
+    assert not any(c.allow_val_change for c in parsed["config"])
+
+
+def test_run_publish_config_allow_val_change(mock_run, parse_records, record_q):
+    run = mock_run()
+    run.config.update(dict(t=1))
+    run.config.update(dict(t=2), allow_val_change=True)
+
+    parsed = parse_records(record_q)
+
+    assert len(parsed.config) == 2
+    assert [c.allow_val_change for c in parsed["config"]] == [False, True]
 
 
 def test_run_publish_history(mock_run, parse_records, record_q):
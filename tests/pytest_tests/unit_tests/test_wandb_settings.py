@@ -701,9 +701,11 @@ def test_preprocess_base_url(url, processed_url):
 @pytest.mark.parametrize(
     "setting",
     [
+        "_config_timeline_history",
         "_disable_meta",
         "_disable_stats",
         "_disable_viewer",
+        "_save_history_and_summary",
         "disable_code",
         "disable_git",
         "disabled",
@@ -734,6 +736,7 @@ def test_preprocess_bool_settings(setting: str):
 @pytest.mark.parametrize(
     "setting, value",
     [
+        ("_graphql_cache_ttls", '{"Viewer": "30s"}'),
         ("_stats_open_metrics_endpoints", '{"DCGM":"http://localhvost"}'),
         (
             "_stats_open_metrics_filters",
@@ -773,6 +776,10 @@ def test_redact():
     redacted = wandb_settings._redact_dict({"api_key": "secret"})
     assert redacted == {"api_key": "***REDACTED***"}
 
+    # mirrors hold api keys
+    redacted = wandb_settings._redact_dict({"_mirrors": {"m": {"api_key": "secret"}}})
+    assert redacted == {"_mirrors": "***REDACTED***"}
+
 
 def test_strict():
     settings = Settings(strict=True)
@@ -792,6 +799,171 @@ def test_validate_console_problem_anonymous():
         s.update(anonymous="lol")
 
 
+def test_history_rate_limit():
+    with mock.patch.dict(
+        os.environ,
+        {
+            "WANDB__HISTORY_RATE_LIMIT": "10",
+            "WANDB__HISTORY_RATE_LIMIT_POLICY": "mean",
+        },
+    ):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._history_rate_limit == 10
+    assert s._history_rate_limit_policy == "mean"
+
+    proto = s.to_proto()
+    assert proto._history_rate_limit.value == 10
+    assert proto._history_rate_limit_policy.value == "mean"
+
+    with pytest.raises(UsageError):
+        s.update(_history_rate_limit_policy="lol")
+
+
+def test_history_type_policy():
+    s = Settings()
+    assert s._history_type_policy == "warn"
+    s.update(_history_type_policy="coerce")
+    assert s.to_proto()._history_type_policy.value == "coerce"
+
+    with pytest.raises(UsageError):
+        s.update(_history_type_policy="lol")
+
+
+def test_symlink_policy():
+    s = Settings()
+    assert s._symlink_policy == "follow"
+    s.update(_symlink_policy="copy")
+    assert s.to_proto()._symlink_policy.value == "copy"
+
+    with pytest.raises(UsageError):
+        s.update(_symlink_policy="lol")
+
+
+def test_pause_subsystems():
+    with mock.patch.dict(os.environ, {"WANDB__PAUSE_SUBSYSTEMS": "filestream,uploads"}):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._pause_subsystems == ("filestream", "uploads")
+    assert list(s.to_proto()._pause_subsystems.value) == ["filestream", "uploads"]
+
+    with pytest.raises(UsageError):
+        s.update(_pause_subsystems=("filestream", "lol"))
+
+
+def test_run_upsert_rate():
+    with mock.patch.dict(
+        os.environ,
+        {
+            "WANDB__RUN_UPSERT_BURST": "3",
+            "WANDB__RUN_UPSERT_INTERVAL_SECONDS": "2",
+        },
+    ):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._run_upsert_burst == 3
+    assert s._run_upsert_interval_seconds == 2.0
+
+    proto = s.to_proto()
+    assert proto._run_upsert_burst.value == 3
+    assert proto._run_upsert_interval_seconds.value == 2.0
+
+
+def test_run_pull_interval():
+    with mock.patch.dict(os.environ, {"WANDB__RUN_PULL_INTERVAL_SECONDS": "0"}):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._run_pull_interval_seconds == 0.0
+
+    # zero turns periodic pulls off, so it must reach the service
+    proto = s.to_proto()
+    assert proto.HasField("_run_pull_interval_seconds")
+    assert proto._run_pull_interval_seconds.value == 0.0
+
+
+def test_stop_polling_interval():
+    assert Settings()._stop_polling_interval_seconds == 15.0
+    with mock.patch.dict(os.environ, {"WANDB__STOP_POLLING_INTERVAL_SECONDS": "60"}):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._stop_polling_interval_seconds == 60.0
+    assert s.to_proto()._stop_polling_interval_seconds.value == 60.0
+
+
+def test_resource_price_table():
+    assert Settings()._resource_price_table is None
+    s = Settings(_resource_price_table="~/prices.json")
+    assert s._resource_price_table == os.path.expanduser("~/prices.json")
+
+
+def test_credential_helper():
+    with mock.patch.dict(
+        os.environ,
+        {
+            "WANDB_CREDENTIAL_HELPER": "pass show wandb",
+            "WANDB__CREDENTIAL_HELPER_TIMEOUT_SECONDS": "5",
+        },
+    ):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s.credential_helper == "pass show wandb"
+    assert s._credential_helper_timeout_seconds == 5.0
+
+    proto = s.to_proto()
+    assert proto.credential_helper.value == "pass show wandb"
+    assert proto._credential_helper_timeout_seconds.value == 5.0
+
+
+def test_mirrors():
+    mirrors = {"backup": {"base_url": "https://wandb.example.com", "api_key": "key"}}
+    with mock.patch.dict(os.environ, {"WANDB__MIRRORS": json.dumps(mirrors)}):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._mirrors == mirrors
+
+    proto = s.to_proto()
+    assert dict(proto._mirrors.value["backup"].value) == mirrors["backup"]
+
+    with pytest.raises(UsageError):
+        s.update(_mirrors={"backup": {"api_key": "key"}})
+    with pytest.raises(UsageError):
+        s.update(_mirrors={"backup": {"base_url": "https://x", "lol": "x"}})
+
+
+def test_mirror_shutdown_timeout():
+    assert Settings()._mirror_shutdown_timeout_seconds == 60.0
+    with mock.patch.dict(os.environ, {"WANDB__MIRROR_SHUTDOWN_TIMEOUT_SECONDS": "5"}):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._mirror_shutdown_timeout_seconds == 5.0
+    assert s.to_proto()._mirror_shutdown_timeout_seconds.value == 5.0
+
+
+def test_ingest():
+    with mock.patch.dict(
+        os.environ,
+        {
+            "WANDB__INGEST_STATSD_ADDRESS": "127.0.0.1:8125",
+            "WANDB__INGEST_INTERVAL_SECONDS": "5",
+            "WANDB__INGEST_PREFIX": "",
+            "WANDB__INGEST_TARGET": "system",
+        },
+    ):
+        s = Settings()
+        s._apply_env_vars(environ=os.environ)
+    assert s._ingest_statsd_address == "127.0.0.1:8125"
+    assert s._ingest_interval_seconds == 5.0
+    assert s._ingest_target == "system"
+
+    proto = s.to_proto()
+    assert proto.HasField("_ingest_prefix")
+    assert proto._ingest_prefix.value == ""
+    assert not proto.HasField("_ingest_http_address")
+
+    with pytest.raises(UsageError):
+        s.update(_ingest_target="lol")
+
+
 def test_wandb_dir(test_settings):
     test_settings = test_settings()
     assert os.path.abspath(test_settings.wandb_dir) == os.path.abspath("wandb")
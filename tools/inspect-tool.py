@@ -18,7 +18,15 @@ def _robust_scan(ds):
             raise e
 
 
-def run(wandb_file: str) -> None:
+def _print_event(event) -> None:
+    """Print an event record on one line."""
+    attributes = ", ".join(f"{item.key}={item.value_json}" for item in event.attribute)
+    timestamp = event.timestamp.ToDatetime().isoformat()
+    print(f"step {event.step.num}\t{timestamp}\t{event.name}\t{attributes}")
+
+
+def run(wandb_file: str, events: bool = False) -> None:
+    """Print the records of a .wandb file one at a time, or only its events."""
     ds = datastore.DataStore()
     try:
         ds.open_for_scan(wandb_file)
@@ -34,6 +42,10 @@ def run(wandb_file: str) -> None:
         pb = wandb_internal_pb2.Record()
         pb.ParseFromString(data)
         record_type = pb.WhichOneof("record_type")
+        if events:
+            if record_type == "event":
+                _print_event(pb.event)
+            continue
         print(f"RECORD TYPE: {record_type}")
         print(pb)
         print()
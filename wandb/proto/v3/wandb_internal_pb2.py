@@ -17,7 +17,7 @@ from wandb.proto import wandb_base_pb2 as wandb_dot_proto_dot_wandb__base__pb2
 from wandb.proto import wandb_telemetry_pb2 as wandb_dot_proto_dot_wandb__telemetry__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\x9c\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0cHeaderRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xce\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x90\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x15\n\rexternal_path\x18\x10 \x01(\t\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xbb\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xc9\x0f\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12\x45\n\x12\x66ile_transfer_info\x18K \x01(\x0b\x32\'.wandb_internal.FileTransferInfoRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_type\"\xe2\x0b\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\x95\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"F\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\\\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\x96\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\tb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\xca\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12,\n\x05\x65vent\x18\x1a \x01(\x0b\x32\x1b.wandb_internal.EventRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0cHeaderRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xf3\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x19\n\x0c\x64isplay_name\x18\x08 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05notes\x18\t \x01(\tH\x01\x88\x01\x01\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\x0f\n\r_display_nameB\x08\n\x06_notes\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\xad\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12\x18\n\x10\x61llow_val_change\x18\x03 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"8\n\x0c\x43onfigResult\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x90\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x15\n\rexternal_path\x18\x10 \x01(\t\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xbb\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xd0\x01\n\x0b\x45ventRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\tattribute\x18\x04 \x03(\x0b\x32\x19.wandb_internal.EventItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\",\n\tEventItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x87\x11\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12\x45\n\x12\x66ile_transfer_info\x18K \x01(\x0b\x32\'.wandb_internal.FileTransferInfoRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\trun_edits\x18M \x01(\x0b\x32\x1f.wandb_internal.RunEditsRequestH\x00\x12\x37\n\ncheckpoint\x18N \x01(\x0b\x32!.wandb_internal.CheckpointRequestH\x00\x12M\n\x16\x66ile_transfer_progress\x18O \x01(\x0b\x32+.wandb_internal.FileTransferProgressRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_type\"\xbe\r\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12>\n\x12run_edits_response\x18G \x01(\x0b\x32 .wandb_internal.RunEditsResponseH\x00\x12\x41\n\x13\x63heckpoint_response\x18H \x01(\x0b\x32\".wandb_internal.CheckpointResponseH\x00\x12W\n\x1f\x66ile_transfer_progress_response\x18I \x01(\x0b\x32,.wandb_internal.FileTransferProgressResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"M\n\x0fRunEditsRequest\x12\x0c\n\x04pull\x18\x01 \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x10RunEditsResponse\x12&\n\x05\x65\x64its\x18\x01 \x03(\x0b\x32\x17.wandb_internal.RunEdit\x12&\n\x03run\x18\x02 \x01(\x0b\x32\x19.wandb_internal.RunRecord\"d\n\x07RunEdit\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x12\x0c\n\x04tags\x18\x03 \x03(\t\x12-\n\tedited_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x8a\x02\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\x12L\n\x16\x66ile_transfer_progress\x18\x05 \x01(\x0b\x32,.wandb_internal.FileTransferProgressResponse\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"K\n\x1b\x46ileTransferProgressRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x9e\x02\n\x12\x46ileTransferStatus\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x37\n\x05state\x18\x03 \x01(\x0e\x32(.wandb_internal.FileTransferStatus.State\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x19\n\x11transferred_bytes\x18\x05 \x01(\x03\x12\x0f\n\x07retries\x18\x06 \x01(\x05\x12\x18\n\x10\x62ytes_per_second\x18\x07 \x01(\x01\x12\x13\n\x0b\x65ta_seconds\x18\x08 \x01(\x01\x12\r\n\x05\x65rror\x18\t \x01(\t\";\n\x05State\x12\n\n\x06QUEUED\x10\x00\x12\x10\n\x0cTRANSFERRING\x10\x01\x12\x08\n\x04\x44ONE\x10\x02\x12\n\n\x06\x46\x41ILED\x10\x03\"\xd0\x02\n\x1c\x46ileTransferProgressResponse\x12\x31\n\x05\x66iles\x18\x01 \x03(\x0b\x32\".wandb_internal.FileTransferStatus\x12\x12\n\ndone_files\x18\x02 \x01(\x05\x12\x19\n\x11transferred_bytes\x18\x03 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x04 \x01(\x03\x12\x18\n\x10\x62ytes_per_second\x18\x05 \x01(\x01\x12\x13\n\x0b\x65ta_seconds\x18\x06 \x01(\x01\x12U\n\rskipped_files\x18\x07 \x03(\x0b\x32>.wandb_internal.FileTransferProgressResponse.SkippedFilesEntry\x1a\x33\n\x11SkippedFilesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x05:\x02\x38\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\xab\x02\n\x11\x43heckpointRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12?\n\x07metrics\x18\x03 \x03(\x0b\x32..wandb_internal.CheckpointRequest.MetricsEntry\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x30\n\x06policy\x18\x05 \x01(\x0b\x32 .wandb_internal.CheckpointPolicy\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\x1a.\n\x0cMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\"Z\n\x10\x43heckpointPolicy\x12\x11\n\tkeep_last\x18\x01 \x01(\x05\x12\x11\n\tkeep_best\x18\x02 \x01(\x05\x12\x0e\n\x06metric\x18\x03 \x01(\t\x12\x10\n\x08minimize\x18\x04 \x01(\x08\"g\n\x12\x43heckpointResponse\x12\x10\n\x08retained\x18\x01 \x01(\x08\x12\x15\n\revicted_steps\x18\x02 \x03(\x03\x12(\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\x95\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"F\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\\\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"U\n\tPauseInfo\x12\x37\n\tpaused_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x08pausedAt\x12\x0f\n\x07seconds\x18\x02 \x01(\x01\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\xc1\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12)\n\x06pauses\x18\x1e \x03(\x0b\x32\x19.wandb_internal.PauseInfo\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\tb\x06proto3')
 
 
 
@@ -73,6 +73,8 @@ _TBRECORD = DESCRIPTOR.message_types_by_name['TBRecord']
 _TBRESULT = DESCRIPTOR.message_types_by_name['TBResult']
 _ALERTRECORD = DESCRIPTOR.message_types_by_name['AlertRecord']
 _ALERTRESULT = DESCRIPTOR.message_types_by_name['AlertResult']
+_EVENTRECORD = DESCRIPTOR.message_types_by_name['EventRecord']
+_EVENTITEM = DESCRIPTOR.message_types_by_name['EventItem']
 _REQUEST = DESCRIPTOR.message_types_by_name['Request']
 _RESPONSE = DESCRIPTOR.message_types_by_name['Response']
 _DEFERREQUEST = DESCRIPTOR.message_types_by_name['DeferRequest']
@@ -93,6 +95,9 @@ _STATUSREQUEST = DESCRIPTOR.message_types_by_name['StatusRequest']
 _STATUSRESPONSE = DESCRIPTOR.message_types_by_name['StatusResponse']
 _STOPSTATUSREQUEST = DESCRIPTOR.message_types_by_name['StopStatusRequest']
 _STOPSTATUSRESPONSE = DESCRIPTOR.message_types_by_name['StopStatusResponse']
+_RUNEDITSREQUEST = DESCRIPTOR.message_types_by_name['RunEditsRequest']
+_RUNEDITSRESPONSE = DESCRIPTOR.message_types_by_name['RunEditsResponse']
+_RUNEDIT = DESCRIPTOR.message_types_by_name['RunEdit']
 _NETWORKSTATUSREQUEST = DESCRIPTOR.message_types_by_name['NetworkStatusRequest']
 _NETWORKSTATUSRESPONSE = DESCRIPTOR.message_types_by_name['NetworkStatusResponse']
 _HTTPRESPONSE = DESCRIPTOR.message_types_by_name['HttpResponse']
@@ -118,6 +123,10 @@ _FILECOUNTS = DESCRIPTOR.message_types_by_name['FileCounts']
 _FILEPUSHERSTATS = DESCRIPTOR.message_types_by_name['FilePusherStats']
 _FILESUPLOADED = DESCRIPTOR.message_types_by_name['FilesUploaded']
 _FILETRANSFERINFOREQUEST = DESCRIPTOR.message_types_by_name['FileTransferInfoRequest']
+_FILETRANSFERPROGRESSREQUEST = DESCRIPTOR.message_types_by_name['FileTransferProgressRequest']
+_FILETRANSFERSTATUS = DESCRIPTOR.message_types_by_name['FileTransferStatus']
+_FILETRANSFERPROGRESSRESPONSE = DESCRIPTOR.message_types_by_name['FileTransferProgressResponse']
+_FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY = _FILETRANSFERPROGRESSRESPONSE.nested_types_by_name['SkippedFilesEntry']
 _LOCALINFO = DESCRIPTOR.message_types_by_name['LocalInfo']
 _SHUTDOWNREQUEST = DESCRIPTOR.message_types_by_name['ShutdownRequest']
 _SHUTDOWNRESPONSE = DESCRIPTOR.message_types_by_name['ShutdownResponse']
@@ -141,6 +150,10 @@ _JOBINFOREQUEST = DESCRIPTOR.message_types_by_name['JobInfoRequest']
 _JOBINFORESPONSE = DESCRIPTOR.message_types_by_name['JobInfoResponse']
 _LOGARTIFACTREQUEST = DESCRIPTOR.message_types_by_name['LogArtifactRequest']
 _LOGARTIFACTRESPONSE = DESCRIPTOR.message_types_by_name['LogArtifactResponse']
+_CHECKPOINTREQUEST = DESCRIPTOR.message_types_by_name['CheckpointRequest']
+_CHECKPOINTREQUEST_METRICSENTRY = _CHECKPOINTREQUEST.nested_types_by_name['MetricsEntry']
+_CHECKPOINTPOLICY = DESCRIPTOR.message_types_by_name['CheckpointPolicy']
+_CHECKPOINTRESPONSE = DESCRIPTOR.message_types_by_name['CheckpointResponse']
 _DOWNLOADARTIFACTREQUEST = DESCRIPTOR.message_types_by_name['DownloadArtifactRequest']
 _DOWNLOADARTIFACTRESPONSE = DESCRIPTOR.message_types_by_name['DownloadArtifactResponse']
 _KEEPALIVEREQUEST = DESCRIPTOR.message_types_by_name['KeepaliveRequest']
@@ -157,6 +170,7 @@ _USEARTIFACTRESULT = DESCRIPTOR.message_types_by_name['UseArtifactResult']
 _CANCELREQUEST = DESCRIPTOR.message_types_by_name['CancelRequest']
 _CANCELRESPONSE = DESCRIPTOR.message_types_by_name['CancelResponse']
 _DISKINFO = DESCRIPTOR.message_types_by_name['DiskInfo']
+_PAUSEINFO = DESCRIPTOR.message_types_by_name['PauseInfo']
 _MEMORYINFO = DESCRIPTOR.message_types_by_name['MemoryInfo']
 _CPUINFO = DESCRIPTOR.message_types_by_name['CpuInfo']
 _GPUAPPLEINFO = DESCRIPTOR.message_types_by_name['GpuAppleInfo']
@@ -175,6 +189,7 @@ _FILESITEM_POLICYTYPE = _FILESITEM.enum_types_by_name['PolicyType']
 _STATSRECORD_STATSTYPE = _STATSRECORD.enum_types_by_name['StatsType']
 _DEFERREQUEST_DEFERSTATE = _DEFERREQUEST.enum_types_by_name['DeferState']
 _FILETRANSFERINFOREQUEST_TRANSFERTYPE = _FILETRANSFERINFOREQUEST.enum_types_by_name['TransferType']
+_FILETRANSFERSTATUS_STATE = _FILETRANSFERSTATUS.enum_types_by_name['State']
 Record = _reflection.GeneratedProtocolMessageType('Record', (_message.Message,), {
   'DESCRIPTOR' : _RECORD,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -539,6 +554,20 @@ AlertResult = _reflection.GeneratedProtocolMessageType('AlertResult', (_message.
   })
 _sym_db.RegisterMessage(AlertResult)
 
+EventRecord = _reflection.GeneratedProtocolMessageType('EventRecord', (_message.Message,), {
+  'DESCRIPTOR' : _EVENTRECORD,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.EventRecord)
+  })
+_sym_db.RegisterMessage(EventRecord)
+
+EventItem = _reflection.GeneratedProtocolMessageType('EventItem', (_message.Message,), {
+  'DESCRIPTOR' : _EVENTITEM,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.EventItem)
+  })
+_sym_db.RegisterMessage(EventItem)
+
 Request = _reflection.GeneratedProtocolMessageType('Request', (_message.Message,), {
   'DESCRIPTOR' : _REQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -680,6 +709,27 @@ StopStatusResponse = _reflection.GeneratedProtocolMessageType('StopStatusRespons
   })
 _sym_db.RegisterMessage(StopStatusResponse)
 
+RunEditsRequest = _reflection.GeneratedProtocolMessageType('RunEditsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RUNEDITSREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunEditsRequest)
+  })
+_sym_db.RegisterMessage(RunEditsRequest)
+
+RunEditsResponse = _reflection.GeneratedProtocolMessageType('RunEditsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RUNEDITSRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunEditsResponse)
+  })
+_sym_db.RegisterMessage(RunEditsResponse)
+
+RunEdit = _reflection.GeneratedProtocolMessageType('RunEdit', (_message.Message,), {
+  'DESCRIPTOR' : _RUNEDIT,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunEdit)
+  })
+_sym_db.RegisterMessage(RunEdit)
+
 NetworkStatusRequest = _reflection.GeneratedProtocolMessageType('NetworkStatusRequest', (_message.Message,), {
   'DESCRIPTOR' : _NETWORKSTATUSREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -855,6 +905,35 @@ FileTransferInfoRequest = _reflection.GeneratedProtocolMessageType('FileTransfer
   })
 _sym_db.RegisterMessage(FileTransferInfoRequest)
 
+FileTransferProgressRequest = _reflection.GeneratedProtocolMessageType('FileTransferProgressRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FILETRANSFERPROGRESSREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FileTransferProgressRequest)
+  })
+_sym_db.RegisterMessage(FileTransferProgressRequest)
+
+FileTransferStatus = _reflection.GeneratedProtocolMessageType('FileTransferStatus', (_message.Message,), {
+  'DESCRIPTOR' : _FILETRANSFERSTATUS,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FileTransferStatus)
+  })
+_sym_db.RegisterMessage(FileTransferStatus)
+
+FileTransferProgressResponse = _reflection.GeneratedProtocolMessageType('FileTransferProgressResponse', (_message.Message,), {
+
+  'SkippedFilesEntry' : _reflection.GeneratedProtocolMessageType('SkippedFilesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.FileTransferProgressResponse.SkippedFilesEntry)
+    })
+  ,
+  'DESCRIPTOR' : _FILETRANSFERPROGRESSRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FileTransferProgressResponse)
+  })
+_sym_db.RegisterMessage(FileTransferProgressResponse)
+_sym_db.RegisterMessage(FileTransferProgressResponse.SkippedFilesEntry)
+
 LocalInfo = _reflection.GeneratedProtocolMessageType('LocalInfo', (_message.Message,), {
   'DESCRIPTOR' : _LOCALINFO,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1016,6 +1095,35 @@ LogArtifactResponse = _reflection.GeneratedProtocolMessageType('LogArtifactRespo
   })
 _sym_db.RegisterMessage(LogArtifactResponse)
 
+CheckpointRequest = _reflection.GeneratedProtocolMessageType('CheckpointRequest', (_message.Message,), {
+
+  'MetricsEntry' : _reflection.GeneratedProtocolMessageType('MetricsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CHECKPOINTREQUEST_METRICSENTRY,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.CheckpointRequest.MetricsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CHECKPOINTREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.CheckpointRequest)
+  })
+_sym_db.RegisterMessage(CheckpointRequest)
+_sym_db.RegisterMessage(CheckpointRequest.MetricsEntry)
+
+CheckpointPolicy = _reflection.GeneratedProtocolMessageType('CheckpointPolicy', (_message.Message,), {
+  'DESCRIPTOR' : _CHECKPOINTPOLICY,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.CheckpointPolicy)
+  })
+_sym_db.RegisterMessage(CheckpointPolicy)
+
+CheckpointResponse = _reflection.GeneratedProtocolMessageType('CheckpointResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CHECKPOINTRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.CheckpointResponse)
+  })
+_sym_db.RegisterMessage(CheckpointResponse)
+
 DownloadArtifactRequest = _reflection.GeneratedProtocolMessageType('DownloadArtifactRequest', (_message.Message,), {
   'DESCRIPTOR' : _DOWNLOADARTIFACTREQUEST,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1128,6 +1236,13 @@ DiskInfo = _reflection.GeneratedProtocolMessageType('DiskInfo', (_message.Messag
   })
 _sym_db.RegisterMessage(DiskInfo)
 
+PauseInfo = _reflection.GeneratedProtocolMessageType('PauseInfo', (_message.Message,), {
+  'DESCRIPTOR' : _PAUSEINFO,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PauseInfo)
+  })
+_sym_db.RegisterMessage(PauseInfo)
+
 MemoryInfo = _reflection.GeneratedProtocolMessageType('MemoryInfo', (_message.Message,), {
   'DESCRIPTOR' : _MEMORYINFO,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -1206,316 +1321,350 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._options = None
   _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._options = None
   _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_options = b'8\001'
+  _FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY._options = None
+  _FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY._serialized_options = b'8\001'
+  _CHECKPOINTREQUEST_METRICSENTRY._options = None
+  _CHECKPOINTREQUEST_METRICSENTRY._serialized_options = b'8\001'
   _METADATAREQUEST_DISKENTRY._options = None
   _METADATAREQUEST_DISKENTRY._serialized_options = b'8\001'
   _METADATAREQUEST_SLURMENTRY._options = None
   _METADATAREQUEST_SLURMENTRY._serialized_options = b'8\001'
   _RECORD._serialized_start=151
-  _RECORD._serialized_end=1331
-  _CONTROL._serialized_start=1334
-  _CONTROL._serialized_end=1502
-  _RESULT._serialized_start=1505
-  _RESULT._serialized_end=2004
-  _FINALRECORD._serialized_start=2006
-  _FINALRECORD._serialized_end=2064
-  _HEADERRECORD._serialized_start=2066
-  _HEADERRECORD._serialized_end=2125
-  _FOOTERRECORD._serialized_start=2127
-  _FOOTERRECORD._serialized_end=2186
-  _RUNRECORD._serialized_start=2189
-  _RUNRECORD._serialized_end=2779
-  _GITREPORECORD._serialized_start=2781
-  _GITREPORECORD._serialized_end=2840
-  _RUNUPDATERESULT._serialized_start=2842
-  _RUNUPDATERESULT._serialized_end=2941
-  _ERRORINFO._serialized_start=2944
-  _ERRORINFO._serialized_end=3116
-  _ERRORINFO_ERRORCODE._serialized_start=3025
-  _ERRORINFO_ERRORCODE._serialized_end=3116
-  _RUNEXITRECORD._serialized_start=3118
-  _RUNEXITRECORD._serialized_end=3214
-  _RUNEXITRESULT._serialized_start=3216
-  _RUNEXITRESULT._serialized_end=3231
-  _RUNPREEMPTINGRECORD._serialized_start=3233
-  _RUNPREEMPTINGRECORD._serialized_end=3299
-  _RUNPREEMPTINGRESULT._serialized_start=3301
-  _RUNPREEMPTINGRESULT._serialized_end=3322
-  _SETTINGSRECORD._serialized_start=3324
-  _SETTINGSRECORD._serialized_end=3429
-  _SETTINGSITEM._serialized_start=3431
-  _SETTINGSITEM._serialized_end=3478
-  _HISTORYSTEP._serialized_start=3480
-  _HISTORYSTEP._serialized_end=3506
-  _HISTORYRECORD._serialized_start=3509
-  _HISTORYRECORD._serialized_end=3655
-  _HISTORYITEM._serialized_start=3657
-  _HISTORYITEM._serialized_end=3723
-  _HISTORYRESULT._serialized_start=3725
-  _HISTORYRESULT._serialized_end=3740
-  _OUTPUTRECORD._serialized_start=3743
-  _OUTPUTRECORD._serialized_end=3963
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=3927
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=3963
-  _OUTPUTRESULT._serialized_start=3965
-  _OUTPUTRESULT._serialized_end=3979
-  _OUTPUTRAWRECORD._serialized_start=3982
-  _OUTPUTRAWRECORD._serialized_end=4208
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=3927
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=3963
-  _OUTPUTRAWRESULT._serialized_start=4210
-  _OUTPUTRAWRESULT._serialized_end=4227
-  _METRICRECORD._serialized_start=4230
-  _METRICRECORD._serialized_end=4638
-  _METRICRECORD_METRICGOAL._serialized_start=4572
-  _METRICRECORD_METRICGOAL._serialized_end=4638
-  _METRICRESULT._serialized_start=4640
-  _METRICRESULT._serialized_end=4654
-  _METRICOPTIONS._serialized_start=4656
-  _METRICOPTIONS._serialized_end=4723
-  _METRICCONTROL._serialized_start=4725
-  _METRICCONTROL._serialized_end=4759
-  _METRICSUMMARY._serialized_start=4761
-  _METRICSUMMARY._serialized_end=4872
-  _CONFIGRECORD._serialized_start=4875
-  _CONFIGRECORD._serialized_end=5022
-  _CONFIGITEM._serialized_start=5024
-  _CONFIGITEM._serialized_end=5089
-  _CONFIGRESULT._serialized_start=5091
-  _CONFIGRESULT._serialized_end=5105
-  _SUMMARYRECORD._serialized_start=5108
-  _SUMMARYRECORD._serialized_end=5258
-  _SUMMARYITEM._serialized_start=5260
-  _SUMMARYITEM._serialized_end=5326
-  _SUMMARYRESULT._serialized_start=5328
-  _SUMMARYRESULT._serialized_end=5343
-  _FILESRECORD._serialized_start=5345
-  _FILESRECORD._serialized_end=5445
-  _FILESITEM._serialized_start=5448
-  _FILESITEM._serialized_end=5592
-  _FILESITEM_POLICYTYPE._serialized_start=5552
-  _FILESITEM_POLICYTYPE._serialized_end=5592
-  _FILESRESULT._serialized_start=5594
-  _FILESRESULT._serialized_end=5607
-  _STATSRECORD._serialized_start=5610
-  _STATSRECORD._serialized_end=5840
-  _STATSRECORD_STATSTYPE._serialized_start=5817
-  _STATSRECORD_STATSTYPE._serialized_end=5840
-  _STATSITEM._serialized_start=5842
-  _STATSITEM._serialized_end=5886
-  _ARTIFACTRECORD._serialized_start=5889
-  _ARTIFACTRECORD._serialized_end=6362
-  _ARTIFACTMANIFEST._serialized_start=6365
-  _ARTIFACTMANIFEST._serialized_end=6553
-  _ARTIFACTMANIFESTENTRY._serialized_start=6556
-  _ARTIFACTMANIFESTENTRY._serialized_end=6743
-  _EXTRAITEM._serialized_start=6745
-  _EXTRAITEM._serialized_end=6789
-  _STORAGEPOLICYCONFIGITEM._serialized_start=6791
-  _STORAGEPOLICYCONFIGITEM._serialized_end=6849
-  _ARTIFACTRESULT._serialized_start=6851
-  _ARTIFACTRESULT._serialized_end=6867
-  _LINKARTIFACTRESULT._serialized_start=6869
-  _LINKARTIFACTRESULT._serialized_end=6889
-  _LINKARTIFACTRECORD._serialized_start=6892
-  _LINKARTIFACTRECORD._serialized_end=7099
-  _TBRECORD._serialized_start=7101
-  _TBRECORD._serialized_end=7205
-  _TBRESULT._serialized_start=7207
-  _TBRESULT._serialized_end=7217
-  _ALERTRECORD._serialized_start=7219
-  _ALERTRECORD._serialized_end=7344
-  _ALERTRESULT._serialized_start=7346
-  _ALERTRESULT._serialized_end=7359
-  _REQUEST._serialized_start=7362
-  _REQUEST._serialized_end=9355
-  _RESPONSE._serialized_start=9358
-  _RESPONSE._serialized_end=10864
-  _DEFERREQUEST._serialized_start=10867
-  _DEFERREQUEST._serialized_end=11187
-  _DEFERREQUEST_DEFERSTATE._serialized_start=10940
-  _DEFERREQUEST_DEFERSTATE._serialized_end=11187
-  _PAUSEREQUEST._serialized_start=11189
-  _PAUSEREQUEST._serialized_end=11249
-  _PAUSERESPONSE._serialized_start=11251
-  _PAUSERESPONSE._serialized_end=11266
-  _RESUMEREQUEST._serialized_start=11268
-  _RESUMEREQUEST._serialized_end=11329
-  _RESUMERESPONSE._serialized_start=11331
-  _RESUMERESPONSE._serialized_end=11347
-  _LOGINREQUEST._serialized_start=11349
-  _LOGINREQUEST._serialized_end=11426
-  _LOGINRESPONSE._serialized_start=11428
-  _LOGINRESPONSE._serialized_end=11466
-  _GETSUMMARYREQUEST._serialized_start=11468
-  _GETSUMMARYREQUEST._serialized_end=11533
-  _GETSUMMARYRESPONSE._serialized_start=11535
-  _GETSUMMARYRESPONSE._serialized_end=11598
-  _GETSYSTEMMETRICSREQUEST._serialized_start=11600
-  _GETSYSTEMMETRICSREQUEST._serialized_end=11671
-  _SYSTEMMETRICSAMPLE._serialized_start=11673
-  _SYSTEMMETRICSAMPLE._serialized_end=11755
-  _SYSTEMMETRICSBUFFER._serialized_start=11757
-  _SYSTEMMETRICSBUFFER._serialized_end=11830
-  _GETSYSTEMMETRICSRESPONSE._serialized_start=11833
-  _GETSYSTEMMETRICSRESPONSE._serialized_end=12035
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=11946
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=12035
-  _STATUSREQUEST._serialized_start=12037
-  _STATUSREQUEST._serialized_end=12098
-  _STATUSRESPONSE._serialized_start=12100
-  _STATUSRESPONSE._serialized_end=12141
-  _STOPSTATUSREQUEST._serialized_start=12143
-  _STOPSTATUSREQUEST._serialized_end=12208
-  _STOPSTATUSRESPONSE._serialized_start=12210
-  _STOPSTATUSRESPONSE._serialized_end=12255
-  _NETWORKSTATUSREQUEST._serialized_start=12257
-  _NETWORKSTATUSREQUEST._serialized_end=12325
-  _NETWORKSTATUSRESPONSE._serialized_start=12327
-  _NETWORKSTATUSRESPONSE._serialized_end=12407
-  _HTTPRESPONSE._serialized_start=12409
-  _HTTPRESPONSE._serialized_end=12477
-  _INTERNALMESSAGESREQUEST._serialized_start=12479
-  _INTERNALMESSAGESREQUEST._serialized_end=12550
-  _INTERNALMESSAGESRESPONSE._serialized_start=12552
-  _INTERNALMESSAGESRESPONSE._serialized_end=12630
-  _INTERNALMESSAGES._serialized_start=12632
-  _INTERNALMESSAGES._serialized_end=12667
-  _POLLEXITREQUEST._serialized_start=12669
-  _POLLEXITREQUEST._serialized_end=12732
-  _POLLEXITRESPONSE._serialized_start=12735
-  _POLLEXITRESPONSE._serialized_end=12923
-  _SYNCOVERWRITE._serialized_start=12925
-  _SYNCOVERWRITE._serialized_end=12989
-  _SYNCSKIP._serialized_start=12991
-  _SYNCSKIP._serialized_end=13021
-  _SENDERMARKREQUEST._serialized_start=13023
-  _SENDERMARKREQUEST._serialized_end=13042
-  _SYNCREQUEST._serialized_start=13045
-  _SYNCREQUEST._serialized_end=13192
-  _SYNCRESPONSE._serialized_start=13194
-  _SYNCRESPONSE._serialized_end=13263
-  _SENDERREADREQUEST._serialized_start=13265
-  _SENDERREADREQUEST._serialized_end=13328
-  _STATUSREPORTREQUEST._serialized_start=13330
-  _STATUSREPORTREQUEST._serialized_end=13439
-  _SUMMARYRECORDREQUEST._serialized_start=13441
-  _SUMMARYRECORDREQUEST._serialized_end=13511
-  _TELEMETRYRECORDREQUEST._serialized_start=13513
-  _TELEMETRYRECORDREQUEST._serialized_end=13589
-  _SERVERINFOREQUEST._serialized_start=13591
-  _SERVERINFOREQUEST._serialized_end=13656
-  _SERVERINFORESPONSE._serialized_start=13658
-  _SERVERINFORESPONSE._serialized_end=13782
-  _SERVERMESSAGES._serialized_start=13784
-  _SERVERMESSAGES._serialized_end=13845
-  _SERVERMESSAGE._serialized_start=13847
-  _SERVERMESSAGE._serialized_end=13948
-  _FILECOUNTS._serialized_start=13950
-  _FILECOUNTS._serialized_end=14049
-  _FILEPUSHERSTATS._serialized_start=14051
-  _FILEPUSHERSTATS._serialized_end=14136
-  _FILESUPLOADED._serialized_start=14138
-  _FILESUPLOADED._serialized_end=14168
-  _FILETRANSFERINFOREQUEST._serialized_start=14171
-  _FILETRANSFERINFOREQUEST._serialized_end=14415
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=14375
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=14415
-  _LOCALINFO._serialized_start=14417
-  _LOCALINFO._serialized_end=14466
-  _SHUTDOWNREQUEST._serialized_start=14468
-  _SHUTDOWNREQUEST._serialized_end=14531
-  _SHUTDOWNRESPONSE._serialized_start=14533
-  _SHUTDOWNRESPONSE._serialized_end=14551
-  _ATTACHREQUEST._serialized_start=14553
-  _ATTACHREQUEST._serialized_end=14633
-  _ATTACHRESPONSE._serialized_start=14635
-  _ATTACHRESPONSE._serialized_end=14733
-  _TESTINJECTREQUEST._serialized_start=14736
-  _TESTINJECTREQUEST._serialized_end=15077
-  _TESTINJECTRESPONSE._serialized_start=15079
-  _TESTINJECTRESPONSE._serialized_end=15099
-  _HISTORYACTION._serialized_start=15101
-  _HISTORYACTION._serialized_end=15131
-  _PARTIALHISTORYREQUEST._serialized_start=15134
-  _PARTIALHISTORYREQUEST._serialized_end=15336
-  _PARTIALHISTORYRESPONSE._serialized_start=15338
-  _PARTIALHISTORYRESPONSE._serialized_end=15362
-  _SAMPLEDHISTORYREQUEST._serialized_start=15364
-  _SAMPLEDHISTORYREQUEST._serialized_end=15433
-  _SAMPLEDHISTORYITEM._serialized_start=15435
-  _SAMPLEDHISTORYITEM._serialized_end=15530
-  _SAMPLEDHISTORYRESPONSE._serialized_start=15532
-  _SAMPLEDHISTORYRESPONSE._serialized_end=15606
-  _RUNSTATUSREQUEST._serialized_start=15608
-  _RUNSTATUSREQUEST._serialized_end=15672
-  _RUNSTATUSRESPONSE._serialized_start=15674
-  _RUNSTATUSRESPONSE._serialized_end=15794
-  _RUNSTARTREQUEST._serialized_start=15796
-  _RUNSTARTREQUEST._serialized_end=15899
-  _RUNSTARTRESPONSE._serialized_start=15901
-  _RUNSTARTRESPONSE._serialized_end=15919
-  _CHECKVERSIONREQUEST._serialized_start=15921
-  _CHECKVERSIONREQUEST._serialized_end=16013
-  _CHECKVERSIONRESPONSE._serialized_start=16015
-  _CHECKVERSIONRESPONSE._serialized_end=16108
-  _JOBINFOREQUEST._serialized_start=16110
-  _JOBINFOREQUEST._serialized_end=16172
-  _JOBINFORESPONSE._serialized_start=16174
-  _JOBINFORESPONSE._serialized_end=16228
-  _LOGARTIFACTREQUEST._serialized_start=16231
-  _LOGARTIFACTREQUEST._serialized_end=16390
-  _LOGARTIFACTRESPONSE._serialized_start=16392
-  _LOGARTIFACTRESPONSE._serialized_end=16457
-  _DOWNLOADARTIFACTREQUEST._serialized_start=16460
-  _DOWNLOADARTIFACTREQUEST._serialized_end=16609
-  _DOWNLOADARTIFACTRESPONSE._serialized_start=16611
-  _DOWNLOADARTIFACTRESPONSE._serialized_end=16660
-  _KEEPALIVEREQUEST._serialized_start=16662
-  _KEEPALIVEREQUEST._serialized_end=16726
-  _KEEPALIVERESPONSE._serialized_start=16728
-  _KEEPALIVERESPONSE._serialized_end=16747
-  _ARTIFACTINFO._serialized_start=16749
-  _ARTIFACTINFO._serialized_end=16819
-  _GITINFO._serialized_start=16821
-  _GITINFO._serialized_end=16862
-  _GITSOURCE._serialized_start=16864
-  _GITSOURCE._serialized_end=16956
-  _IMAGESOURCE._serialized_start=16958
-  _IMAGESOURCE._serialized_end=16986
-  _SOURCE._serialized_start=16989
-  _SOURCE._serialized_end=17129
-  _JOBSOURCE._serialized_start=17131
-  _JOBSOURCE._serialized_end=17238
-  _PARTIALJOBARTIFACT._serialized_start=17240
-  _PARTIALJOBARTIFACT._serialized_end=17326
-  _USEARTIFACTRECORD._serialized_start=17329
-  _USEARTIFACTRECORD._serialized_end=17486
-  _USEARTIFACTRESULT._serialized_start=17488
-  _USEARTIFACTRESULT._serialized_end=17507
-  _CANCELREQUEST._serialized_start=17509
-  _CANCELREQUEST._serialized_end=17591
-  _CANCELRESPONSE._serialized_start=17593
-  _CANCELRESPONSE._serialized_end=17609
-  _DISKINFO._serialized_start=17611
-  _DISKINFO._serialized_end=17650
-  _MEMORYINFO._serialized_start=17652
-  _MEMORYINFO._serialized_end=17679
-  _CPUINFO._serialized_start=17681
-  _CPUINFO._serialized_end=17728
-  _GPUAPPLEINFO._serialized_start=17730
-  _GPUAPPLEINFO._serialized_end=17792
-  _GPUNVIDIAINFO._serialized_start=17794
-  _GPUNVIDIAINFO._serialized_end=17845
-  _GPUAMDINFO._serialized_start=17848
-  _GPUAMDINFO._serialized_end=18113
-  _METADATAREQUEST._serialized_start=18116
-  _METADATAREQUEST._serialized_end=19162
-  _METADATAREQUEST_DISKENTRY._serialized_start=19047
-  _METADATAREQUEST_DISKENTRY._serialized_end=19116
-  _METADATAREQUEST_SLURMENTRY._serialized_start=19118
-  _METADATAREQUEST_SLURMENTRY._serialized_end=19162
-  _PYTHONPACKAGESREQUEST._serialized_start=19165
-  _PYTHONPACKAGESREQUEST._serialized_end=19306
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=19260
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=19306
+  _RECORD._serialized_end=1377
+  _CONTROL._serialized_start=1380
+  _CONTROL._serialized_end=1548
+  _RESULT._serialized_start=1551
+  _RESULT._serialized_end=2050
+  _FINALRECORD._serialized_start=2052
+  _FINALRECORD._serialized_end=2110
+  _HEADERRECORD._serialized_start=2112
+  _HEADERRECORD._serialized_end=2171
+  _FOOTERRECORD._serialized_start=2173
+  _FOOTERRECORD._serialized_end=2232
+  _RUNRECORD._serialized_start=2235
+  _RUNRECORD._serialized_end=2862
+  _GITREPORECORD._serialized_start=2864
+  _GITREPORECORD._serialized_end=2923
+  _RUNUPDATERESULT._serialized_start=2925
+  _RUNUPDATERESULT._serialized_end=3024
+  _ERRORINFO._serialized_start=3027
+  _ERRORINFO._serialized_end=3199
+  _ERRORINFO_ERRORCODE._serialized_start=3108
+  _ERRORINFO_ERRORCODE._serialized_end=3199
+  _RUNEXITRECORD._serialized_start=3201
+  _RUNEXITRECORD._serialized_end=3297
+  _RUNEXITRESULT._serialized_start=3299
+  _RUNEXITRESULT._serialized_end=3314
+  _RUNPREEMPTINGRECORD._serialized_start=3316
+  _RUNPREEMPTINGRECORD._serialized_end=3382
+  _RUNPREEMPTINGRESULT._serialized_start=3384
+  _RUNPREEMPTINGRESULT._serialized_end=3405
+  _SETTINGSRECORD._serialized_start=3407
+  _SETTINGSRECORD._serialized_end=3512
+  _SETTINGSITEM._serialized_start=3514
+  _SETTINGSITEM._serialized_end=3561
+  _HISTORYSTEP._serialized_start=3563
+  _HISTORYSTEP._serialized_end=3589
+  _HISTORYRECORD._serialized_start=3592
+  _HISTORYRECORD._serialized_end=3738
+  _HISTORYITEM._serialized_start=3740
+  _HISTORYITEM._serialized_end=3806
+  _HISTORYRESULT._serialized_start=3808
+  _HISTORYRESULT._serialized_end=3823
+  _OUTPUTRECORD._serialized_start=3826
+  _OUTPUTRECORD._serialized_end=4046
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=4010
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=4046
+  _OUTPUTRESULT._serialized_start=4048
+  _OUTPUTRESULT._serialized_end=4062
+  _OUTPUTRAWRECORD._serialized_start=4065
+  _OUTPUTRAWRECORD._serialized_end=4291
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=4010
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=4046
+  _OUTPUTRAWRESULT._serialized_start=4293
+  _OUTPUTRAWRESULT._serialized_end=4310
+  _METRICRECORD._serialized_start=4313
+  _METRICRECORD._serialized_end=4721
+  _METRICRECORD_METRICGOAL._serialized_start=4655
+  _METRICRECORD_METRICGOAL._serialized_end=4721
+  _METRICRESULT._serialized_start=4723
+  _METRICRESULT._serialized_end=4737
+  _METRICOPTIONS._serialized_start=4739
+  _METRICOPTIONS._serialized_end=4806
+  _METRICCONTROL._serialized_start=4808
+  _METRICCONTROL._serialized_end=4842
+  _METRICSUMMARY._serialized_start=4844
+  _METRICSUMMARY._serialized_end=4955
+  _CONFIGRECORD._serialized_start=4958
+  _CONFIGRECORD._serialized_end=5131
+  _CONFIGITEM._serialized_start=5133
+  _CONFIGITEM._serialized_end=5198
+  _CONFIGRESULT._serialized_start=5200
+  _CONFIGRESULT._serialized_end=5256
+  _SUMMARYRECORD._serialized_start=5259
+  _SUMMARYRECORD._serialized_end=5409
+  _SUMMARYITEM._serialized_start=5411
+  _SUMMARYITEM._serialized_end=5477
+  _SUMMARYRESULT._serialized_start=5479
+  _SUMMARYRESULT._serialized_end=5494
+  _FILESRECORD._serialized_start=5496
+  _FILESRECORD._serialized_end=5596
+  _FILESITEM._serialized_start=5599
+  _FILESITEM._serialized_end=5743
+  _FILESITEM_POLICYTYPE._serialized_start=5703
+  _FILESITEM_POLICYTYPE._serialized_end=5743
+  _FILESRESULT._serialized_start=5745
+  _FILESRESULT._serialized_end=5758
+  _STATSRECORD._serialized_start=5761
+  _STATSRECORD._serialized_end=5991
+  _STATSRECORD_STATSTYPE._serialized_start=5968
+  _STATSRECORD_STATSTYPE._serialized_end=5991
+  _STATSITEM._serialized_start=5993
+  _STATSITEM._serialized_end=6037
+  _ARTIFACTRECORD._serialized_start=6040
+  _ARTIFACTRECORD._serialized_end=6513
+  _ARTIFACTMANIFEST._serialized_start=6516
+  _ARTIFACTMANIFEST._serialized_end=6704
+  _ARTIFACTMANIFESTENTRY._serialized_start=6707
+  _ARTIFACTMANIFESTENTRY._serialized_end=6894
+  _EXTRAITEM._serialized_start=6896
+  _EXTRAITEM._serialized_end=6940
+  _STORAGEPOLICYCONFIGITEM._serialized_start=6942
+  _STORAGEPOLICYCONFIGITEM._serialized_end=7000
+  _ARTIFACTRESULT._serialized_start=7002
+  _ARTIFACTRESULT._serialized_end=7018
+  _LINKARTIFACTRESULT._serialized_start=7020
+  _LINKARTIFACTRESULT._serialized_end=7040
+  _LINKARTIFACTRECORD._serialized_start=7043
+  _LINKARTIFACTRECORD._serialized_end=7250
+  _TBRECORD._serialized_start=7252
+  _TBRECORD._serialized_end=7356
+  _TBRESULT._serialized_start=7358
+  _TBRESULT._serialized_end=7368
+  _ALERTRECORD._serialized_start=7370
+  _ALERTRECORD._serialized_end=7495
+  _ALERTRESULT._serialized_start=7497
+  _ALERTRESULT._serialized_end=7510
+  _EVENTRECORD._serialized_start=7513
+  _EVENTRECORD._serialized_end=7721
+  _EVENTITEM._serialized_start=7723
+  _EVENTITEM._serialized_end=7767
+  _REQUEST._serialized_start=7770
+  _REQUEST._serialized_end=9953
+  _RESPONSE._serialized_start=9956
+  _RESPONSE._serialized_end=11682
+  _DEFERREQUEST._serialized_start=11685
+  _DEFERREQUEST._serialized_end=12005
+  _DEFERREQUEST_DEFERSTATE._serialized_start=11758
+  _DEFERREQUEST_DEFERSTATE._serialized_end=12005
+  _PAUSEREQUEST._serialized_start=12007
+  _PAUSEREQUEST._serialized_end=12067
+  _PAUSERESPONSE._serialized_start=12069
+  _PAUSERESPONSE._serialized_end=12084
+  _RESUMEREQUEST._serialized_start=12086
+  _RESUMEREQUEST._serialized_end=12147
+  _RESUMERESPONSE._serialized_start=12149
+  _RESUMERESPONSE._serialized_end=12165
+  _LOGINREQUEST._serialized_start=12167
+  _LOGINREQUEST._serialized_end=12244
+  _LOGINRESPONSE._serialized_start=12246
+  _LOGINRESPONSE._serialized_end=12284
+  _GETSUMMARYREQUEST._serialized_start=12286
+  _GETSUMMARYREQUEST._serialized_end=12351
+  _GETSUMMARYRESPONSE._serialized_start=12353
+  _GETSUMMARYRESPONSE._serialized_end=12416
+  _GETSYSTEMMETRICSREQUEST._serialized_start=12418
+  _GETSYSTEMMETRICSREQUEST._serialized_end=12489
+  _SYSTEMMETRICSAMPLE._serialized_start=12491
+  _SYSTEMMETRICSAMPLE._serialized_end=12573
+  _SYSTEMMETRICSBUFFER._serialized_start=12575
+  _SYSTEMMETRICSBUFFER._serialized_end=12648
+  _GETSYSTEMMETRICSRESPONSE._serialized_start=12651
+  _GETSYSTEMMETRICSRESPONSE._serialized_end=12853
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=12764
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=12853
+  _STATUSREQUEST._serialized_start=12855
+  _STATUSREQUEST._serialized_end=12916
+  _STATUSRESPONSE._serialized_start=12918
+  _STATUSRESPONSE._serialized_end=12959
+  _STOPSTATUSREQUEST._serialized_start=12961
+  _STOPSTATUSREQUEST._serialized_end=13026
+  _STOPSTATUSRESPONSE._serialized_start=13028
+  _STOPSTATUSRESPONSE._serialized_end=13073
+  _RUNEDITSREQUEST._serialized_start=13075
+  _RUNEDITSREQUEST._serialized_end=13152
+  _RUNEDITSRESPONSE._serialized_start=13154
+  _RUNEDITSRESPONSE._serialized_end=13252
+  _RUNEDIT._serialized_start=13254
+  _RUNEDIT._serialized_end=13354
+  _NETWORKSTATUSREQUEST._serialized_start=13356
+  _NETWORKSTATUSREQUEST._serialized_end=13424
+  _NETWORKSTATUSRESPONSE._serialized_start=13426
+  _NETWORKSTATUSRESPONSE._serialized_end=13506
+  _HTTPRESPONSE._serialized_start=13508
+  _HTTPRESPONSE._serialized_end=13576
+  _INTERNALMESSAGESREQUEST._serialized_start=13578
+  _INTERNALMESSAGESREQUEST._serialized_end=13649
+  _INTERNALMESSAGESRESPONSE._serialized_start=13651
+  _INTERNALMESSAGESRESPONSE._serialized_end=13729
+  _INTERNALMESSAGES._serialized_start=13731
+  _INTERNALMESSAGES._serialized_end=13766
+  _POLLEXITREQUEST._serialized_start=13768
+  _POLLEXITREQUEST._serialized_end=13831
+  _POLLEXITRESPONSE._serialized_start=13834
+  _POLLEXITRESPONSE._serialized_end=14100
+  _SYNCOVERWRITE._serialized_start=14102
+  _SYNCOVERWRITE._serialized_end=14166
+  _SYNCSKIP._serialized_start=14168
+  _SYNCSKIP._serialized_end=14198
+  _SENDERMARKREQUEST._serialized_start=14200
+  _SENDERMARKREQUEST._serialized_end=14219
+  _SYNCREQUEST._serialized_start=14222
+  _SYNCREQUEST._serialized_end=14369
+  _SYNCRESPONSE._serialized_start=14371
+  _SYNCRESPONSE._serialized_end=14440
+  _SENDERREADREQUEST._serialized_start=14442
+  _SENDERREADREQUEST._serialized_end=14505
+  _STATUSREPORTREQUEST._serialized_start=14507
+  _STATUSREPORTREQUEST._serialized_end=14616
+  _SUMMARYRECORDREQUEST._serialized_start=14618
+  _SUMMARYRECORDREQUEST._serialized_end=14688
+  _TELEMETRYRECORDREQUEST._serialized_start=14690
+  _TELEMETRYRECORDREQUEST._serialized_end=14766
+  _SERVERINFOREQUEST._serialized_start=14768
+  _SERVERINFOREQUEST._serialized_end=14833
+  _SERVERINFORESPONSE._serialized_start=14835
+  _SERVERINFORESPONSE._serialized_end=14959
+  _SERVERMESSAGES._serialized_start=14961
+  _SERVERMESSAGES._serialized_end=15022
+  _SERVERMESSAGE._serialized_start=15024
+  _SERVERMESSAGE._serialized_end=15125
+  _FILECOUNTS._serialized_start=15127
+  _FILECOUNTS._serialized_end=15226
+  _FILEPUSHERSTATS._serialized_start=15228
+  _FILEPUSHERSTATS._serialized_end=15313
+  _FILESUPLOADED._serialized_start=15315
+  _FILESUPLOADED._serialized_end=15345
+  _FILETRANSFERINFOREQUEST._serialized_start=15348
+  _FILETRANSFERINFOREQUEST._serialized_end=15592
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=15552
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=15592
+  _FILETRANSFERPROGRESSREQUEST._serialized_start=15594
+  _FILETRANSFERPROGRESSREQUEST._serialized_end=15669
+  _FILETRANSFERSTATUS._serialized_start=15672
+  _FILETRANSFERSTATUS._serialized_end=15958
+  _FILETRANSFERSTATUS_STATE._serialized_start=15899
+  _FILETRANSFERSTATUS_STATE._serialized_end=15958
+  _FILETRANSFERPROGRESSRESPONSE._serialized_start=15961
+  _FILETRANSFERPROGRESSRESPONSE._serialized_end=16297
+  _FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY._serialized_start=16246
+  _FILETRANSFERPROGRESSRESPONSE_SKIPPEDFILESENTRY._serialized_end=16297
+  _LOCALINFO._serialized_start=16299
+  _LOCALINFO._serialized_end=16348
+  _SHUTDOWNREQUEST._serialized_start=16350
+  _SHUTDOWNREQUEST._serialized_end=16413
+  _SHUTDOWNRESPONSE._serialized_start=16415
+  _SHUTDOWNRESPONSE._serialized_end=16433
+  _ATTACHREQUEST._serialized_start=16435
+  _ATTACHREQUEST._serialized_end=16515
+  _ATTACHRESPONSE._serialized_start=16517
+  _ATTACHRESPONSE._serialized_end=16615
+  _TESTINJECTREQUEST._serialized_start=16618
+  _TESTINJECTREQUEST._serialized_end=16959
+  _TESTINJECTRESPONSE._serialized_start=16961
+  _TESTINJECTRESPONSE._serialized_end=16981
+  _HISTORYACTION._serialized_start=16983
+  _HISTORYACTION._serialized_end=17013
+  _PARTIALHISTORYREQUEST._serialized_start=17016
+  _PARTIALHISTORYREQUEST._serialized_end=17218
+  _PARTIALHISTORYRESPONSE._serialized_start=17220
+  _PARTIALHISTORYRESPONSE._serialized_end=17244
+  _SAMPLEDHISTORYREQUEST._serialized_start=17246
+  _SAMPLEDHISTORYREQUEST._serialized_end=17315
+  _SAMPLEDHISTORYITEM._serialized_start=17317
+  _SAMPLEDHISTORYITEM._serialized_end=17412
+  _SAMPLEDHISTORYRESPONSE._serialized_start=17414
+  _SAMPLEDHISTORYRESPONSE._serialized_end=17488
+  _RUNSTATUSREQUEST._serialized_start=17490
+  _RUNSTATUSREQUEST._serialized_end=17554
+  _RUNSTATUSRESPONSE._serialized_start=17556
+  _RUNSTATUSRESPONSE._serialized_end=17676
+  _RUNSTARTREQUEST._serialized_start=17678
+  _RUNSTARTREQUEST._serialized_end=17781
+  _RUNSTARTRESPONSE._serialized_start=17783
+  _RUNSTARTRESPONSE._serialized_end=17801
+  _CHECKVERSIONREQUEST._serialized_start=17803
+  _CHECKVERSIONREQUEST._serialized_end=17895
+  _CHECKVERSIONRESPONSE._serialized_start=17897
+  _CHECKVERSIONRESPONSE._serialized_end=17990
+  _JOBINFOREQUEST._serialized_start=17992
+  _JOBINFOREQUEST._serialized_end=18054
+  _JOBINFORESPONSE._serialized_start=18056
+  _JOBINFORESPONSE._serialized_end=18110
+  _LOGARTIFACTREQUEST._serialized_start=18113
+  _LOGARTIFACTREQUEST._serialized_end=18272
+  _LOGARTIFACTRESPONSE._serialized_start=18274
+  _LOGARTIFACTRESPONSE._serialized_end=18339
+  _CHECKPOINTREQUEST._serialized_start=18342
+  _CHECKPOINTREQUEST._serialized_end=18641
+  _CHECKPOINTREQUEST_METRICSENTRY._serialized_start=18595
+  _CHECKPOINTREQUEST_METRICSENTRY._serialized_end=18641
+  _CHECKPOINTPOLICY._serialized_start=18643
+  _CHECKPOINTPOLICY._serialized_end=18733
+  _CHECKPOINTRESPONSE._serialized_start=18735
+  _CHECKPOINTRESPONSE._serialized_end=18838
+  _DOWNLOADARTIFACTREQUEST._serialized_start=18841
+  _DOWNLOADARTIFACTREQUEST._serialized_end=18990
+  _DOWNLOADARTIFACTRESPONSE._serialized_start=18992
+  _DOWNLOADARTIFACTRESPONSE._serialized_end=19041
+  _KEEPALIVEREQUEST._serialized_start=19043
+  _KEEPALIVEREQUEST._serialized_end=19107
+  _KEEPALIVERESPONSE._serialized_start=19109
+  _KEEPALIVERESPONSE._serialized_end=19128
+  _ARTIFACTINFO._serialized_start=19130
+  _ARTIFACTINFO._serialized_end=19200
+  _GITINFO._serialized_start=19202
+  _GITINFO._serialized_end=19243
+  _GITSOURCE._serialized_start=19245
+  _GITSOURCE._serialized_end=19337
+  _IMAGESOURCE._serialized_start=19339
+  _IMAGESOURCE._serialized_end=19367
+  _SOURCE._serialized_start=19370
+  _SOURCE._serialized_end=19510
+  _JOBSOURCE._serialized_start=19512
+  _JOBSOURCE._serialized_end=19619
+  _PARTIALJOBARTIFACT._serialized_start=19621
+  _PARTIALJOBARTIFACT._serialized_end=19707
+  _USEARTIFACTRECORD._serialized_start=19710
+  _USEARTIFACTRECORD._serialized_end=19867
+  _USEARTIFACTRESULT._serialized_start=19869
+  _USEARTIFACTRESULT._serialized_end=19888
+  _CANCELREQUEST._serialized_start=19890
+  _CANCELREQUEST._serialized_end=19972
+  _CANCELRESPONSE._serialized_start=19974
+  _CANCELRESPONSE._serialized_end=19990
+  _DISKINFO._serialized_start=19992
+  _DISKINFO._serialized_end=20031
+  _PAUSEINFO._serialized_start=20033
+  _PAUSEINFO._serialized_end=20118
+  _MEMORYINFO._serialized_start=20120
+  _MEMORYINFO._serialized_end=20147
+  _CPUINFO._serialized_start=20149
+  _CPUINFO._serialized_end=20196
+  _GPUAPPLEINFO._serialized_start=20198
+  _GPUAPPLEINFO._serialized_end=20260
+  _GPUNVIDIAINFO._serialized_start=20262
+  _GPUNVIDIAINFO._serialized_end=20313
+  _GPUAMDINFO._serialized_start=20316
+  _GPUAMDINFO._serialized_end=20581
+  _METADATAREQUEST._serialized_start=20584
+  _METADATAREQUEST._serialized_end=21673
+  _METADATAREQUEST_DISKENTRY._serialized_start=21558
+  _METADATAREQUEST_DISKENTRY._serialized_end=21627
+  _METADATAREQUEST_SLURMENTRY._serialized_start=21629
+  _METADATAREQUEST_SLURMENTRY._serialized_end=21673
+  _PYTHONPACKAGESREQUEST._serialized_start=21676
+  _PYTHONPACKAGESREQUEST._serialized_end=21817
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=21771
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=21817
 # @@protoc_insertion_point(module_scope)
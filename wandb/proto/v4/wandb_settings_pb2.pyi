@@ -130,6 +130,8 @@ class Settings(google.protobuf.message.Message):
     _ASYNC_UPLOAD_CONCURRENCY_LIMIT_FIELD_NUMBER: builtins.int
     _CLI_ONLY_MODE_FIELD_NUMBER: builtins.int
     _COLAB_FIELD_NUMBER: builtins.int
+    _CONFIG_TIMELINE_HISTORY_FIELD_NUMBER: builtins.int
+    _CREDENTIAL_HELPER_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
     _CUDA_FIELD_NUMBER: builtins.int
     _DISABLE_META_FIELD_NUMBER: builtins.int
     _DISABLE_SERVICE_FIELD_NUMBER: builtins.int
@@ -151,10 +153,19 @@ class Settings(google.protobuf.message.Message):
     _FILE_TRANSFER_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
     _FLOW_CONTROL_CUSTOM_FIELD_NUMBER: builtins.int
     _FLOW_CONTROL_DISABLED_FIELD_NUMBER: builtins.int
+    _GRAPHQL_CACHE_TTLS_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_MAX_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_WAIT_MIN_SECONDS_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_WAIT_MAX_SECONDS_FIELD_NUMBER: builtins.int
     _GRAPHQL_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _HISTORY_RATE_LIMIT_FIELD_NUMBER: builtins.int
+    _HISTORY_RATE_LIMIT_POLICY_FIELD_NUMBER: builtins.int
+    _HISTORY_TYPE_POLICY_FIELD_NUMBER: builtins.int
+    _INGEST_HTTP_ADDRESS_FIELD_NUMBER: builtins.int
+    _INGEST_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _INGEST_PREFIX_FIELD_NUMBER: builtins.int
+    _INGEST_STATSD_ADDRESS_FIELD_NUMBER: builtins.int
+    _INGEST_TARGET_FIELD_NUMBER: builtins.int
     _INTERNAL_CHECK_PROCESS_FIELD_NUMBER: builtins.int
     _INTERNAL_QUEUE_TIMEOUT_FIELD_NUMBER: builtins.int
     _IPYTHON_FIELD_NUMBER: builtins.int
@@ -166,17 +177,25 @@ class Settings(google.protobuf.message.Message):
     _LIVE_POLICY_RATE_LIMIT_FIELD_NUMBER: builtins.int
     _LIVE_POLICY_WAIT_TIME_FIELD_NUMBER: builtins.int
     _LOG_LEVEL_FIELD_NUMBER: builtins.int
+    _MIRROR_SHUTDOWN_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _MIRRORS_FIELD_NUMBER: builtins.int
     _NETWORK_BUFFER_FIELD_NUMBER: builtins.int
     _NOOP_FIELD_NUMBER: builtins.int
     _NOTEBOOK_FIELD_NUMBER: builtins.int
     _OFFLINE_FIELD_NUMBER: builtins.int
     _SYNC_FIELD_NUMBER: builtins.int
     _OS_FIELD_NUMBER: builtins.int
+    _PAUSE_SUBSYSTEMS_FIELD_NUMBER: builtins.int
     _PLATFORM_FIELD_NUMBER: builtins.int
     _PYTHON_FIELD_NUMBER: builtins.int
+    _RUN_PULL_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _RUN_UPSERT_BURST_FIELD_NUMBER: builtins.int
+    _RUN_UPSERT_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
     _RUNQUEUE_ITEM_ID_FIELD_NUMBER: builtins.int
     _REQUIRE_CORE_FIELD_NUMBER: builtins.int
+    _RESOURCE_PRICE_TABLE_FIELD_NUMBER: builtins.int
     _SAVE_REQUIREMENTS_FIELD_NUMBER: builtins.int
+    _SAVE_HISTORY_AND_SUMMARY_FIELD_NUMBER: builtins.int
     _SERVICE_TRANSPORT_FIELD_NUMBER: builtins.int
     _SERVICE_WAIT_FIELD_NUMBER: builtins.int
     _START_DATETIME_FIELD_NUMBER: builtins.int
@@ -190,6 +209,8 @@ class Settings(google.protobuf.message.Message):
     _STATS_OPEN_METRICS_FILTERS_FIELD_NUMBER: builtins.int
     _STATS_DISK_PATHS_FIELD_NUMBER: builtins.int
     _STATS_BUFFER_SIZE_FIELD_NUMBER: builtins.int
+    _STOP_POLLING_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _SYMLINK_POLICY_FIELD_NUMBER: builtins.int
     _TMP_CODE_DIR_FIELD_NUMBER: builtins.int
     _TRACELOG_FIELD_NUMBER: builtins.int
     _UNSAVED_KEYS_FIELD_NUMBER: builtins.int
@@ -203,6 +224,7 @@ class Settings(google.protobuf.message.Message):
     CONFIG_PATHS_FIELD_NUMBER: builtins.int
     COLAB_URL_FIELD_NUMBER: builtins.int
     CONSOLE_FIELD_NUMBER: builtins.int
+    CREDENTIAL_HELPER_FIELD_NUMBER: builtins.int
     DEPLOYMENT_FIELD_NUMBER: builtins.int
     DISABLE_CODE_FIELD_NUMBER: builtins.int
     DISABLE_GIT_FIELD_NUMBER: builtins.int
@@ -297,6 +319,10 @@ class Settings(google.protobuf.message.Message):
     @property
     def _colab(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
+    def _config_timeline_history(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
+    def _credential_helper_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
     def _cuda(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def _disable_meta(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
@@ -339,6 +365,8 @@ class Settings(google.protobuf.message.Message):
     @property
     def _flow_control_disabled(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
+    def _graphql_cache_ttls(self) -> global___MapStringKeyStringValue: ...
+    @property
     def _graphql_retry_max(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     @property
     def _graphql_retry_wait_min_seconds(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
@@ -347,6 +375,22 @@ class Settings(google.protobuf.message.Message):
     @property
     def _graphql_timeout_seconds(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     @property
+    def _history_rate_limit(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _history_rate_limit_policy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _history_type_policy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _ingest_http_address(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _ingest_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _ingest_prefix(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _ingest_statsd_address(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _ingest_target(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
     def _internal_check_process(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
     @property
     def _internal_queue_timeout(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
@@ -369,6 +413,10 @@ class Settings(google.protobuf.message.Message):
     @property
     def _log_level(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     @property
+    def _mirror_shutdown_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _mirrors(self) -> global___MapStringKeyMapStringKeyStringValue: ...
+    @property
     def _network_buffer(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     @property
     def _noop(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
@@ -381,16 +429,28 @@ class Settings(google.protobuf.message.Message):
     @property
     def _os(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
+    def _pause_subsystems(self) -> global___ListStringValue: ...
+    @property
     def _platform(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def _python(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
+    def _run_pull_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _run_upsert_burst(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _run_upsert_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
     def _runqueue_item_id(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def _require_core(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
+    def _resource_price_table(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
     def _save_requirements(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
+    def _save_history_and_summary(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
     def _service_transport(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def _service_wait(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
@@ -417,6 +477,10 @@ class Settings(google.protobuf.message.Message):
     @property
     def _stats_buffer_size(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     @property
+    def _stop_polling_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _symlink_policy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
     def _tmp_code_dir(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def _tracelog(self) -> google.protobuf.wrappers_pb2.StringValue: ...
@@ -443,6 +507,8 @@ class Settings(google.protobuf.message.Message):
     @property
     def console(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
+    def credential_helper(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
     def deployment(self) -> google.protobuf.wrappers_pb2.StringValue: ...
     @property
     def disable_code(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
@@ -616,6 +682,8 @@ class Settings(google.protobuf.message.Message):
         _async_upload_concurrency_limit: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _cli_only_mode: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _colab: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_timeline_history: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _credential_helper_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _cuda: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _disable_meta: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _disable_service: google.protobuf.wrappers_pb2.BoolValue | None = ...,
@@ -637,10 +705,19 @@ class Settings(google.protobuf.message.Message):
         _file_transfer_timeout_seconds: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _flow_control_custom: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _flow_control_disabled: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _graphql_cache_ttls: global___MapStringKeyStringValue | None = ...,
         _graphql_retry_max: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _graphql_retry_wait_min_seconds: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _graphql_retry_wait_max_seconds: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _graphql_timeout_seconds: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_rate_limit: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_rate_limit_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _history_type_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _ingest_http_address: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _ingest_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _ingest_prefix: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _ingest_statsd_address: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _ingest_target: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _internal_check_process: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _internal_queue_timeout: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _ipython: google.protobuf.wrappers_pb2.BoolValue | None = ...,
@@ -652,17 +729,25 @@ class Settings(google.protobuf.message.Message):
         _live_policy_rate_limit: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _live_policy_wait_time: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _log_level: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _mirror_shutdown_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _mirrors: global___MapStringKeyMapStringKeyStringValue | None = ...,
         _network_buffer: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _noop: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _notebook: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _offline: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _sync: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _os: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _pause_subsystems: global___ListStringValue | None = ...,
         _platform: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _python: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _run_pull_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _run_upsert_burst: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _run_upsert_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _runqueue_item_id: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _require_core: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _resource_price_table: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _save_requirements: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _save_history_and_summary: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _service_transport: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _service_wait: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _start_datetime: google.protobuf.wrappers_pb2.StringValue | None = ...,
@@ -676,6 +761,8 @@ class Settings(google.protobuf.message.Message):
         _stats_open_metrics_filters: global___OpenMetricsFilters | None = ...,
         _stats_disk_paths: global___ListStringValue | None = ...,
         _stats_buffer_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _stop_polling_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _symlink_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _tmp_code_dir: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _tracelog: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _unsaved_keys: global___ListStringValue | None = ...,
@@ -689,6 +776,7 @@ class Settings(google.protobuf.message.Message):
         config_paths: global___ListStringValue | None = ...,
         colab_url: google.protobuf.wrappers_pb2.StringValue | None = ...,
         console: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        credential_helper: google.protobuf.wrappers_pb2.StringValue | None = ...,
         deployment: google.protobuf.wrappers_pb2.StringValue | None = ...,
         disable_code: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         disable_git: google.protobuf.wrappers_pb2.BoolValue | None = ...,
@@ -773,7 +861,7 @@ class Settings(google.protobuf.message.Message):
         username: google.protobuf.wrappers_pb2.StringValue | None = ...,
         wandb_dir: google.protobuf.wrappers_pb2.StringValue | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_config_timeline_history", b"_config_timeline_history", "_credential_helper_timeout_seconds", b"_credential_helper_timeout_seconds", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_cache_ttls", b"_graphql_cache_ttls", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_rate_limit", b"_history_rate_limit", "_history_rate_limit_policy", b"_history_rate_limit_policy", "_history_type_policy", b"_history_type_policy", "_ingest_http_address", b"_ingest_http_address", "_ingest_interval_seconds", b"_ingest_interval_seconds", "_ingest_prefix", b"_ingest_prefix", "_ingest_statsd_address", b"_ingest_statsd_address", "_ingest_target", b"_ingest_target", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_mirror_shutdown_timeout_seconds", b"_mirror_shutdown_timeout_seconds", "_mirrors", b"_mirrors", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_pause_subsystems", b"_pause_subsystems", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_resource_price_table", b"_resource_price_table", "_run_pull_interval_seconds", b"_run_pull_interval_seconds", "_run_upsert_burst", b"_run_upsert_burst", "_run_upsert_interval_seconds", b"_run_upsert_interval_seconds", "_runqueue_item_id", b"_runqueue_item_id", "_save_history_and_summary", b"_save_history_and_summary", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stop_polling_interval_seconds", b"_stop_polling_interval_seconds", "_symlink_policy", b"_symlink_policy", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "credential_helper", b"credential_helper", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_config_timeline_history", b"_config_timeline_history", "_credential_helper_timeout_seconds", b"_credential_helper_timeout_seconds", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_cache_ttls", b"_graphql_cache_ttls", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_rate_limit", b"_history_rate_limit", "_history_rate_limit_policy", b"_history_rate_limit_policy", "_history_type_policy", b"_history_type_policy", "_ingest_http_address", b"_ingest_http_address", "_ingest_interval_seconds", b"_ingest_interval_seconds", "_ingest_prefix", b"_ingest_prefix", "_ingest_statsd_address", b"_ingest_statsd_address", "_ingest_target", b"_ingest_target", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_mirror_shutdown_timeout_seconds", b"_mirror_shutdown_timeout_seconds", "_mirrors", b"_mirrors", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_pause_subsystems", b"_pause_subsystems", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_resource_price_table", b"_resource_price_table", "_run_pull_interval_seconds", b"_run_pull_interval_seconds", "_run_upsert_burst", b"_run_upsert_burst", "_run_upsert_interval_seconds", b"_run_upsert_interval_seconds", "_runqueue_item_id", b"_runqueue_item_id", "_save_history_and_summary", b"_save_history_and_summary", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stop_polling_interval_seconds", b"_stop_polling_interval_seconds", "_symlink_policy", b"_symlink_policy", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "credential_helper", b"credential_helper", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
 
 global___Settings = Settings
@@ -113,8 +113,11 @@ class InterfaceBase:
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
         obj: Optional[pb.ConfigRecord] = None,
+        allow_val_change: bool = False,
     ) -> pb.ConfigRecord:
         config = obj or pb.ConfigRecord()
+        if allow_val_change:
+            config.allow_val_change = True
         if data:
             for k, v in data.items():
                 update = config.update.add()
@@ -163,8 +166,11 @@ class InterfaceBase:
         data: Optional[dict] = None,
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
+        allow_val_change: bool = False,
     ) -> None:
-        cfg = self._make_config(data=data, key=key, val=val)
+        cfg = self._make_config(
+            data=data, key=key, val=val, allow_val_change=allow_val_change
+        )
 
         self._publish_config(cfg)
 
@@ -16,6 +16,8 @@ _Setting = Literal[
     "_async_upload_concurrency_limit",
     "_cli_only_mode",
     "_colab",
+    "_config_timeline_history",
+    "_credential_helper_timeout_seconds",
     "_cuda",
     "_disable_meta",
     "_disable_service",
@@ -36,10 +38,19 @@ _Setting = Literal[
     "_file_transfer_timeout_seconds",
     "_flow_control_custom",
     "_flow_control_disabled",
+    "_graphql_cache_ttls",
     "_graphql_retry_max",
     "_graphql_retry_wait_min_seconds",
     "_graphql_retry_wait_max_seconds",
     "_graphql_timeout_seconds",
+    "_history_rate_limit",
+    "_history_rate_limit_policy",
+    "_history_type_policy",
+    "_ingest_http_address",
+    "_ingest_interval_seconds",
+    "_ingest_prefix",
+    "_ingest_statsd_address",
+    "_ingest_target",
     "_internal_check_process",
     "_internal_queue_timeout",
     "_ipython",
@@ -51,18 +62,26 @@ _Setting = Literal[
     "_live_policy_rate_limit",
     "_live_policy_wait_time",
     "_log_level",
+    "_mirror_shutdown_timeout_seconds",
+    "_mirrors",
     "_network_buffer",
     "_noop",
     "_notebook",
     "_offline",
     "_sync",
     "_os",
+    "_pause_subsystems",
     "_platform",
     "_proxies",
     "_python",
+    "_run_pull_interval_seconds",
+    "_run_upsert_burst",
+    "_run_upsert_interval_seconds",
     "_runqueue_item_id",
     "_require_core",
+    "_resource_price_table",
     "_save_requirements",
+    "_save_history_and_summary",
     "_service_transport",
     "_service_wait",
     "_start_datetime",
@@ -76,6 +95,8 @@ _Setting = Literal[
     "_stats_open_metrics_filters",
     "_stats_disk_paths",
     "_stats_buffer_size",
+    "_stop_polling_interval_seconds",
+    "_symlink_policy",
     "_tmp_code_dir",
     "_tracelog",
     "_unsaved_keys",
@@ -89,6 +110,7 @@ _Setting = Literal[
     "colab_url",
     "config_paths",
     "console",
+    "credential_helper",
     "deployment",
     "disable_code",
     "disable_git",
@@ -176,9 +198,15 @@ _Setting = Literal[
 
 SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "_async_upload_concurrency_limit",
+    "_history_rate_limit_policy",
+    "_history_type_policy",
+    "_ingest_target",
+    "_mirrors",
+    "_pause_subsystems",
     "_service_wait",
     "_stats_sample_rate_seconds",
     "_stats_samples_to_average",
+    "_symlink_policy",
     "anonymous",
     "api_key",
     "base_url",
@@ -204,6 +232,7 @@ SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "_notebook",
     "disabled",
     "_offline",
+    "_resource_price_table",
     "_stats_neuron_monitor_config_path",
     "run_mode",
     "_start_datetime",
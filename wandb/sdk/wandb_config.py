@@ -150,7 +150,9 @@ class Config:
         self._items[key] = val
         logger.info("config set %s = %s - %s", key, val, self._callback)
         if self._callback:
-            self._callback(key=key, val=val)
+            self._callback(
+                key=key, val=val, allow_val_change=self._allow_val_change()
+            )
 
     def items(self):
         return [(k, v) for k, v in self._items.items() if not k.startswith("_")]
@@ -183,7 +185,10 @@ class Config:
     def update(self, d, allow_val_change=None):
         sanitized = self._update(d, allow_val_change)
         if self._callback:
-            self._callback(data=sanitized)
+            self._callback(
+                data=sanitized,
+                allow_val_change=self._allow_val_change(allow_val_change),
+            )
 
     def get(self, *args):
         return self._items.get(*args)
@@ -216,7 +221,9 @@ class Config:
             self._items[k] = v
 
         if self._callback:
-            self._callback(data=d)
+            self._callback(
+                data=d, allow_val_change=self._allow_val_change(_allow_val_change)
+            )
 
     def _load_defaults(self):
         conf_dict = config_util.dict_from_config_file("config-defaults.yaml")
@@ -243,9 +250,7 @@ class Config:
         # refuse all WBValues which is all Media and Histograms
         if isinstance(val, wandb.sdk.data_types.base_types.wb_value.WBValue):
             raise ValueError("WBValue objects cannot be added to the run config")
-        # Let jupyter change config freely by default
-        if self._settings and self._settings._jupyter and allow_val_change is None:
-            allow_val_change = True
+        allow_val_change = self._allow_val_change(allow_val_change)
         # We always normalize keys by stripping '-'
         key = key.strip("-")
         if _is_artifact_representation(val):
@@ -263,6 +268,12 @@ class Config:
                 )
         return key, val
 
+    def _allow_val_change(self, allow_val_change=None):
+        # Let jupyter change config freely by default
+        if self._settings and self._settings._jupyter and allow_val_change is None:
+            return True
+        return bool(allow_val_change)
+
     def _raise_value_error_on_nested_artifact(self, v, nested=False):
         # we can't swap nested artifacts because their root key can be locked by other values
         # best if we don't allow nested artifacts until we can lock nested keys in the config
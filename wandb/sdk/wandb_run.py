@@ -169,7 +169,7 @@ class RunStatusChecker:
     def __init__(
         self,
         interface: InterfaceBase,
-        stop_polling_interval: int = 15,
+        stop_polling_interval: float = 15,
         retry_polling_interval: int = 5,
     ) -> None:
         self._interface = interface
@@ -1338,10 +1338,13 @@ class Run:
         key: Optional[Union[Tuple[str, ...], str]] = None,
         val: Optional[Any] = None,
         data: Optional[Dict[str, object]] = None,
+        allow_val_change: bool = False,
     ) -> None:
         logger.info(f"config_cb {key} {val} {data}")
         if self._backend and self._backend.interface:
-            self._backend.interface.publish_config(key=key, val=val, data=data)
+            self._backend.interface.publish_config(
+                key=key, val=val, data=data, allow_val_change=allow_val_change
+            )
 
     def _config_artifact_callback(
         self, key: str, val: Union[str, Artifact, dict]
@@ -2290,6 +2293,7 @@ class Run:
         if self._backend and self._backend.interface and not self._settings._offline:
             self._run_status_checker = RunStatusChecker(
                 interface=self._backend.interface,
+                stop_polling_interval=self._settings._stop_polling_interval_seconds,
             )
             self._run_status_checker.start()
 
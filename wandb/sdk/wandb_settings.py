@@ -134,7 +134,7 @@ def _datetime_as_str(val: Union[datetime, str]) -> str:
 
 def _redact_dict(
     d: Dict[str, Any],
-    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset({"api_key"}),
+    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset({"api_key", "_mirrors"}),
     redact_str: str = "***REDACTED***",
 ) -> Dict[str, Any]:
     """Redact a dict of unsafe values specified by their key."""
@@ -292,6 +292,8 @@ class SettingsData:
     _async_upload_concurrency_limit: int
     _cli_only_mode: bool  # Avoid running any code specific for runs
     _colab: bool
+    _config_timeline_history: bool  # also log config changes as history keys
+    _credential_helper_timeout_seconds: float
     # _config_dict: Config
     _cuda: str
     _disable_meta: bool  # Do not collect system metadata
@@ -317,11 +319,22 @@ class SettingsData:
     _file_transfer_timeout_seconds: int
     _flow_control_custom: bool
     _flow_control_disabled: bool
+    # cache TTLs of read-only graphql operations, such as "30s", by operation name
+    _graphql_cache_ttls: Mapping[str, str]
     # graphql retry client configuration
     _graphql_retry_max: int
     _graphql_retry_wait_min_seconds: int
     _graphql_retry_wait_max_seconds: int
     _graphql_timeout_seconds: int
+    _history_rate_limit: int  # max history rows per second streamed to the server
+    _history_rate_limit_policy: str  # what to do with the rows over the limit
+    _history_type_policy: str  # what to do with values that change a key's type
+    # metrics of other processes, over StatsD on UDP and over HTTP
+    _ingest_http_address: str
+    _ingest_interval_seconds: float
+    _ingest_prefix: str  # prefix of the ingested metric names
+    _ingest_statsd_address: str
+    _ingest_target: str  # whether ingested metrics go to the history or system
     _internal_check_process: float
     _internal_queue_timeout: float
     _ipython: bool
@@ -333,18 +346,28 @@ class SettingsData:
     _live_policy_rate_limit: int
     _live_policy_wait_time: int
     _log_level: int
+    _mirror_shutdown_timeout_seconds: float  # max wait for mirrors at exit
+    # additional servers to send the run to, by name, each a mapping with
+    # a base_url and optionally an api_key, entity and project
+    _mirrors: Mapping[str, Mapping[str, str]]
     _network_buffer: int
     _noop: bool
     _notebook: bool
     _offline: bool
     _sync: bool
     _os: str
+    _pause_subsystems: Sequence[str]  # subsystems quiesced while paused, empty for all
     _platform: str
     _proxies: Mapping[str, str]  # dedicated global proxy servers [scheme -> url]
     _python: str
+    _run_pull_interval_seconds: float  # pull server-side run edits at this interval
+    _run_upsert_burst: int  # run upserts allowed at once before rate limiting
+    _run_upsert_interval_seconds: float  # min time between rate limited run upserts
     _runqueue_item_id: str
     _require_core: bool
+    _resource_price_table: str  # path to a JSON file of resource prices
     _save_requirements: bool
+    _save_history_and_summary: bool  # keep history and summary in a files_dir subdir
     _service_transport: str
     _service_wait: float
     _start_datetime: str
@@ -367,6 +390,8 @@ class SettingsData:
     _stats_buffer_size: (
         int
     )  # number of consolidated samples to buffer before flushing, available in run obj
+    _stop_polling_interval_seconds: float  # time between checks for a stop request
+    _symlink_policy: str  # how uploads treat symlinks to outside the files dir
     _tmp_code_dir: str
     _tracelog: str
     _unsaved_keys: Sequence[str]
@@ -380,6 +405,7 @@ class SettingsData:
     colab_url: str
     config_paths: Sequence[str]
     console: str
+    credential_helper: str  # command that prints the API key for a host
     deployment: str
     disable_code: bool
     disable_git: bool
@@ -622,6 +648,8 @@ class Settings(SettingsData):
                 "hook": lambda _: "google.colab" in sys.modules,
                 "auto_hook": True,
             },
+            _config_timeline_history={"value": False, "preprocessor": _str_as_bool},
+            _credential_helper_timeout_seconds={"preprocessor": float},
             _disable_machine_info={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -665,10 +693,25 @@ class Settings(SettingsData):
                 "hook": lambda _: bool(self._network_buffer),
                 "auto_hook": True,
             },
+            _graphql_cache_ttls={"preprocessor": _str_as_json},
             _graphql_retry_max={"value": 20, "preprocessor": int},
             _graphql_retry_wait_min_seconds={"value": 2, "preprocessor": int},
             _graphql_retry_wait_max_seconds={"value": 60, "preprocessor": int},
             _graphql_timeout_seconds={"value": 30.0, "preprocessor": int},
+            _history_rate_limit={"preprocessor": int},
+            _history_rate_limit_policy={
+                "value": "drop",
+                "validator": self._validate__history_rate_limit_policy,
+            },
+            _history_type_policy={
+                "value": "warn",
+                "validator": self._validate__history_type_policy,
+            },
+            _ingest_interval_seconds={"preprocessor": float},
+            _ingest_target={
+                "value": "history",
+                "validator": self._validate__ingest_target,
+            },
             _internal_check_process={"value": 8, "preprocessor": float},
             _internal_queue_timeout={"value": 2, "preprocessor": float},
             _ipython={
@@ -681,6 +724,11 @@ class Settings(SettingsData):
             },
             _kaggle={"hook": lambda _: util._is_likely_kaggle(), "auto_hook": True},
             _log_level={"value": logging.DEBUG},
+            _mirror_shutdown_timeout_seconds={"value": 60.0, "preprocessor": float},
+            _mirrors={
+                "preprocessor": _str_as_json,
+                "validator": self._validate__mirrors,
+            },
             _network_buffer={"preprocessor": int},
             _noop={"hook": lambda _: self.mode == "disabled", "auto_hook": True},
             _notebook={
@@ -698,12 +746,24 @@ class Settings(SettingsData):
                 ),
                 "auto_hook": True,
             },
+            _pause_subsystems={
+                "value": tuple(),
+                "preprocessor": _str_as_tuple,
+                "validator": self._validate__pause_subsystems,
+            },
             _platform={"value": util.get_platform_name()},
             _proxies={
                 "preprocessor": _str_as_json,
             },
             _require_core={"value": False, "preprocessor": _str_as_bool},
+            _resource_price_table={
+                "hook": lambda x: self._path_convert(x),
+            },
+            _run_pull_interval_seconds={"preprocessor": float},
+            _run_upsert_burst={"preprocessor": int},
+            _run_upsert_interval_seconds={"preprocessor": float},
             _save_requirements={"value": True, "preprocessor": _str_as_bool},
+            _save_history_and_summary={"value": False, "preprocessor": _str_as_bool},
             _service_wait={
                 "value": 30,
                 "preprocessor": float,
@@ -740,6 +800,11 @@ class Settings(SettingsData):
                 "value": 0,
                 "preprocessor": int,
             },
+            _stop_polling_interval_seconds={"value": 15.0, "preprocessor": float},
+            _symlink_policy={
+                "value": "follow",
+                "validator": self._validate__symlink_policy,
+            },
             _sync={"value": False},
             _tmp_code_dir={
                 "value": "code",
@@ -1184,6 +1249,67 @@ class Settings(SettingsData):
 
         return True
 
+    @staticmethod
+    def _validate__history_rate_limit_policy(value: str) -> bool:
+        choices: Set[str] = {"drop", "stride", "mean", "last"}
+        if value not in choices:
+            raise UsageError(
+                "Settings field `_history_rate_limit_policy`: "
+                f"{value!r} not in {choices}"
+            )
+        return True
+
+    @staticmethod
+    def _validate__history_type_policy(value: str) -> bool:
+        choices: Set[str] = {"warn", "reject", "coerce"}
+        if value not in choices:
+            raise UsageError(
+                f"Settings field `_history_type_policy`: {value!r} not in {choices}"
+            )
+        return True
+
+    @staticmethod
+    def _validate__ingest_target(value: str) -> bool:
+        choices: Set[str] = {"history", "system"}
+        if value not in choices:
+            raise UsageError(
+                f"Settings field `_ingest_target`: {value!r} not in {choices}"
+            )
+        return True
+
+    @staticmethod
+    def _validate__mirrors(value: Mapping[str, Mapping[str, str]]) -> bool:
+        choices: Set[str] = {"base_url", "api_key", "entity", "project"}
+        for name, destination in value.items():
+            if not isinstance(destination, Mapping) or not destination.get("base_url"):
+                raise UsageError(f"Settings field `_mirrors`: {name!r} has no base_url")
+            unknown = [k for k in destination if k not in choices]
+            if unknown:
+                raise UsageError(
+                    f"Settings field `_mirrors`: {name!r} keys {unknown!r} "
+                    f"not in {choices}"
+                )
+        return True
+
+    @staticmethod
+    def _validate__pause_subsystems(value: Sequence[str]) -> bool:
+        choices: Set[str] = {"system", "filestream", "uploads", "console"}
+        unknown = [v for v in value if v not in choices]
+        if unknown:
+            raise UsageError(
+                f"Settings field `_pause_subsystems`: {unknown!r} not in {choices}"
+            )
+        return True
+
+    @staticmethod
+    def _validate__symlink_policy(value: str) -> bool:
+        choices: Set[str] = {"follow", "copy", "skip"}
+        if value not in choices:
+            raise UsageError(
+                f"Settings field `_symlink_policy`: {value!r} not in {choices}"
+            )
+        return True
+
     @staticmethod
     def _validate_job_source(value: str) -> bool:
         valid_sources = ["repo", "artifact", "image"]
@@ -1547,6 +1673,12 @@ class Settings(SettingsData):
                 else:
                     raise TypeError(f"Unsupported type {type(v)} for setting {k}")
                 continue
+            # special case for _mirrors, a mapping of mappings
+            if k == "_mirrors" and isinstance(v, dict):
+                setting = getattr(settings, k)
+                for key, value in v.items():
+                    setting.value[key].value.update(value)
+                continue
 
             if isinstance(v, bool):
                 getattr(settings, k).CopyFrom(BoolValue(value=v))